@@ -0,0 +1,243 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/eightvalues"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+// Default stopping margins, in percentage points, for adaptive quizzes started
+// without one. Politiscales spreads 117 questions over 23 axes, and each answer
+// only informs the side of a pair it was given on, so it needs a wider margin to
+// stop noticeably early.
+const (
+	defaultEightValuesMargin  = 10.0
+	defaultPolitiscalesMargin = 20.0
+)
+
+// adaptiveZ is the normal quantile for the 95% intervals behind the margins
+const adaptiveZ = 1.96
+
+// axisObservation is one answer's contribution to an axis: the answer value on
+// the axis scale, weighted by the question's weight on that axis
+type axisObservation struct {
+	value  float64
+	weight float64
+}
+
+// axisEstimate summarises the answers given on one axis. Margin is the half-width,
+// in percentage points, of a 95% interval for the score the full quiz would give.
+type axisEstimate struct {
+	Mean   float64
+	Margin float64
+
+	spread    float64 // width of the value scale (2 for -1..1, 1 for 0..1)
+	variance  float64 // weighted answer variance, shrunk towards a uniform prior
+	weight    float64 // total weight answered
+	weightSq  float64 // sum of squared weights answered
+	remaining float64 // total weight of the questions not yet asked
+}
+
+// estimateAxis computes the weighted mean of the observations and its margin.
+// The variance is shrunk towards that of a uniform answer over two questions'
+// worth of weight, so a handful of identical answers does not look certain, and
+// a finite-population correction brings the margin to zero once every question
+// on the axis has been answered.
+func estimateAxis(observations []axisObservation, remaining, spread float64) axisEstimate {
+	estimate := axisEstimate{spread: spread, remaining: remaining}
+	for _, o := range observations {
+		estimate.weight += o.weight
+		estimate.weightSq += o.weight * o.weight
+		estimate.Mean += o.value * o.weight
+	}
+	if estimate.weight == 0 {
+		// Nothing known yet: the whole scale is possible
+		estimate.Margin = 50
+		if remaining == 0 {
+			estimate.Margin = 0
+		}
+		return estimate
+	}
+	estimate.Mean /= estimate.weight
+
+	var squares float64
+	for _, o := range observations {
+		squares += o.weight * (o.value - estimate.Mean) * (o.value - estimate.Mean)
+	}
+	priorWeight := 2 * estimate.weight / float64(len(observations))
+	estimate.variance = (squares + priorWeight*spread*spread/12) / (estimate.weight + priorWeight)
+
+	estimate.Margin = estimate.marginWith(0)
+	return estimate
+}
+
+// marginWith returns the margin the axis would have after answering one more
+// question carrying the given weight on it
+func (e axisEstimate) marginWith(weight float64) float64 {
+	answered := e.weight + weight
+	if answered == 0 {
+		return 50
+	}
+	total := e.weight + e.remaining
+	correction := 0.0
+	if total > 0 {
+		correction = math.Max(0, e.remaining-weight) / total
+	}
+	standardError := math.Sqrt(e.variance * (e.weightSq + weight*weight) / (answered * answered) * correction)
+	return adaptiveZ * standardError * 100 / e.spread
+}
+
+// axesStable reports whether every axis margin is within the target margin
+func axesStable(estimates []axisEstimate, margin float64) bool {
+	for _, estimate := range estimates {
+		if estimate.Margin > margin {
+			return false
+		}
+	}
+	return true
+}
+
+// selectAdaptiveQuestion finds the unasked question in order[next:] with the largest
+// information gain (margin reduction) on the least-certain axis and moves it to
+// order[next]. Ties are broken by the gain summed over all axes, then by the
+// existing shuffled order.
+func selectAdaptiveQuestion(order []int, next int, estimates []axisEstimate, weight func(question, axis int) float64) {
+	if next >= len(order)-1 {
+		return
+	}
+
+	leastCertain := 0
+	for axis, estimate := range estimates {
+		if estimate.Margin > estimates[leastCertain].Margin {
+			leastCertain = axis
+		}
+	}
+
+	best, bestGain, bestTotal := next, -1.0, -1.0
+	for i := next; i < len(order); i++ {
+		question := order[i]
+		gain := estimates[leastCertain].Margin - estimates[leastCertain].marginWith(weight(question, leastCertain))
+		total := 0.0
+		for axis, estimate := range estimates {
+			total += estimate.Margin - estimate.marginWith(weight(question, axis))
+		}
+		if gain > bestGain+1e-9 || (math.Abs(gain-bestGain) <= 1e-9 && total > bestTotal+1e-9) {
+			best, bestGain, bestTotal = i, gain, total
+		}
+	}
+	order[next], order[best] = order[best], order[next]
+}
+
+// adaptiveOptions reads the adaptive and margin arguments used when starting a quiz
+func adaptiveOptions(request mcp.CallToolRequest, defaultMargin float64) (adaptive bool, margin float64, err error) {
+	adaptive = request.GetBool("adaptive", false)
+	margin = request.GetFloat("margin", defaultMargin)
+	if math.IsNaN(margin) || margin <= 0 || margin > 50 {
+		return false, 0, fmt.Errorf("invalid margin: must be between 0 and 50 percentage points")
+	}
+	return adaptive, margin, nil
+}
+
+// eightValuesAxisNames labels the 8values axes in Effect order
+var eightValuesAxisNames = [4]string{"Economic", "Diplomatic", "Government", "Society"}
+
+// eightValuesAxisWeight is a question's weight on an 8values axis
+func eightValuesAxisWeight(question, axis int) float64 {
+	return abs(eightvalues.Questions[question].Effect[axis])
+}
+
+// eightValuesAxisEstimates estimates each 8values axis from the answers so far.
+// An answer's value on an axis is its multiplier signed by the question's effect.
+func eightValuesAxisEstimates() []axisEstimate {
+	answered := len(eightValuesQuizState.Responses)
+	if answered > len(eightValuesShuffledQuestions) {
+		answered = len(eightValuesShuffledQuestions)
+	}
+
+	estimates := make([]axisEstimate, len(eightValuesAxisNames))
+	for axis := range estimates {
+		var observations []axisObservation
+		for i, multiplier := range eightValuesQuizState.Responses[:answered] {
+			effect := eightvalues.Questions[eightValuesShuffledQuestions[i]].Effect[axis]
+			if effect > 0 {
+				observations = append(observations, axisObservation{value: multiplier, weight: effect})
+			} else if effect < 0 {
+				observations = append(observations, axisObservation{value: -multiplier, weight: -effect})
+			}
+		}
+
+		var remaining float64
+		for _, question := range eightValuesShuffledQuestions[answered:] {
+			remaining += eightValuesAxisWeight(question, axis)
+		}
+		estimates[axis] = estimateAxis(observations, remaining, 2)
+	}
+	return estimates
+}
+
+// eightValuesAdaptiveStable reports whether an adaptive 8values quiz can stop
+func eightValuesAdaptiveStable() bool {
+	return eightValuesQuizState.Adaptive && len(eightValuesQuizState.Responses) > 0 &&
+		axesStable(eightValuesAxisEstimates(), eightValuesQuizState.Margin)
+}
+
+// politiscalesAxisWeights returns a question's yes and no weights on an axis
+func politiscalesAxisWeights(question politiscales.Question, axis string) (yes, no float64) {
+	for _, weight := range question.YesWeights {
+		if weight.Axis == axis && weight.Value > 0 {
+			yes += weight.Value
+		}
+	}
+	for _, weight := range question.NoWeights {
+		if weight.Axis == axis && weight.Value > 0 {
+			no += weight.Value
+		}
+	}
+	return yes, no
+}
+
+// politiscalesAxisWeight is a question's weight on a politiscales axis (by position
+// in politiscales.Axes); the larger side is used as the answer is not known yet
+func politiscalesAxisWeight(question, axis int) float64 {
+	yes, no := politiscalesAxisWeights(politiscales.Questions[question], politiscales.Axes[axis].Name)
+	return math.Max(yes, no)
+}
+
+// politiscalesAxisEstimates estimates each politiscales axis, in politiscales.Axes
+// order, mirroring calculatePolitiscalesResultsInternal: an answer counts towards
+// the side it was given on with value |answer|, and neutral answers count as 0
+// against both sides' weights.
+func politiscalesAxisEstimates() []axisEstimate {
+	estimates := make([]axisEstimate, len(politiscales.Axes))
+	for axis, a := range politiscales.Axes {
+		var observations []axisObservation
+		var remaining float64
+		for _, questionIndex := range politiscalesShuffledQuestions {
+			question := politiscales.Questions[questionIndex]
+			yes, no := politiscalesAxisWeights(question, a.Name)
+
+			answer, answered := politiscalesQuizState.Responses[question.Index]
+			switch {
+			case !answered:
+				remaining += math.Max(yes, no)
+			case answer > 0 && yes > 0:
+				observations = append(observations, axisObservation{value: answer, weight: yes})
+			case answer < 0 && no > 0:
+				observations = append(observations, axisObservation{value: -answer, weight: no})
+			case answer == 0 && yes+no > 0:
+				observations = append(observations, axisObservation{value: 0, weight: yes + no})
+			}
+		}
+		estimates[axis] = estimateAxis(observations, remaining, 1)
+	}
+	return estimates
+}
+
+// politiscalesAdaptiveStable reports whether an adaptive politiscales quiz can stop
+func politiscalesAdaptiveStable() bool {
+	return politiscalesQuizState.Adaptive && len(politiscalesQuizState.Responses) > 0 &&
+		axesStable(politiscalesAxisEstimates(), politiscalesQuizState.Margin)
+}
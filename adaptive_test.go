@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/eightvalues"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+func createAdaptiveStartRequest(margin float64) mcp.CallToolRequest {
+	return createMockRequest("start", map[string]interface{}{"answer": "", "adaptive": true, "margin": margin})
+}
+
+func TestEstimateAxis(t *testing.T) {
+	unknown := estimateAxis(nil, 10, 2)
+	if unknown.Margin != 50 {
+		t.Errorf("an axis with no answers should have a margin of 50, got %f", unknown.Margin)
+	}
+
+	observations := []axisObservation{{1, 1}, {1, 1}, {1, 1}}
+	partial := estimateAxis(observations, 10, 2)
+	if partial.Mean != 1 {
+		t.Errorf("expected mean 1, got %f", partial.Mean)
+	}
+	if partial.Margin <= 0 {
+		t.Error("identical answers should still leave some uncertainty while questions remain")
+	}
+	if more := estimateAxis(append(observations, axisObservation{1, 1}), 9, 2); more.Margin >= partial.Margin {
+		t.Errorf("margin should shrink with more answers: %f -> %f", partial.Margin, more.Margin)
+	}
+	if complete := estimateAxis(observations, 0, 2); complete.Margin != 0 {
+		t.Errorf("margin should be zero once every question is answered, got %f", complete.Margin)
+	}
+
+	mixed := estimateAxis([]axisObservation{{1, 1}, {-1, 1}, {1, 1}, {-1, 1}}, 10, 2)
+	consistent := estimateAxis([]axisObservation{{1, 1}, {1, 1}, {1, 1}, {1, 1}}, 10, 2)
+	if mixed.Margin <= consistent.Margin {
+		t.Error("inconsistent answers should give a wider margin")
+	}
+}
+
+func TestSelectAdaptiveQuestion(t *testing.T) {
+	weights := [][]float64{
+		{1, 0}, // question 0
+		{0, 5}, // question 1
+		{0, 1}, // question 2
+		{3, 0}, // question 3
+	}
+	weight := func(question, axis int) float64 { return weights[question][axis] }
+
+	estimates := []axisEstimate{
+		estimateAxis([]axisObservation{{1, 1}, {1, 1}}, 4, 2),
+		estimateAxis([]axisObservation{{1, 1}}, 6, 2),
+	}
+	order := []int{9, 0, 2, 1, 3}
+	selectAdaptiveQuestion(order, 1, estimates, func(q, a int) float64 {
+		if q == 9 {
+			return 0
+		}
+		return weight(q, a)
+	})
+	if order[1] != 1 {
+		t.Errorf("expected the heaviest question on the least-certain axis next, got order %v", order)
+	}
+	if order[0] != 9 {
+		t.Error("asked questions should not move")
+	}
+}
+
+func TestAdaptiveEightValuesStopsEarly(t *testing.T) {
+	resetState()
+
+	response, _ := handleEightValues(context.Background(), createAdaptiveStartRequest(15))
+	if isErrorResult(response) || !eightValuesQuizState.Adaptive || eightValuesQuizState.Margin != 15 {
+		t.Fatalf("expected adaptive quiz to start: %s", extractTextContent(response))
+	}
+
+	var content string
+	for i := 0; i < len(eightvalues.Questions); i++ {
+		response, _ = handleEightValues(context.Background(), createRequestWithAnswer("strongly_agree"))
+		content = extractTextContent(response)
+		if strings.Contains(content, "Quiz Complete!") {
+			break
+		}
+	}
+
+	answered := len(eightValuesQuizState.Responses)
+	if !strings.Contains(content, "Quiz Complete!") || answered >= len(eightvalues.Questions) {
+		t.Fatalf("expected adaptive quiz to stop early, answered %d", answered)
+	}
+	if !strings.Contains(content, "Adaptive mode: stopped after") || !strings.Contains(content, "(±") {
+		t.Errorf("completion should report the margins, got: %s", content)
+	}
+	for _, estimate := range eightValuesAxisEstimates() {
+		if estimate.Margin > 15 {
+			t.Errorf("axis margin %f exceeds the target", estimate.Margin)
+		}
+	}
+
+	// Scores are normalised over the questions actually asked
+	maxEcon, _, _, _ := eightValuesMaxima()
+	var expected float64
+	for i, multiplier := range eightValuesQuizState.Responses {
+		expected += multiplier * eightvalues.Questions[eightValuesShuffledQuestions[i]].Effect[eightvalues.Economic]
+	}
+	if got := eightValuesPercentage(expected, maxEcon); math.IsNaN(got) || got < 0 || got > 100 {
+		t.Errorf("partial percentage out of range: %f", got)
+	}
+
+	status, _ := handleEightValuesStatus(context.Background(), createEmptyRequest())
+	statusText := extractTextContent(status)
+	if !strings.Contains(statusText, "Mode: Adaptive") || !strings.Contains(statusText, "**Final Scores:**") {
+		t.Errorf("status should show the finished adaptive quiz, got: %s", statusText)
+	}
+}
+
+func TestAdaptivePolitiscalesStopsEarly(t *testing.T) {
+	resetState()
+
+	handlePolitiscales(context.Background(), createMockRequest("start", map[string]interface{}{"answer": "", "adaptive": true}))
+	if politiscalesQuizState.Margin != defaultPolitiscalesMargin {
+		t.Errorf("expected default margin %f, got %f", defaultPolitiscalesMargin, politiscalesQuizState.Margin)
+	}
+
+	var content string
+	for i := 0; i < len(politiscales.Questions); i++ {
+		response, _ := handlePolitiscales(context.Background(), createRequestWithAnswer("agree"))
+		content = extractTextContent(response)
+		if strings.Contains(content, "Quiz Complete!") {
+			break
+		}
+	}
+	if !strings.Contains(content, "Adaptive mode: stopped after") {
+		t.Fatalf("expected adaptive completion, got: %s", content)
+	}
+	if len(politiscalesQuizState.Responses) >= len(politiscales.Questions) {
+		t.Errorf("expected adaptive quiz to stop early, answered %d", len(politiscalesQuizState.Responses))
+	}
+}
+
+func TestAdaptiveOptionsValidation(t *testing.T) {
+	resetState()
+
+	for _, margin := range []float64{0, -5, 80} {
+		response, _ := handleEightValues(context.Background(), createAdaptiveStartRequest(margin))
+		if !isErrorResult(response) || !strings.Contains(extractTextContent(response), "invalid margin") {
+			t.Errorf("expected invalid margin error for %f", margin)
+		}
+	}
+	if eightValuesQuestionCount != 0 {
+		t.Error("an invalid margin should not start the quiz")
+	}
+
+	// Without the adaptive flag the full quiz runs
+	handleEightValues(context.Background(), createRequestWithAnswer(""))
+	if eightValuesQuizState.Adaptive {
+		t.Error("quizzes should not be adaptive by default")
+	}
+}
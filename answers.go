@@ -0,0 +1,128 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// answerTokens are the canonical answers, ordered from strongly disagree to strongly agree
+var answerTokens = []string{"strongly_disagree", "disagree", "neutral", "agree", "strongly_agree"}
+
+// answerCopyKeys maps each canonical answer to its label key in the *Copy tables
+var answerCopyKeys = map[string]string{
+	"strongly_disagree": "strong_disagree",
+	"disagree":          "disagree",
+	"neutral":           "neutral",
+	"agree":             "agree",
+	"strongly_agree":    "strong_agree",
+}
+
+// answerShortCodes are the abbreviations and 1-5 scale positions shown in error hints
+var answerShortCodes = map[string][]string{
+	"strongly_disagree": {"SD", "1"},
+	"disagree":          {"D", "2"},
+	"neutral":           {"N", "3"},
+	"agree":             {"A", "4"},
+	"strongly_agree":    {"SA", "5"},
+}
+
+// answerSynonyms lists further English wordings accepted for each answer
+var answerSynonyms = map[string][]string{
+	"strongly_disagree": {"strong disagree", "totally disagree", "completely disagree", "absolutely disagree", "strongly oppose"},
+	"disagree":          {"somewhat disagree", "mostly disagree", "tend to disagree", "oppose"},
+	"neutral":           {"neither", "neither agree nor disagree", "no opinion", "undecided", "unsure", "not sure", "indifferent"},
+	"agree":             {"somewhat agree", "mostly agree", "tend to agree", "support"},
+	"strongly_agree":    {"strong agree", "totally agree", "completely agree", "absolutely agree", "fully agree", "strongly support"},
+}
+
+// compactAnswer folds case, spacing, separators, apostrophes and trailing
+// punctuation so that "Pas d’accord", "pas d'accord." and "PAS_DACCORD" compare equal
+func compactAnswer(answer string) string {
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	answer = strings.TrimRight(answer, ".!。！ ")
+	return strings.NewReplacer(" ", "", "_", "", "-", "", "'", "", "’", "", "`", "").Replace(answer)
+}
+
+// normalizeAnswer maps a free-form answer onto one of answerTokens, returning ""
+// when it is not recognised. Labels from every language's copy table are
+// accepted, with the session language taking precedence over English and the rest.
+func normalizeAnswer(answer string, copyTables map[string]map[string]string, language string) string {
+	compact := compactAnswer(answer)
+	if compact == "" {
+		return ""
+	}
+
+	for _, token := range answerTokens {
+		forms := append([]string{token, answerCopyKeys[token]}, answerShortCodes[token]...)
+		for _, form := range append(forms, answerSynonyms[token]...) {
+			if compactAnswer(form) == compact {
+				return token
+			}
+		}
+	}
+
+	languages := append([]string{language, "en"}, supportedLanguages...)
+	for _, lang := range languages {
+		for _, token := range answerTokens {
+			if label, ok := copyTables[lang][answerCopyKeys[token]]; ok && compactAnswer(label) == compact {
+				return token
+			}
+		}
+	}
+	return ""
+}
+
+// invalidAnswerMessage explains an unrecognised answer, listing the accepted
+// tokens and the quiz's labels in the session language
+func invalidAnswerMessage(answer string, tokens []string, copyTables map[string]map[string]string, language string) string {
+	forms := make([]string, len(tokens))
+	for i, token := range tokens {
+		label := translate(copyTables, language, answerCopyKeys[token])
+		forms[i] = fmt.Sprintf("\"%s\" (%s)", label, strings.Join(answerShortCodes[token], ", "))
+	}
+	return fmt.Sprintf("invalid response: %s. Please use one of: %s\nAccepted forms: %s",
+		answer, strings.Join(tokens, ", "), strings.Join(forms, ", "))
+}
+
+// answerValue reads the optional numeric "value" argument, a slider position from
+// -1 (strongly disagree) to 1 (strongly agree). ok is false when it is absent.
+func answerValue(request mcp.CallToolRequest) (value float64, ok bool, err error) {
+	if _, present := request.GetArguments()["value"]; !present {
+		return 0, false, nil
+	}
+	value, err = request.RequireFloat("value")
+	if err != nil || math.IsNaN(value) || value < -1 || value > 1 {
+		return 0, false, fmt.Errorf("invalid value: must be a number between -1 (strongly disagree) and 1 (strongly agree)")
+	}
+	return value, true, nil
+}
+
+// formatRawValues lists answer values to two decimals, marking those that fall
+// between the quiz's fixed answer positions. It returns "" when every answer
+// sits on a fixed position, so status output only changes once the slider is used.
+func formatRawValues(values []float64, positions []float64) string {
+	formatted := make([]string, len(values))
+	sliderAnswers := 0
+	for i, value := range values {
+		formatted[i] = fmt.Sprintf("%+.2f", value)
+		onPosition := false
+		for _, position := range positions {
+			if math.Abs(value-position) < 1e-9 {
+				onPosition = true
+				break
+			}
+		}
+		if !onPosition {
+			formatted[i] += "*"
+			sliderAnswers++
+		}
+	}
+	if sliderAnswers == 0 {
+		return ""
+	}
+	return fmt.Sprintf("\n**Raw Values** (-1 to 1, * = slider answer, %d of %d):\n%s\n",
+		sliderAnswers, len(values), strings.Join(formatted, ", "))
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+func TestNormalizeAnswer(t *testing.T) {
+	tests := []struct {
+		answer   string
+		language string
+		expected string
+	}{
+		{"strongly_agree", "en", "strongly_agree"},
+		{"Strongly Agree", "en", "strongly_agree"},
+		{"STRONGLY-DISAGREE", "en", "strongly_disagree"},
+		{"StronglyAgree", "en", "strongly_agree"},
+		{"strong_disagree", "en", "strongly_disagree"},
+		{"SA", "en", "strongly_agree"},
+		{"a", "en", "agree"},
+		{"N", "en", "neutral"},
+		{"d", "en", "disagree"},
+		{"sd", "en", "strongly_disagree"},
+		{"1", "en", "strongly_disagree"},
+		{"3", "en", "neutral"},
+		{" 5 ", "en", "strongly_agree"},
+		{"Neither agree nor disagree", "en", "neutral"},
+		{"totally agree!", "en", "strongly_agree"},
+		{"pas d'accord", "fr", "disagree"},
+		{"Pas d’accord.", "fr", "disagree"},
+		{"tout à fait d'accord", "fr", "strongly_agree"},
+		{"Pas du tout d'accord", "en", "strongly_disagree"},
+		{"非常同意", "zh", "strongly_agree"},
+		{"同意", "zh", "agree"},
+		{"maybe", "en", ""},
+		{"", "en", ""},
+		{"6", "en", ""},
+		{"yes", "en", ""},
+	}
+
+	for _, tt := range tests {
+		if got := normalizeAnswer(tt.answer, politiscalesCopyTables, tt.language); got != tt.expected {
+			t.Errorf("normalizeAnswer(%q, %s) = %q, want %q", tt.answer, tt.language, got, tt.expected)
+		}
+	}
+}
+
+func TestTranslatedAnswerLabelsAreUnambiguous(t *testing.T) {
+	for _, tables := range []map[string]map[string]string{politicalCompassCopyTables, eightValuesCopyTables, politiscalesCopyTables} {
+		for lang, copy := range tables {
+			for _, token := range answerTokens {
+				label := copy[answerCopyKeys[token]]
+				for _, other := range supportedLanguages {
+					if got := normalizeAnswer(label, tables, other); got != token {
+						t.Errorf("%s label %q read as %q in a %s session, want %q", lang, label, got, other, token)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestFreeFormAnswersInHandlers(t *testing.T) {
+	resetState()
+	defer restoreDefaultLanguages()
+	handleSetLanguage(context.Background(), createRequestWithLanguage("fr"))
+
+	handlePoliticalCompass(context.Background(), createRequestWithAnswer(""))
+	response, _ := handlePoliticalCompass(context.Background(), createRequestWithAnswer("Pas d'accord"))
+	if isErrorResult(response) {
+		t.Fatalf("political compass should accept French labels: %s", extractTextContent(response))
+	}
+
+	handleEightValues(context.Background(), createRequestWithAnswer(""))
+	response, _ = handleEightValues(context.Background(), createRequestWithAnswer("4"))
+	if isErrorResult(response) || eightValuesQuizState.Responses[0] != 0.5 {
+		t.Fatalf("8values should record 4 as agree: %s", extractTextContent(response))
+	}
+
+	handlePolitiscales(context.Background(), createRequestWithAnswer(""))
+	response, _ = handlePolitiscales(context.Background(), createRequestWithAnswer("SA"))
+	if isErrorResult(response) {
+		t.Fatalf("politiscales should accept short codes: %s", extractTextContent(response))
+	}
+	for _, value := range politiscalesQuizState.Responses {
+		if value != 1.0 {
+			t.Errorf("expected SA to record 1.0, got %f", value)
+		}
+	}
+}
+
+func TestInvalidAnswerListsSessionLanguageForms(t *testing.T) {
+	resetState()
+	defer restoreDefaultLanguages()
+	handleSetLanguage(context.Background(), createRequestWithLanguage("fr"))
+
+	handlePolitiscales(context.Background(), createRequestWithAnswer(""))
+	response, _ := handlePolitiscales(context.Background(), createRequestWithAnswer("peut-être"))
+	if !isErrorResult(response) {
+		t.Fatal("expected error result for unrecognised answer")
+	}
+	content := extractTextContent(response)
+	for _, label := range []string{politiscales.FRCopy["strong_disagree"], politiscales.FRCopy["neutral"], "(SA, 5)"} {
+		if !strings.Contains(content, label) {
+			t.Errorf("expected %q in error, got: %s", label, content)
+		}
+	}
+
+	handlePoliticalCompass(context.Background(), createRequestWithAnswer(""))
+	response, _ = handlePoliticalCompass(context.Background(), createRequestWithAnswer("neutral"))
+	if !isErrorResult(response) {
+		t.Fatal("political compass has no neutral option")
+	}
+	if strings.Contains(extractTextContent(response), "(N, 3)") {
+		t.Error("political compass error should not offer neutral")
+	}
+}
+
+func createRequestWithValue(value interface{}) mcp.CallToolRequest {
+	return createMockRequest("answer", map[string]interface{}{"value": value})
+}
+
+func TestSliderValues(t *testing.T) {
+	resetState()
+
+	// Political compass: halfway between disagree and agree is the column midpoint
+	handlePoliticalCompass(context.Background(), createRequestWithAnswer(""))
+	question := politicalcompass.AllQuestions[shuffledQuestions[0]]
+	response, _ := handlePoliticalCompass(context.Background(), createRequestWithValue(0.0))
+	if isErrorResult(response) {
+		t.Fatalf("unexpected error: %s", extractTextContent(response))
+	}
+	expected := (question.Economic[politicalcompass.Disagree] + question.Economic[politicalcompass.Agree]) / 2
+	if math.Abs(totalEconomicScore-expected) > 1e-9 {
+		t.Errorf("expected interpolated economic score %f, got %f", expected, totalEconomicScore)
+	}
+	if quizState.Values[0] != 0 {
+		t.Errorf("expected raw value 0 to be recorded, got %f", quizState.Values[0])
+	}
+
+	// 8values and politiscales use the value directly
+	handleEightValues(context.Background(), createRequestWithAnswer(""))
+	handleEightValues(context.Background(), createRequestWithValue(0.25))
+	if eightValuesQuizState.Responses[0] != 0.25 {
+		t.Errorf("expected 8values multiplier 0.25, got %f", eightValuesQuizState.Responses[0])
+	}
+
+	handlePolitiscales(context.Background(), createRequestWithAnswer(""))
+	handlePolitiscales(context.Background(), createMockRequest("politiscales", map[string]interface{}{"answer": "agree", "value": "-0.4"}))
+	for _, value := range politiscalesQuizState.Responses {
+		if value != -0.4 {
+			t.Errorf("value should take precedence over answer, got %f", value)
+		}
+	}
+
+	for _, status := range []func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error){
+		handleQuizStatus, handleEightValuesStatus, handlePolitiscalesStatus,
+	} {
+		response, _ := status(context.Background(), createEmptyRequest())
+		if !strings.Contains(extractTextContent(response), "**Raw Values** (-1 to 1, * = slider answer, 1 of 1)") {
+			t.Errorf("status should list slider values, got: %s", extractTextContent(response))
+		}
+	}
+}
+
+func TestSliderValueValidation(t *testing.T) {
+	resetState()
+	handleEightValues(context.Background(), createRequestWithAnswer(""))
+
+	for _, value := range []interface{}{1.5, -2, "abc", true} {
+		response, _ := handleEightValues(context.Background(), createRequestWithValue(value))
+		if !isErrorResult(response) || !strings.Contains(extractTextContent(response), "invalid value") {
+			t.Errorf("expected invalid value error for %v", value)
+		}
+	}
+	if len(eightValuesQuizState.Responses) != 0 {
+		t.Error("invalid values should not be recorded")
+	}
+
+	// Fixed answers do not add a raw values section
+	handleEightValues(context.Background(), createRequestWithAnswer("agree"))
+	response, _ := handleEightValues(context.Background(), createEmptyRequest())
+	if !isErrorResult(response) {
+		t.Error("expected error when neither answer nor value is given")
+	}
+	response, _ = handleEightValuesStatus(context.Background(), createEmptyRequest())
+	if strings.Contains(extractTextContent(response), "Raw Values") {
+		t.Error("raw values should only be shown once a slider answer is given")
+	}
+}
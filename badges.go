@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+// handlePolitiscalesFlag renders the flag composed from the current politiscales
+// result, with the badges earned so far
+func handlePolitiscalesFlag(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	result, err := currentResult(politiscalesQuiz)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	scores := map[string]float64{}
+	for _, s := range result.Scores {
+		scores[s.Axis] = s.Score
+	}
+
+	text := fmt.Sprintf("🏳️ **Your Politiscales Flag**\n\nPolitical identity: %s\n\n", politiscales.IdentityName(scores, result.Language))
+	if axes := politiscales.FlagAxes(scores); len(axes) > 0 {
+		text += "**Colours** (strongest first):\n"
+		for i, axis := range axes[:min(3, len(axes))] {
+			part := []string{"field", "band", "hoist triangle"}[i]
+			text += fmt.Sprintf("- %s: %s %.1f%% (%s)\n", part,
+				politiscales.ResultsText(result.Language, "axis_"+axis.Name, axis.Name), scores[axis.Name], axis.Color)
+		}
+	} else {
+		text += fmt.Sprintf("No axis reaches %.0f%% yet, so the flag is plain grey.\n", politiscales.IdentityThreshold)
+	}
+
+	badges := politiscales.EarnedBadges(scores)
+	if len(badges) > 0 {
+		text += "\n**Badges:**\n"
+		for _, axis := range badges {
+			text += fmt.Sprintf("- %s (%.1f%%)\n", politiscales.ResultsText(result.Language, "badge_"+axis.Name, axis.Label), scores[axis.Name])
+		}
+		text += fmt.Sprintf("\nThe flag carries the %s emblem.\n", politiscales.ResultsText(result.Language, "badge_"+badges[0].Name, badges[0].Label))
+	}
+	if !result.Complete {
+		text += fmt.Sprintf("\n*The quiz is still in progress (%d/%d answered), so the flag is provisional.*\n", result.Answered, result.Total)
+	}
+
+	text += "\n" + politiscales.GenerateFlagSVG(scores)
+	if len(badges) > 0 {
+		text += "\n\n" + politiscalesBadgesChart(scores, result.Language)
+	}
+	text += "\n\nRender the SVG flag above, and the badges if present, so the user can see them."
+	return mcp.NewToolResultText(text), nil
+}
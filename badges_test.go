@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+func TestHandlePolitiscalesFlag(t *testing.T) {
+	resetState()
+
+	request := func() (string, bool) {
+		response, err := handlePolitiscalesFlag(context.Background(), createMockRequest("politiscales_flag", nil))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return extractTextContent(response), isErrorResult(response)
+	}
+
+	if content, isError := request(); !isError || !strings.Contains(content, "no answers") {
+		t.Errorf("expected an error before the quiz is started, got: %s", content)
+	}
+
+	handlePolitiscales(context.Background(), createRequestWithAnswer(""))
+	for i := 0; i < len(politiscales.Questions); i++ {
+		handlePolitiscales(context.Background(), createRequestWithAnswer("strongly_agree"))
+	}
+	content, isError := request()
+	if isError {
+		t.Fatalf("unexpected error: %s", content)
+	}
+	for _, expected := range []string{"Political identity: ", "- field: ", "**Badges:**", "emblem", `<svg width="300" height="200"`, `r="30"`} {
+		if !strings.Contains(content, expected) {
+			t.Errorf("expected %q in flag output, got: %s", expected, content)
+		}
+	}
+	if strings.Contains(content, "provisional") {
+		t.Error("a finished quiz should not be marked provisional")
+	}
+}
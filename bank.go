@@ -0,0 +1,247 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/x86ed/MCP-PoliticalCompass/v3/eightvalues"
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+// bankMigration carries stored answers from one question bank version to the next
+type bankMigration struct {
+	from    int         // Bank version migrated from; answers end up on version from+1
+	hash    string      // Content hash of version from, as stamped on its results
+	count   int         // Number of questions in version from
+	ids     map[int]int // Old question ID -> new ID, or -1 for a removed question; unlisted IDs are unchanged
+	changed []int       // New IDs of questions reworded or reweighted, whose old answers may score differently
+}
+
+// bankMigrations lists each quiz's migrations, oldest first. Every bank is still at
+// its first version, so none are needed yet.
+var bankMigrations = map[string][]bankMigration{}
+
+// questionBankVersion returns the explicit version of a quiz's question bank
+func questionBankVersion(quiz string) int {
+	switch quiz {
+	case politicalCompassQuiz:
+		return politicalcompass.BankVersion
+	case eightValuesQuiz:
+		return eightvalues.BankVersion
+	default:
+		return politiscales.BankVersion
+	}
+}
+
+// questionBankHash hashes a question bank's keys and weights in bank order
+func questionBankHash(quiz string) []byte {
+	hash := sha256.New()
+	switch quiz {
+	case politicalCompassQuiz:
+		for _, q := range politicalcompass.AllQuestions {
+			fmt.Fprintf(hash, "%s %v %v\n", q.Text, q.Economic, q.Social)
+		}
+	case eightValuesQuiz:
+		for _, q := range eightvalues.Questions {
+			fmt.Fprintf(hash, "%s %v\n", q.Text, q.Effect)
+		}
+	case politiscalesQuiz:
+		for _, q := range politiscales.Questions {
+			fmt.Fprintf(hash, "%d %s %v %v\n", q.Index, q.Text, q.YesWeights, q.NoWeights)
+		}
+	}
+	return hash.Sum(nil)
+}
+
+// questionBankStamp returns the short content hash stamped on results next to the version
+func questionBankStamp(quiz string) string {
+	return hex.EncodeToString(questionBankHash(quiz)[:6])
+}
+
+// bankVersionLabel formats a bank version as stamped on results, such as "v1"
+func bankVersionLabel(version int) string {
+	return fmt.Sprintf("v%d", version)
+}
+
+// parseBankStamp reads the bank version and hash stamped on a stored result. Results
+// exported before banks were versioned carry "<size>-<hash>" instead, read as version 0.
+func parseBankStamp(version, hash string) (int, string, bool) {
+	if n, err := strconv.Atoi(strings.TrimPrefix(version, "v")); err == nil && strings.HasPrefix(version, "v") && hash != "" {
+		return n, hash, true
+	}
+	if size, legacy, found := strings.Cut(version, "-"); found && legacy != "" {
+		if _, err := strconv.Atoi(size); err == nil {
+			return 0, legacy, true
+		}
+	}
+	return 0, "", false
+}
+
+// bankUpgrade is the combined effect of the migrations from an old question bank
+// to the current one
+type bankUpgrade struct {
+	from    int          // Bank version migrated from
+	count   int          // Number of questions in that version
+	ids     map[int]int  // Old question ID -> current ID, or -1 for a removed question
+	changed map[int]bool // Current IDs of questions reworded or reweighted since
+}
+
+// question returns the current ID of an old question, or -1 if it was removed
+func (u bankUpgrade) question(id int) int {
+	if current, ok := u.ids[id]; ok {
+		return current
+	}
+	return id
+}
+
+// findBankUpgrade chains the migrations from a stamped question bank to the current
+// one. version is 0 when the stamp has none, and hash may be a prefix of the stamped hash.
+func findBankUpgrade(quiz string, version int, hash string) (bankUpgrade, error) {
+	current := questionBankVersion(quiz)
+	if (version == 0 || version == current) && hash != "" && strings.HasPrefix(questionBankStamp(quiz), hash) {
+		return bankUpgrade{from: current, count: quizQuestionCount(quiz)}, nil
+	}
+	stamp := hash
+	if version > 0 {
+		stamp = bankVersionLabel(version) + " " + hash
+	}
+	if version > current {
+		return bankUpgrade{}, fmt.Errorf("it was made with a newer %s question bank (%s) than this server's %s",
+			quizTitles[quiz], stamp, bankVersionLabel(current))
+	}
+
+	migrations := bankMigrations[quiz]
+	start := -1
+	for i, m := range migrations {
+		if (version == 0 || m.from == version) && hash != "" && strings.HasPrefix(m.hash, hash) {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return bankUpgrade{}, fmt.Errorf("it was made with a different %s question bank (%s) that no migration maps onto the current %s",
+			quizTitles[quiz], stamp, bankVersionLabel(current))
+	}
+
+	upgrade := bankUpgrade{from: migrations[start].from, count: migrations[start].count, ids: map[int]int{}, changed: map[int]bool{}}
+	for id := 0; id < upgrade.count; id++ {
+		upgrade.ids[id] = id
+	}
+	next := upgrade.from
+	for _, m := range migrations[start:] {
+		if m.from != next {
+			return bankUpgrade{}, fmt.Errorf("the %s question bank migrations skip from %s to %s",
+				quizTitles[quiz], bankVersionLabel(next), bankVersionLabel(m.from))
+		}
+		remap := func(id int) int {
+			if moved, ok := m.ids[id]; ok {
+				return moved
+			}
+			return id
+		}
+		for old, id := range upgrade.ids {
+			if id >= 0 {
+				upgrade.ids[old] = remap(id)
+			}
+		}
+		changed := map[int]bool{}
+		for id := range upgrade.changed {
+			if moved := remap(id); moved >= 0 {
+				changed[moved] = true
+			}
+		}
+		for _, id := range m.changed {
+			changed[id] = true
+		}
+		upgrade.changed = changed
+		next = m.from + 1
+	}
+	if next != current {
+		return bankUpgrade{}, fmt.Errorf("the %s question bank migrations stop at %s, before the current %s",
+			quizTitles[quiz], bankVersionLabel(next), bankVersionLabel(current))
+	}
+	return upgrade, nil
+}
+
+// warnings describes the answers to old questions an upgrade cannot carry over faithfully
+func (u bankUpgrade) warnings(quiz string, answered []int) []string {
+	removed, changed := 0, 0
+	for _, id := range answered {
+		switch current := u.question(id); {
+		case current < 0:
+			removed++
+		case u.changed[current]:
+			changed++
+		}
+	}
+	var warnings []string
+	if removed > 0 {
+		warnings = append(warnings, fmt.Sprintf("%d answer(s) were to questions since removed from the %s question bank and were dropped",
+			removed, quizTitles[quiz]))
+	}
+	if changed > 0 {
+		warnings = append(warnings, fmt.Sprintf("%d answer(s) are to questions reworded or reweighted since %s question bank %s, so they may not score as they did",
+			changed, quizTitles[quiz], bankVersionLabel(u.from)))
+	}
+	return warnings
+}
+
+// migrateStoredResult brings a stored result's question IDs onto the current bank
+// and restamps it, or warns when its answers cannot be rescored faithfully
+func migrateStoredResult(result *exportedResult) {
+	if len(result.Answers) == 0 {
+		return
+	}
+	version, hash, ok := parseBankStamp(result.BankVersion, result.BankHash)
+	if !ok {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("the result has no %s question bank stamp, so its answers may not match the current questions",
+			result.Title))
+		return
+	}
+	upgrade, err := findBankUpgrade(result.Quiz, version, hash)
+	if err != nil {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("the answers cannot be rescored faithfully, so the scores are shown as stored: %v", err))
+		return
+	}
+
+	answered := make([]int, len(result.Answers))
+	var kept []exportedAnswer
+	for i, answer := range result.Answers {
+		answered[i] = answer.QuestionID
+		if answer.QuestionID = upgrade.question(answer.QuestionID); answer.QuestionID >= 0 {
+			kept = append(kept, answer)
+		}
+	}
+	if warnings := upgrade.warnings(result.Quiz, answered); len(warnings) > 0 {
+		result.Warnings = append(result.Warnings, warnings...)
+		result.Warnings = append(result.Warnings, fmt.Sprintf("the scores are shown as stored from %s question bank %s",
+			result.Title, bankVersionLabel(upgrade.from)))
+	}
+	result.Answers = kept
+	result.BankVersion, result.BankHash = bankVersionLabel(questionBankVersion(result.Quiz)), questionBankStamp(result.Quiz)
+}
+
+// quizBankWarnings returns the bank migration warnings kept in a quiz's state
+func quizBankWarnings(quiz string) *[]string {
+	switch quiz {
+	case politicalCompassQuiz:
+		return &quizState.BankWarnings
+	case eightValuesQuiz:
+		return &eightValuesQuizState.BankWarnings
+	default:
+		return &politiscalesQuizState.BankWarnings
+	}
+}
+
+// bankWarningNote lists a quiz's bank migration warnings for status messages, or returns ""
+func bankWarningNote(quiz string) string {
+	warnings := *quizBankWarnings(quiz)
+	if len(warnings) == 0 {
+		return ""
+	}
+	return "\n⚠️ **Question bank:** " + strings.Join(warnings, "; ") + "\n"
+}
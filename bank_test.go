@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// oldEightValuesBank registers a migration from a hypothetical 71-question 8values
+// bank before version 1: questions 0 and 1 swapped places, question 70 was removed
+// and question 5 was reweighted
+func oldEightValuesBank(t *testing.T) string {
+	t.Helper()
+	const hash = "00112233aabb"
+	saved := bankMigrations
+	bankMigrations = map[string][]bankMigration{eightValuesQuiz: {{
+		from: 0, hash: hash, count: 71, ids: map[int]int{0: 1, 1: 0, 70: -1}, changed: []int{5},
+	}}}
+	t.Cleanup(func() { bankMigrations = saved })
+	return hash
+}
+
+func TestParseBankStamp(t *testing.T) {
+	tests := []struct {
+		version, hash string
+		expected      int
+		expectedHash  string
+		ok            bool
+	}{
+		{"v1", "0123456789ab", 1, "0123456789ab", true},
+		{"70-0123456789ab", "", 0, "0123456789ab", true},
+		{"v1", "", 0, "", false},
+		{"", "", 0, "", false},
+		{"latest", "0123456789ab", 0, "", false},
+	}
+	for _, tt := range tests {
+		version, hash, ok := parseBankStamp(tt.version, tt.hash)
+		if version != tt.expected || hash != tt.expectedHash || ok != tt.ok {
+			t.Errorf("parseBankStamp(%q, %q) = %d, %q, %v", tt.version, tt.hash, version, hash, ok)
+		}
+	}
+}
+
+func TestFindBankUpgrade(t *testing.T) {
+	hash := oldEightValuesBank(t)
+
+	upgrade, err := findBankUpgrade(eightValuesQuiz, 1, questionBankStamp(eightValuesQuiz))
+	if err != nil || upgrade.count != 70 || upgrade.question(12) != 12 {
+		t.Errorf("expected the current bank to map onto itself, got %+v (%v)", upgrade, err)
+	}
+
+	upgrade, err = findBankUpgrade(eightValuesQuiz, 0, hash[:8])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if upgrade.count != 71 || upgrade.question(0) != 1 || upgrade.question(1) != 0 || upgrade.question(70) != -1 || upgrade.question(9) != 9 {
+		t.Errorf("unexpected upgrade: %+v", upgrade)
+	}
+	warnings := upgrade.warnings(eightValuesQuiz, []int{0, 5, 9, 70})
+	if len(warnings) != 2 || !strings.Contains(warnings[0], "1 answer(s) were to questions since removed") ||
+		!strings.Contains(warnings[1], "1 answer(s) are to questions reworded or reweighted since 8values question bank v0") {
+		t.Errorf("unexpected warnings: %v", warnings)
+	}
+
+	for _, tt := range []struct {
+		version  int
+		hash     string
+		expected string
+	}{
+		{1, "ffffffffffff", "different 8values question bank (v1 ffffffffffff) that no migration maps onto the current v1"},
+		{7, hash, "newer 8values question bank"},
+		{0, "", "different 8values question bank"},
+	} {
+		if _, err := findBankUpgrade(eightValuesQuiz, tt.version, tt.hash); err == nil || !strings.Contains(err.Error(), tt.expected) {
+			t.Errorf("expected an error containing %q for %d %q, got %v", tt.expected, tt.version, tt.hash, err)
+		}
+	}
+}
+
+func TestStoredResultMigration(t *testing.T) {
+	hash := oldEightValuesBank(t)
+	stored := func(version, bankHash string, ids ...int) string {
+		result := exportedResult{Quiz: eightValuesQuiz, BankVersion: version, BankHash: bankHash,
+			Scores: []exportedScore{{Axis: "Economic", Score: 60}}}
+		for _, id := range ids {
+			result.Answers = append(result.Answers, exportedAnswer{QuestionID: id, Answer: "agree", Value: 0.5})
+		}
+		data, _ := json.Marshal(result)
+		return string(data)
+	}
+
+	// Results stamped before banks were versioned still match the current bank
+	result, err := parseStoredResult([]byte(stored("70-"+questionBankStamp(eightValuesQuiz), "", 3, 4)))
+	if err != nil || result.BankVersion != "v1" || result.BankHash != questionBankStamp(eightValuesQuiz) || len(result.Warnings) != 0 {
+		t.Errorf("expected a legacy stamp to be restamped without warnings, got %+v (%v)", result, err)
+	}
+
+	result, err = parseStoredResult([]byte(stored("v0", hash, 0, 1, 5, 70)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ids := []int{}
+	for _, answer := range result.Answers {
+		ids = append(ids, answer.QuestionID)
+	}
+	if !reflect.DeepEqual(ids, []int{1, 0, 5}) || result.BankVersion != "v1" {
+		t.Errorf("expected the answers moved onto the current bank, got %v stamped %s", ids, result.BankVersion)
+	}
+	if len(result.Warnings) != 3 || !strings.Contains(result.Warnings[2], "scores are shown as stored") {
+		t.Errorf("expected warnings about the dropped and reweighted answers, got %v", result.Warnings)
+	}
+
+	content, isError := callTool(t, handleExportResults, "export_results", map[string]interface{}{"result": stored("v1", "ffffffffffff", 2), "format": "markdown"})
+	if isError || !strings.Contains(content, "> ⚠️ the answers cannot be rescored faithfully") {
+		t.Errorf("expected the export to warn about an unknown bank, got: %s", content)
+	}
+	content, _ = callTool(t, handleExportResults, "export_results", map[string]interface{}{"result": stored("v1", "ffffffffffff", 2), "format": "csv"})
+	if !strings.Contains(content, "warning,eight_values,,,") {
+		t.Errorf("expected a warning row in the CSV, got: %s", content)
+	}
+}
+
+func TestShareCodeFromOlderBank(t *testing.T) {
+	hash := oldEightValuesBank(t)
+	resetState()
+
+	// Agree with old question 0 (now 1), disagree with reweighted question 5, and
+	// strongly agree with the removed question 70
+	codes := make([]byte, 71)
+	codes[0], codes[5], codes[70] = 4, 2, 5
+	prefix, _ := hex.DecodeString(hash[:8])
+	data := append([]byte{shareCodeVersion<<4 | 1, shareModeFull, 0, 0, 0}, prefix...)
+	data = append(data, packAnswerCodes(codes)...)
+	data = append(data, crc8(data))
+
+	content, isError := callTool(t, handleImportShareCode, "import_share_code", map[string]interface{}{"code": base64.RawURLEncoding.EncodeToString(data)})
+	if isError {
+		t.Fatalf("unexpected error: %s", content)
+	}
+	if !reflect.DeepEqual(eightValuesAnswers(), []answeredItem{{1, 0.5}, {5, -0.5}}) {
+		t.Errorf("expected the answers moved onto the current bank, got %v", eightValuesAnswers())
+	}
+	if !strings.Contains(content, "Question bank:") || len(eightValuesQuizState.BankWarnings) != 2 {
+		t.Errorf("expected the import to warn about the migration, got: %s", content)
+	}
+	status, _ := handleEightValuesStatus(context.Background(), createMockRequest("eight_values_status", nil))
+	if text := extractTextContent(status); !strings.Contains(text, "were dropped") {
+		t.Errorf("expected the status to keep the warning, got: %s", text)
+	}
+	if result, _ := currentResult(eightValuesQuiz); len(result.Warnings) != 2 {
+		t.Errorf("expected the export to keep the warnings, got %v", result.Warnings)
+	}
+
+	resetState()
+	if len(eightValuesQuizState.BankWarnings) != 0 {
+		t.Error("expected a reset to clear the warnings")
+	}
+}
+
+func TestShareCodeFormatVersion1(t *testing.T) {
+	resetState()
+	handleEightValues(context.Background(), createRequestWithAnswer(""))
+	handleEightValues(context.Background(), createRequestWithAnswer("agree"))
+	code, _ := shareCode(eightValuesQuiz)
+	data, _ := base64.RawURLEncoding.DecodeString(code)
+
+	// Version 1 codes have no bank version byte
+	old := append([]byte{1<<4 | data[0]&0x0f}, data[1:4]...)
+	old = append(old, data[5:len(data)-1]...)
+	old = append(old, crc8(old))
+	expected := eightValuesAnswers()
+
+	resetState()
+	if content, isError := callTool(t, handleImportShareCode, "import_share_code", map[string]interface{}{"code": base64.RawURLEncoding.EncodeToString(old)}); isError || !reflect.DeepEqual(eightValuesAnswers(), expected) {
+		t.Errorf("expected a version 1 code to import, got %v: %s", eightValuesAnswers(), content)
+	}
+}
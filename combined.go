@@ -0,0 +1,264 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// combinedQuizzes are the quizzes in a combined profile, in chart lane order
+var combinedQuizzes = []string{politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz}
+
+// combinedDisagreement is the spread, on the common -100 to 100 scale, above
+// which the quizzes are flagged as disagreeing on a dimension
+const combinedDisagreement = 50.0
+
+// profileReading is one quiz's score on a combined dimension, from -100 (the
+// low pole) to 100 (the high pole)
+type profileReading struct {
+	Quiz   string
+	Source string
+	Score  float64
+}
+
+// profileDimension lines up the comparable scores of the quizzes on one dimension
+type profileDimension struct {
+	Name     string
+	Low      string
+	High     string
+	Readings []profileReading
+}
+
+// Spread is the distance between the lowest and highest readings
+func (d profileDimension) Spread() float64 {
+	if len(d.Readings) < 2 {
+		return 0
+	}
+	low, high := d.Readings[0].Score, d.Readings[0].Score
+	for _, reading := range d.Readings[1:] {
+		low, high = math.Min(low, reading.Score), math.Max(high, reading.Score)
+	}
+	return high - low
+}
+
+// Disagree reports whether the quizzes place the user far apart on the dimension
+func (d profileDimension) Disagree() bool {
+	return d.Spread() > combinedDisagreement
+}
+
+// Average is the mean of the readings
+func (d profileDimension) Average() float64 {
+	total := 0.0
+	for _, reading := range d.Readings {
+		total += reading.Score
+	}
+	return total / float64(len(d.Readings))
+}
+
+// combinedProfile puts the comparable scores of the given results on a common
+// -100 to 100 scale. Compass scores are scaled from ±10, 8values percentages are
+// measured from the centre, and Politiscales pairs are the difference of their sides.
+func combinedProfile(results map[string]exportedResult) []profileDimension {
+	scores := map[string]map[string]float64{}
+	for quiz, result := range results {
+		scores[quiz] = map[string]float64{}
+		for _, score := range result.Scores {
+			scores[quiz][score.Axis] = score.Score
+		}
+	}
+
+	dimensions := []profileDimension{
+		{Name: "Economic", Low: "Left", High: "Right"},
+		{Name: "Authority", Low: "Libertarian", High: "Authoritarian"},
+		{Name: "Diplomatic", Low: "Globalist", High: "Nationalist"},
+	}
+	add := func(dimension int, quiz, source string, score float64) {
+		score = math.Max(-100, math.Min(100, score))
+		dimensions[dimension].Readings = append(dimensions[dimension].Readings, profileReading{quiz, source, score})
+	}
+	if s, ok := scores[politicalCompassQuiz]; ok {
+		add(0, politicalCompassQuiz, "Economic", s["Economic"]*10)
+		add(1, politicalCompassQuiz, "Social", s["Social"]*10)
+	}
+	// 8values percentages measure Equality, Globe and Liberty, the low poles
+	if s, ok := scores[eightValuesQuiz]; ok {
+		add(0, eightValuesQuiz, "Economic", 2*(50-s["Economic"]))
+		add(1, eightValuesQuiz, "Government", 2*(50-s["Government"]))
+		add(2, eightValuesQuiz, "Diplomatic", 2*(50-s["Diplomatic"]))
+	}
+	if s, ok := scores[politiscalesQuiz]; ok {
+		add(0, politiscalesQuiz, "communism vs capitalism", s["capitalism"]-s["communism"])
+		add(1, politiscalesQuiz, "rehabilitative vs punitive justice", s["punitive_justice"]-s["rehabilitative_justice"])
+		add(2, politiscalesQuiz, "internationalism vs nationalism", s["nationalism"]-s["internationalism"])
+	}
+	return dimensions
+}
+
+// describeProfileScore names where a common-scale score sits between two poles
+func describeProfileScore(score float64, low, high string) string {
+	pole := strings.ToLower(high)
+	if score < 0 {
+		pole = strings.ToLower(low)
+	}
+	switch magnitude := math.Abs(score); {
+	case magnitude < 10:
+		return "centre"
+	case magnitude < 50:
+		return "moderately " + pole
+	default:
+		return "strongly " + pole
+	}
+}
+
+// combinedProfileColors are the marker colours of each quiz in the summary chart
+var combinedProfileColors = map[string]string{
+	politicalCompassQuiz: "#e53935",
+	eightValuesQuiz:      "#1e88e5",
+	politiscalesQuiz:     "#8e24aa",
+}
+
+// combinedProfileMarker draws a quiz's marker centred on x, y: a circle for the
+// Political Compass, a square for 8values and a diamond for Politiscales
+func combinedProfileMarker(quiz string, x, y float64) string {
+	color := combinedProfileColors[quiz]
+	switch quiz {
+	case politicalCompassQuiz:
+		return fmt.Sprintf(`<circle cx="%.1f" cy="%.1f" r="8" fill="%s" stroke="#222222" stroke-width="1.5"/>`, x, y, color)
+	case eightValuesQuiz:
+		return fmt.Sprintf(`<rect x="%.1f" y="%.1f" width="15" height="15" fill="%s" stroke="#222222" stroke-width="1.5"/>`, x-7.5, y-7.5, color)
+	default:
+		return fmt.Sprintf(`<polygon points="%.1f,%.1f %.1f,%.1f %.1f,%.1f %.1f,%.1f" fill="%s" stroke="#222222" stroke-width="1.5"/>`,
+			x, y-9, x+9, y, x, y+9, x-9, y, color)
+	}
+}
+
+// combinedProfileSVG renders the dimensions as one track each, with a lane per
+// quiz so that close scores never overlap. Disagreeing dimensions are outlined in red.
+func combinedProfileSVG(dimensions []profileDimension, results map[string]exportedResult) string {
+	height := 110 + 150*len(dimensions) + 50
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg width="800" height="%d" xmlns="http://www.w3.org/2000/svg">
+  <rect width="800" height="%d" fill="#EEEEEE"/>
+  <text x="400" y="60" text-anchor="middle" font-family="Montserrat, sans-serif" font-size="40" font-weight="700" fill="#222222">Combined Profile</text>
+  <text x="400" y="90" text-anchor="middle" font-family="Arial, sans-serif" font-size="14" fill="#555555">Comparable scores on a common scale from -100 to 100</text>
+`, height, height)
+
+	for i, dimension := range dimensions {
+		top := 110.0 + 150*float64(i)
+		stroke, strokeWidth := "#222222", 2
+		fmt.Fprintf(&b, `  <text x="100" y="%.1f" font-family="Montserrat, sans-serif" font-size="22" font-weight="700" fill="#222222">%s</text>
+`, top+22, dimension.Name)
+		if dimension.Disagree() {
+			stroke, strokeWidth = "#d32f2f", 4
+			fmt.Fprintf(&b, `  <text x="700" y="%.1f" text-anchor="end" font-family="Arial, sans-serif" font-size="16" font-weight="700" fill="#d32f2f">⚠ Quizzes disagree (%.0f points apart)</text>
+`, top+22, dimension.Spread())
+		}
+		fmt.Fprintf(&b, `  <rect x="100" y="%.1f" width="600" height="66" fill="white" stroke="%s" stroke-width="%d"/>
+  <line x1="400" y1="%.1f" x2="400" y2="%.1f" stroke="#999999" stroke-width="1.5" stroke-dasharray="4,4"/>
+  <text x="100" y="%.1f" font-family="Arial, sans-serif" font-size="14" fill="#222222">%s</text>
+  <text x="400" y="%.1f" text-anchor="middle" font-family="Arial, sans-serif" font-size="14" fill="#555555">0</text>
+  <text x="700" y="%.1f" text-anchor="end" font-family="Arial, sans-serif" font-size="14" fill="#222222">%s</text>
+`, top+35, stroke, strokeWidth, top+35, top+101, top+120, dimension.Low, top+120, top+120, dimension.High)
+
+		for _, reading := range dimension.Readings {
+			lane := 0
+			for j, quiz := range combinedQuizzes {
+				if quiz == reading.Quiz {
+					lane = j
+				}
+			}
+			x, y := 400+3*reading.Score, top+46+22*float64(lane)
+			anchor, labelX := "start", x+13
+			if x > 600 {
+				anchor, labelX = "end", x-13
+			}
+			fmt.Fprintf(&b, "  %s\n", combinedProfileMarker(reading.Quiz, x, y))
+			fmt.Fprintf(&b, `  <text x="%.1f" y="%.1f" text-anchor="%s" font-family="Arial, sans-serif" font-size="12" fill="#222222">%.0f</text>
+`, labelX, y+4, anchor, reading.Score)
+		}
+	}
+
+	// Legend
+	legendY := float64(height) - 30
+	for i, quiz := range combinedQuizzes {
+		x := 100 + 210*float64(i)
+		name := quizTitles[quiz]
+		if result, ok := results[quiz]; !ok {
+			name += " (not taken)"
+		} else if !result.Complete {
+			name += " (provisional)"
+		}
+		fmt.Fprintf(&b, "  %s\n", combinedProfileMarker(quiz, x+9, legendY))
+		fmt.Fprintf(&b, `  <text x="%.1f" y="%.1f" font-family="Arial, sans-serif" font-size="14" fill="#222222">%s</text>
+`, x+24, legendY+5, name)
+	}
+	b.WriteString("</svg>")
+	return b.String()
+}
+
+// handleCombinedProfile lines up the comparable dimensions of every quiz taken so far
+func handleCombinedProfile(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	results := map[string]exportedResult{}
+	text := "🧩 **Combined Profile**\n\n**Quizzes:**\n"
+	for _, quiz := range combinedQuizzes {
+		result, err := currentResult(quiz)
+		if reviewPending(quiz) {
+			text += fmt.Sprintf("- %s: in its review step, left out until finalized\n", quizTitles[quiz])
+			continue
+		}
+		if err != nil {
+			text += fmt.Sprintf("- %s: not taken\n", quizTitles[quiz])
+			continue
+		}
+		results[quiz] = result
+		status := "complete"
+		if !result.Complete {
+			status = "in progress, provisional"
+		}
+		text += fmt.Sprintf("- %s: %s (%d/%d answered)\n", quizTitles[quiz], status, result.Answered, result.Total)
+	}
+	if len(results) == 0 {
+		return mcp.NewToolResultError("no answers yet: take at least one of the political_compass, eight_values or politiscales quizzes"), nil
+	}
+
+	dimensions := combinedProfile(results)
+	text += "\nScores are on a common scale from -100 to 100.\n"
+	var disagreements []string
+	for _, dimension := range dimensions {
+		text += fmt.Sprintf("\n**%s** (%s -100 … 100 %s)\n", dimension.Name, dimension.Low, dimension.High)
+		if len(dimension.Readings) == 0 {
+			text += "- No quiz taken so far measures this dimension\n"
+			continue
+		}
+		for _, reading := range dimension.Readings {
+			text += fmt.Sprintf("- %s %s: %.1f (%s)\n", quizTitles[reading.Quiz], reading.Source, reading.Score,
+				describeProfileScore(reading.Score, dimension.Low, dimension.High))
+		}
+		if len(dimension.Readings) > 1 {
+			average := dimension.Average()
+			text += fmt.Sprintf("- Average: %.1f (%s)\n", average, describeProfileScore(average, dimension.Low, dimension.High))
+		}
+		if dimension.Disagree() {
+			text += fmt.Sprintf("- ⚠️ The quizzes disagree: %.0f points apart\n", dimension.Spread())
+			disagreements = append(disagreements, dimension.Name)
+		}
+	}
+
+	switch {
+	case len(results) == 1:
+		text += "\nTake another quiz to compare the instruments.\n"
+	case len(disagreements) == 0:
+		text += "\n✅ The quizzes agree on every shared dimension.\n"
+	default:
+		text += fmt.Sprintf("\n⚠️ **Disagreements:** %s. The quizzes word these questions differently, so answers that look consistent can land apart; `explain_result` shows which answers drove each score.\n",
+			strings.Join(disagreements, ", "))
+	}
+	text += "\n" + combinedProfileSVG(dimensions, results) + "\n\nRender the SVG chart above so the user can see the combined profile."
+	return mcp.NewToolResultText(text), nil
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+)
+
+func TestCombinedProfileScales(t *testing.T) {
+	results := map[string]exportedResult{
+		politicalCompassQuiz: {Quiz: politicalCompassQuiz, Scores: []exportedScore{{Axis: "Economic", Score: -4}, {Axis: "Social", Score: 2.5}}},
+		eightValuesQuiz: {Quiz: eightValuesQuiz, Scores: []exportedScore{
+			{Axis: "Economic", Score: 70}, {Axis: "Diplomatic", Score: 40}, {Axis: "Government", Score: 35}, {Axis: "Society", Score: 60},
+		}},
+		politiscalesQuiz: {Quiz: politiscalesQuiz, Scores: []exportedScore{
+			{Axis: "communism", Score: 10}, {Axis: "capitalism", Score: 80},
+			{Axis: "rehabilitative_justice", Score: 30}, {Axis: "punitive_justice", Score: 50},
+			{Axis: "internationalism", Score: 60},
+		}},
+	}
+	expected := map[string][]float64{
+		"Economic":   {-40, -40, 70},
+		"Authority":  {25, 30, 20},
+		"Diplomatic": {20, -60},
+	}
+
+	dimensions := combinedProfile(results)
+	if len(dimensions) != 3 {
+		t.Fatalf("expected 3 dimensions, got %d", len(dimensions))
+	}
+	for _, dimension := range dimensions {
+		want := expected[dimension.Name]
+		if len(dimension.Readings) != len(want) {
+			t.Fatalf("%s: expected %d readings, got %+v", dimension.Name, len(want), dimension.Readings)
+		}
+		for i, reading := range dimension.Readings {
+			if math.Abs(reading.Score-want[i]) > 1e-9 {
+				t.Errorf("%s %s: expected %f, got %f", dimension.Name, reading.Quiz, want[i], reading.Score)
+			}
+		}
+	}
+
+	if !dimensions[0].Disagree() || dimensions[0].Spread() != 110 {
+		t.Errorf("expected the economic readings to disagree by 110 points, got %f", dimensions[0].Spread())
+	}
+	if dimensions[1].Disagree() {
+		t.Errorf("expected the authority readings to agree, spread %f", dimensions[1].Spread())
+	}
+	if !dimensions[2].Disagree() {
+		t.Error("expected the diplomatic readings to disagree")
+	}
+}
+
+func TestDescribeProfileScore(t *testing.T) {
+	tests := []struct {
+		score    float64
+		expected string
+	}{
+		{0, "centre"},
+		{-9.9, "centre"},
+		{30, "moderately right"},
+		{-50, "strongly left"},
+	}
+	for _, tt := range tests {
+		if got := describeProfileScore(tt.score, "Left", "Right"); got != tt.expected {
+			t.Errorf("describeProfileScore(%f) = %q, want %q", tt.score, got, tt.expected)
+		}
+	}
+}
+
+func TestHandleCombinedProfile(t *testing.T) {
+	resetState()
+
+	response, err := handleCombinedProfile(context.Background(), createMockRequest("combined_profile", nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !isErrorResult(response) || !strings.Contains(extractTextContent(response), "no answers yet") {
+		t.Errorf("expected an error before any quiz is taken, got: %s", extractTextContent(response))
+	}
+
+	handlePoliticalCompass(context.Background(), createRequestWithAnswer(""))
+	for i := 0; i < len(politicalcompass.AllQuestions); i++ {
+		handlePoliticalCompass(context.Background(), createRequestWithAnswer("strongly_agree"))
+	}
+	response, _ = handleCombinedProfile(context.Background(), createMockRequest("combined_profile", nil))
+	content := extractTextContent(response)
+	for _, expected := range []string{"Political Compass: complete (62/62 answered)", "8values: not taken", "Take another quiz", "No quiz taken so far measures this dimension"} {
+		if !strings.Contains(content, expected) {
+			t.Errorf("expected %q with only the compass taken, got: %s", expected, content)
+		}
+	}
+
+	handleEightValues(context.Background(), createRequestWithAnswer(""))
+	for i := 0; i < 10; i++ {
+		handleEightValues(context.Background(), createRequestWithAnswer("strongly_disagree"))
+	}
+	response, _ = handleCombinedProfile(context.Background(), createMockRequest("combined_profile", nil))
+	content = extractTextContent(response)
+	for _, expected := range []string{"8values: in progress, provisional (10/70 answered)", "- Average:", "8values (provisional)", "Politiscales (not taken)", "<svg", "</svg>"} {
+		if !strings.Contains(content, expected) {
+			t.Errorf("expected %q in combined profile, got: %s", expected, content)
+		}
+	}
+	if strings.Contains(content, "Take another quiz") {
+		t.Error("expected a comparison once two quizzes are taken")
+	}
+	if len(quizState.Responses) != len(politicalcompass.AllQuestions) || len(eightValuesQuizState.Responses) != 10 {
+		t.Error("the combined profile should not change the quiz state")
+	}
+}
+
+func TestCombinedProfileSVGFlagsDisagreement(t *testing.T) {
+	results := map[string]exportedResult{
+		politicalCompassQuiz: {Quiz: politicalCompassQuiz, Complete: true, Scores: []exportedScore{{Axis: "Economic", Score: -9}, {Axis: "Social", Score: 0}}},
+		eightValuesQuiz:      {Quiz: eightValuesQuiz, Complete: true, Scores: []exportedScore{{Axis: "Economic", Score: 10}, {Axis: "Diplomatic", Score: 50}, {Axis: "Government", Score: 50}}},
+	}
+	svg := combinedProfileSVG(combinedProfile(results), results)
+	if strings.Count(svg, "Quizzes disagree") != 1 || !strings.Contains(svg, "170 points apart") {
+		t.Errorf("expected only the economic dimension to be flagged, got: %s", svg)
+	}
+	// Markers: circles for the compass, squares for 8values, plus one of each in the legend
+	if strings.Count(svg, "<circle") != 3 || strings.Count(svg, `width="15"`) != 4 || strings.Count(svg, "<polygon") != 1 {
+		t.Errorf("unexpected markers in: %s", svg)
+	}
+}
@@ -0,0 +1,263 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/eightvalues"
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+// mappedAnswer is an answer carried over to a question of another quiz
+type mappedAnswer struct {
+	question int     // question index in the target quiz
+	value    float64 // the carried-over answer on the target quiz's answer positions
+	source   itemRef
+	answer   float64 // the answer given to the source question
+}
+
+// mappedAnswers carries the answers given in the source quizzes over to the
+// target quiz, in question ID order. Earlier sources win when two map to the same
+// question. Slider answers count as the nearest fixed answer, and neutral answers
+// are dropped for the political compass, which has no neutral option.
+func mappedAnswers(target string, sources []string) []mappedAnswer {
+	values := answeredValues()
+	found := map[int]mappedAnswer{}
+	for _, source := range sources {
+		if source == target {
+			continue
+		}
+		for _, mapping := range itemLinks {
+			if mapping.kind != carriedLink {
+				continue
+			}
+			from, to := mapping.first, mapping.second
+			if to.quiz == source && from.quiz == target {
+				from, to = to, from
+			}
+			if from.quiz != source || to.quiz != target {
+				continue
+			}
+			answer, ok := values[source][questionIndex(source, from.key)]
+			if !ok {
+				continue
+			}
+			// Answers carry over by category, from strongly disagree (1) to strongly agree (5)
+			code := shareAnswerCode(source, answer)
+			if !mapping.same {
+				code = 6 - code
+			}
+			question := questionIndex(target, to.key)
+			value := shareAnswerPositions[target][code-1]
+			if _, taken := found[question]; taken || math.IsNaN(value) {
+				continue
+			}
+			found[question] = mappedAnswer{question: question, value: value, source: from, answer: answer}
+		}
+	}
+
+	answers := make([]mappedAnswer, 0, len(found))
+	for _, answer := range found {
+		answers = append(answers, answer)
+	}
+	sort.Slice(answers, func(i, j int) bool { return answers[i].question < answers[j].question })
+	return answers
+}
+
+// otherQuizzes returns every quiz but the given one
+func otherQuizzes(quiz string) []string {
+	var others []string
+	for _, other := range shareQuizIDs {
+		if other != quiz {
+			others = append(others, other)
+		}
+	}
+	return others
+}
+
+// formatMappedAnswers lists carried-over answers with the questions they came from
+func formatMappedAnswers(target string, answers []mappedAnswer) string {
+	text := ""
+	for _, answer := range answers {
+		text += fmt.Sprintf("- \"%s\" → %s (from %s: \"%s\", %s)\n",
+			questionText(itemRef{target, questionKey(target, answer.question)}), describeAnswer(answer.value),
+			quizTitles[answer.source.quiz], questionText(answer.source), describeAnswer(answer.answer))
+	}
+	return text
+}
+
+// prefillHint tells a user starting a quiz how many questions they could skip
+func prefillHint(quiz string) string {
+	count := len(mappedAnswers(quiz, otherQuizzes(quiz)))
+	if count == 0 {
+		return ""
+	}
+	return fmt.Sprintf("\n\n💡 %d question(s) in this quiz match answers you already gave in another quiz. "+
+		"To skip them, reset the quiz and start it again with prefill set to true.", count)
+}
+
+// startPrefilled starts a quiz with the answers carried over from the other
+// quizzes, waiting on the first question left to answer
+func startPrefilled(quiz string, adaptive bool, margin float64, length int, review bool) *mcp.CallToolResult {
+	answers := mappedAnswers(quiz, otherQuizzes(quiz))
+	if length > 0 {
+		subset := map[int]bool{}
+		shortForm := eightValuesShortForm
+		if quiz == politiscalesQuiz {
+			shortForm = politiscalesShortForm
+		}
+		for _, question := range shortForm(length) {
+			subset[question] = true
+		}
+		kept := answers[:0]
+		for _, answer := range answers {
+			if subset[answer.question] {
+				kept = append(kept, answer)
+			}
+		}
+		answers = kept
+	}
+	if len(answers) == 0 {
+		return mcp.NewToolResultError(fmt.Sprintf("nothing to pre-fill: none of your answers in the other quizzes match a %s question yet; start the quiz without prefill", quizTitles[quiz]))
+	}
+
+	decoded := decodedShareCode{quiz: quiz, review: review}
+	decoded.mode, decoded.parameter = shareMode(adaptive, margin, length, "")
+	for _, answer := range answers {
+		decoded.answers = append(decoded.answers, answeredItem{question: answer.question, value: answer.value})
+	}
+	next := restoreShareCode(decoded)
+
+	var language, question, options string
+	var total int
+	switch quiz {
+	case politicalCompassQuiz:
+		language, total, options = politicalCompassLanguage, len(shuffledQuestions), "strongly_disagree, disagree, agree, or strongly_agree"
+		if next >= 0 {
+			question = getPoliticalCompassQuestionText(politicalcompass.AllQuestions[shuffledQuestions[next]].Text)
+		}
+	case eightValuesQuiz:
+		language, total, options = eightValuesLanguage, len(eightValuesShuffledQuestions), "strongly_disagree, disagree, neutral, agree, or strongly_agree"
+		if next >= 0 {
+			question = getEightValuesQuestionText(eightvalues.Questions[eightValuesShuffledQuestions[next]].Text)
+		}
+	default:
+		language, total, options = politiscalesLanguage, len(politiscalesShuffledQuestions), "strongly_disagree, disagree, neutral, agree, or strongly_agree"
+		if next >= 0 {
+			question = getPolitiscalesQuestionText(politiscales.Questions[politiscalesShuffledQuestions[next]].Text)
+		}
+	}
+
+	text := fmt.Sprintf("🗳️ %s Quiz Started with %d answer(s) pre-filled! (Language: %s)\n\n"+
+		"**Pre-filled from your other quizzes:**\n%s\nTo answer these yourself, reset the quiz and start it without prefill.\n\n",
+		quizTitles[quiz], len(answers), language, formatMappedAnswers(quiz, answers))
+	if next < 0 && review {
+		return mcp.NewToolResultText(text + "Every question in this quiz was pre-filled.\n\n" + reviewList(quiz))
+	}
+	if next < 0 {
+		result, err := currentResult(quiz)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error())
+		}
+		return mcp.NewToolResultText(text + "Every question in this quiz was pre-filled.\n\n" + formatResultScores(result) + "\n" + resultChart(result))
+	}
+	text += fmt.Sprintf("Question %d of %d:\n%s\n\nPlease respond with: %s", next+1, total, question, options)
+	if quiz != politiscalesQuiz {
+		text += "\n\n**Important Instructions:**\n" +
+			"1. Present this question in the chat for the user to see\n" +
+			"2. After the user provides their response, show both the question and their answer in chat\n" +
+			"3. Then call this tool again with their response to continue to the next question"
+	}
+	return mcp.NewToolResultText(text)
+}
+
+// estimateResult scores the answers carried over to the target quiz. Axes no
+// carried-over answer bears on are listed in unestimated.
+func estimateResult(quiz string, answers []mappedAnswer) (result exportedResult, unestimated []string) {
+	result = exportedResult{Quiz: quiz, Title: quizTitles[quiz], Mode: "estimate", Answered: len(answers)}
+	switch quiz {
+	case eightValuesQuiz:
+		result.Language, result.Total = eightValuesLanguage, len(eightvalues.Questions)
+		scored := map[int]float64{}
+		var covered [4]bool
+		for _, answer := range answers {
+			scored[answer.question] = answer.value
+			for axis, effect := range eightvalues.Questions[answer.question].Effect {
+				covered[axis] = covered[axis] || effect != 0
+			}
+		}
+		for axis, score := range scoreEightValues(scored) {
+			label := eightValuesLabel(axis, score)
+			if !covered[axis] {
+				label = "(not estimated)"
+				unestimated = append(unestimated, eightValuesAxisNames[axis])
+			}
+			result.Scores = append(result.Scores, exportedScore{Axis: eightValuesAxisNames[axis], Score: score, Label: label})
+		}
+
+	default:
+		result.Language, result.Total = politiscalesLanguage, len(politiscales.Questions)
+		responses := map[int32]float64{}
+		for _, answer := range answers {
+			responses[politiscales.Questions[answer.question].Index] = answer.value
+		}
+		breakdowns := breakdownPolitiscales(responses)
+		for _, axis := range politiscales.Axes {
+			breakdown := breakdowns[axis.Name]
+			if breakdown.Sum == 0 {
+				unestimated = append(unestimated, axis.Name)
+				continue
+			}
+			result.Scores = append(result.Scores, exportedScore{Axis: axis.Name, Score: breakdown.Percentage() * breakdown.Ratio})
+		}
+	}
+	return result, unestimated
+}
+
+// handleEstimateResults predicts a provisional profile in one quiz from the
+// answers already given to near-identical questions in the others
+func handleEstimateResults(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	quiz, err := request.RequireString("quiz")
+	if err != nil {
+		return mcp.NewToolResultError("quiz is required"), nil
+	}
+	if quiz != eightValuesQuiz && quiz != politiscalesQuiz {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid quiz: %s. Estimates are available for %s and %s; the political compass position is normalised over all of its questions, so use prefill to carry answers over instead",
+			quiz, eightValuesQuiz, politiscalesQuiz)), nil
+	}
+	sources := otherQuizzes(quiz)
+	if from := request.GetString("from", ""); from != "" {
+		if _, ok := quizTitles[from]; !ok || from == quiz {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid from: %s. Please use another quiz than %s", from, quiz)), nil
+		}
+		sources = []string{from}
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	answers := mappedAnswers(quiz, sources)
+	if len(answers) == 0 {
+		return mcp.NewToolResultError(fmt.Sprintf("nothing to estimate from: none of your answers match a %s question yet", quizTitles[quiz])), nil
+	}
+	result, unestimated := estimateResult(quiz, answers)
+
+	var from []string
+	for _, source := range sources {
+		from = append(from, quizTitles[source])
+	}
+	text := fmt.Sprintf("🔭 **Estimated %s Profile**\n\n*Provisional: predicted from %d answer(s) to near-identical questions in %s. Take the quiz for real scores.*\n\n"+
+		"**Carried-over answers:**\n%s\n", result.Title, len(answers), strings.Join(from, " and "), formatMappedAnswers(quiz, answers))
+	text += formatResultScores(result)
+	if len(unestimated) > 0 {
+		text += fmt.Sprintf("- Not estimated (no matching questions): %s\n", strings.Join(unestimated, ", "))
+	}
+	text += "\n" + resultChart(result) + "\n\n" +
+		fmt.Sprintf("Start the `%s` quiz with prefill set to true to keep these answers and skip their questions.", quiz)
+	return mcp.NewToolResultText(text), nil
+}
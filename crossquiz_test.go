@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/x86ed/MCP-PoliticalCompass/v3/eightvalues"
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+)
+
+// completePoliticalCompass answers every compass question with the same answer
+func completePoliticalCompass(answer string) {
+	handlePoliticalCompass(context.Background(), createRequestWithAnswer(""))
+	for i := 0; i < len(politicalcompass.AllQuestions); i++ {
+		handlePoliticalCompass(context.Background(), createRequestWithAnswer(answer))
+	}
+}
+
+func TestCarriedLinksResolve(t *testing.T) {
+	seen := map[[2]itemRef]bool{}
+	for _, mapping := range itemLinks {
+		if mapping.kind != carriedLink {
+			continue
+		}
+		for _, ref := range []itemRef{mapping.first, mapping.second} {
+			if questionIndex(ref.quiz, ref.key) < 0 {
+				t.Errorf("unknown question %s/%s", ref.quiz, ref.key)
+			}
+		}
+		if mapping.first.quiz == mapping.second.quiz {
+			t.Errorf("mapping %v stays within one quiz", mapping)
+		}
+		pair := [2]itemRef{mapping.first, mapping.second}
+		if seen[pair] {
+			t.Errorf("duplicate mapping %v", mapping)
+		}
+		seen[pair] = true
+	}
+}
+
+func TestMappedAnswersCarryCategories(t *testing.T) {
+	resetState()
+	completePoliticalCompass("agree")
+
+	answers := mappedAnswers(politiscalesQuiz, []string{politicalCompassQuiz})
+	if len(answers) != 6 {
+		t.Fatalf("expected the 6 compass/politiscales mappings to carry over, got %d", len(answers))
+	}
+	for _, answer := range answers {
+		key := questionKey(politiscalesQuiz, answer.question)
+		expected := 2.0 / 3.0
+		if key == "punitive_justice_terrorism_protection" || key == "regulation_income_tax_redistribution" {
+			expected = -2.0 / 3.0
+		}
+		if answer.value != expected {
+			t.Errorf("%s: expected the compass agree to carry over as %f, got %f", key, expected, answer.value)
+		}
+	}
+	for i := 1; i < len(answers); i++ {
+		if answers[i-1].question >= answers[i].question {
+			t.Error("expected the answers in question ID order")
+		}
+	}
+
+	// The compass has no neutral answer, so neutral 8values answers do not carry over
+	resetState()
+	handleEightValues(context.Background(), createRequestWithAnswer(""))
+	for i := 0; i < len(eightvalues.Questions); i++ {
+		handleEightValues(context.Background(), createRequestWithAnswer("neutral"))
+	}
+	if answers := mappedAnswers(politicalCompassQuiz, []string{eightValuesQuiz}); len(answers) != 0 {
+		t.Errorf("expected neutral answers to be dropped for the compass, got %d", len(answers))
+	}
+}
+
+func TestEstimateResults(t *testing.T) {
+	resetState()
+
+	response, _ := handleEstimateResults(context.Background(), createMockRequest("estimate_results", map[string]interface{}{"quiz": "eight_values"}))
+	if !isErrorResult(response) || !strings.Contains(extractTextContent(response), "nothing to estimate from") {
+		t.Errorf("expected an error with nothing answered, got: %s", extractTextContent(response))
+	}
+	response, _ = handleEstimateResults(context.Background(), createMockRequest("estimate_results", map[string]interface{}{"quiz": "political_compass"}))
+	if !isErrorResult(response) || !strings.Contains(extractTextContent(response), "use prefill") {
+		t.Errorf("expected compass estimates to be refused, got: %s", extractTextContent(response))
+	}
+
+	completePoliticalCompass("strongly_agree")
+	response, err := handleEstimateResults(context.Background(), createMockRequest("estimate_results", map[string]interface{}{
+		"quiz": "eight_values", "from": "political_compass",
+	}))
+	if err != nil || isErrorResult(response) {
+		t.Fatalf("unexpected error: %v %s", err, extractTextContent(response))
+	}
+	content := extractTextContent(response)
+	for _, expected := range []string{"Estimated 8values Profile", "17 answer(s)", "The freer the markets, the freer the people.", "- Economic:", "<svg", "prefill"} {
+		if !strings.Contains(content, expected) {
+			t.Errorf("expected %q in estimate, got: %s", expected, content)
+		}
+	}
+	if len(eightValuesQuizState.Responses) != 0 {
+		t.Error("estimating should not start the quiz")
+	}
+
+	result, unestimated := estimateResult(eightValuesQuiz, mappedAnswers(eightValuesQuiz, []string{politicalCompassQuiz}))
+	if len(unestimated) != 0 || len(result.Scores) != 4 {
+		t.Errorf("expected every 8values axis to be estimated, missing %v", unestimated)
+	}
+
+	result, unestimated = estimateResult(politiscalesQuiz, mappedAnswers(politiscalesQuiz, []string{politicalCompassQuiz}))
+	if len(unestimated) == 0 || len(result.Scores)+len(unestimated) != 23 {
+		t.Errorf("expected some politiscales axes to be unestimated, got %d scores and %v", len(result.Scores), unestimated)
+	}
+}
+
+func TestPrefillSkipsMappedQuestions(t *testing.T) {
+	resetState()
+
+	response, _ := handleEightValues(context.Background(), createMockRequest("eight_values", map[string]interface{}{"answer": "", "prefill": true}))
+	if !isErrorResult(response) || !strings.Contains(extractTextContent(response), "nothing to pre-fill") {
+		t.Fatalf("expected an error with nothing to pre-fill, got: %s", extractTextContent(response))
+	}
+	if eightValuesQuestionCount != 0 {
+		t.Error("a failed prefill should leave the quiz unstarted")
+	}
+
+	completePoliticalCompass("strongly_disagree")
+
+	// Starting without prefill mentions it
+	response, _ = handleEightValues(context.Background(), createRequestWithAnswer(""))
+	if !strings.Contains(extractTextContent(response), "17 question(s) in this quiz match answers") {
+		t.Errorf("expected a prefill hint, got: %s", extractTextContent(response))
+	}
+
+	resetEightValuesState()
+	response, _ = handleEightValues(context.Background(), createMockRequest("eight_values", map[string]interface{}{"answer": "", "prefill": true}))
+	content := extractTextContent(response)
+	if isErrorResult(response) || !strings.Contains(content, "Started with 17 answer(s) pre-filled") || !strings.Contains(content, "Question 18 of 70") {
+		t.Fatalf("unexpected prefill start: %s", content)
+	}
+	if len(eightValuesQuizState.Responses) != 17 {
+		t.Errorf("expected 17 pre-filled answers, got %d", len(eightValuesQuizState.Responses))
+	}
+	index := questionIndex(eightValuesQuiz, "free_markets_free_people")
+	for i, question := range eightValuesShuffledQuestions[:17] {
+		if question == index && eightValuesQuizState.Responses[i] != eightvalues.StronglyDisagree {
+			t.Errorf("expected the free market answer to carry over as strongly disagree, got %f", eightValuesQuizState.Responses[i])
+		}
+	}
+
+	// The quiz carries on from the first question left
+	handleEightValues(context.Background(), createRequestWithAnswer("agree"))
+	if len(eightValuesQuizState.Responses) != 18 || eightValuesQuizState.Responses[17] != eightvalues.Agree {
+		t.Errorf("expected the next answer to be recorded, got %v", eightValuesQuizState.Responses)
+	}
+}
+
+func TestPrefillShortFormKeepsItsQuestions(t *testing.T) {
+	resetState()
+	completePoliticalCompass("agree")
+
+	response, _ := handlePolitiscales(context.Background(), createMockRequest("politiscales", map[string]interface{}{"answer": "", "prefill": true, "length": 40}))
+	if isErrorResult(response) {
+		t.Fatalf("unexpected error: %s", extractTextContent(response))
+	}
+	subset := map[int]bool{}
+	for _, question := range politiscalesShortForm(40) {
+		subset[question] = true
+	}
+	if len(politiscalesShuffledQuestions) != 40 || politiscalesQuizState.Length != 40 {
+		t.Errorf("expected the 40-question short form, got %d questions", len(politiscalesShuffledQuestions))
+	}
+	for _, item := range politiscalesAnswers() {
+		if !subset[item.question] {
+			t.Errorf("pre-filled question %d is outside the short form", item.question)
+		}
+	}
+}
@@ -0,0 +1,474 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/x86ed/MCP-PoliticalCompass/v3/eightvalues"
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+// Diagnostic thresholds. Answers are on the -1 to 1 agreement scale, where the
+// weakest non-neutral answer is 1/3 (political compass agree).
+const (
+	straightLineRun       = 10  // identical answers in a row that count as straight-lining
+	keyedMinimum          = 3   // keyed questions needed in each direction to judge acquiescence
+	acquiescenceThreshold = 0.3 // mean agreement in both directions that counts as acquiescence
+	contradictionLimit    = 3   // contradictory pairs that make a result unreliable on their own
+)
+
+// Quiz names used in diagnostics and item references
+const (
+	politicalCompassQuiz = "political_compass"
+	eightValuesQuiz      = "eight_values"
+	politiscalesQuiz     = "politiscales"
+)
+
+// quizTitles are the display names of the quizzes
+var quizTitles = map[string]string{
+	politicalCompassQuiz: "Political Compass",
+	eightValuesQuiz:      "8values",
+	politiscalesQuiz:     "Politiscales",
+}
+
+// answeredItem is one answer in the order it was given
+type answeredItem struct {
+	question int
+	value    float64
+}
+
+// itemRef identifies a question by quiz and translation key
+type itemRef struct {
+	quiz string
+	key  string
+}
+
+// linkKind says what a link between two questions is used for
+type linkKind int
+
+const (
+	checkedLink linkKind = iota // Answers on conflicting sides are flagged as a contradiction
+	carriedLink                 // Also carries answers over between quizzes; only for questions asking the same thing
+)
+
+// itemLink is two questions that ask the same thing (same is true) or opposite
+// things, so answering them on conflicting sides is a contradiction
+type itemLink struct {
+	first, second itemRef
+	same          bool
+	kind          linkKind
+}
+
+// itemLinks lists equivalent and opposed questions within and across quizzes.
+// Every link is checked for contradictions; carried links also prefill answers.
+var itemLinks = []itemLink{
+	// Political compass and 8values
+	{itemRef{politicalCompassQuiz, "free_market_free_people"}, itemRef{eightValuesQuiz, "free_markets_free_people"}, true, carriedLink},
+	{itemRef{politicalCompassQuiz, "ability_need"}, itemRef{eightValuesQuiz, "ability_needs"}, true, carriedLink},
+	{itemRef{politicalCompassQuiz, "question_authority"}, itemRef{eightValuesQuiz, "question_authority"}, true, carriedLink},
+	{itemRef{politicalCompassQuiz, "paid_medical_care"}, itemRef{eightValuesQuiz, "healthcare_ability_to_pay"}, true, carriedLink},
+	{itemRef{politicalCompassQuiz, "sex_outside_marriage"}, itemRef{eightValuesQuiz, "sex_outside_marriage"}, true, carriedLink},
+	{itemRef{politicalCompassQuiz, "abortion_illegal"}, itemRef{eightValuesQuiz, "abortion_prohibition"}, true, carriedLink},
+	{itemRef{politicalCompassQuiz, "country_right_or_wrong"}, itemRef{eightValuesQuiz, "side_with_country"}, true, carriedLink},
+	{itemRef{politicalCompassQuiz, "charity_over_social_security"}, itemRef{eightValuesQuiz, "private_charity"}, true, carriedLink},
+	{itemRef{politicalCompassQuiz, "school_religious_values"}, itemRef{eightValuesQuiz, "religious_education"}, true, carriedLink},
+	{itemRef{politicalCompassQuiz, "marijuana_decriminalise"}, itemRef{eightValuesQuiz, "drug_legalization"}, true, carriedLink},
+	{itemRef{politicalCompassQuiz, "protectionism_trade"}, itemRef{eightValuesQuiz, "tariffs"}, true, carriedLink},
+	{itemRef{politicalCompassQuiz, "no_savage_peoples"}, itemRef{eightValuesQuiz, "no_superior_cultures"}, true, carriedLink},
+	{itemRef{politicalCompassQuiz, "corporate_environment_regulation"}, itemRef{eightValuesQuiz, "environmental_regulations"}, true, carriedLink},
+	{itemRef{politicalCompassQuiz, "hierarchy_obedience"}, itemRef{eightValuesQuiz, "hierarchical_state"}, true, carriedLink},
+	{itemRef{politicalCompassQuiz, "surveillance_wrongdoers"}, itemRef{eightValuesQuiz, "government_surveillance"}, true, carriedLink},
+	{itemRef{politicalCompassQuiz, "rich_overtaxed"}, itemRef{eightValuesQuiz, "tax_the_rich"}, false, carriedLink},
+	{itemRef{politicalCompassQuiz, "counter_terrorism_liberties"}, itemRef{eightValuesQuiz, "civil_liberties_terrorism"}, false, carriedLink},
+
+	// Political compass and politiscales
+	{itemRef{politicalCompassQuiz, "death_penalty"}, itemRef{politiscalesQuiz, "conservative_death_penalty_justification"}, true, carriedLink},
+	{itemRef{politicalCompassQuiz, "punishment_over_rehabilitation"}, itemRef{politiscalesQuiz, "punitive_justice_punishment_goal"}, true, carriedLink},
+	{itemRef{politicalCompassQuiz, "abortion_illegal"}, itemRef{politiscalesQuiz, "conservative_abortion_restriction"}, true, carriedLink},
+	{itemRef{politicalCompassQuiz, "free_market_monopoly_restrictions"}, itemRef{politiscalesQuiz, "regulation_monopoly_prevention"}, true, carriedLink},
+	{itemRef{politicalCompassQuiz, "counter_terrorism_liberties"}, itemRef{politiscalesQuiz, "punitive_justice_terrorism_protection"}, false, carriedLink},
+	{itemRef{politicalCompassQuiz, "rich_overtaxed"}, itemRef{politiscalesQuiz, "regulation_income_tax_redistribution"}, false, carriedLink},
+	{itemRef{politicalCompassQuiz, "question_authority"}, itemRef{politiscalesQuiz, "punitive_justice_order_authority"}, false, checkedLink},
+
+	// 8values and politiscales
+	{itemRef{eightValuesQuiz, "civil_liberties_terrorism"}, itemRef{politiscalesQuiz, "punitive_justice_terrorism_protection"}, true, carriedLink},
+	{itemRef{eightValuesQuiz, "spread_religion"}, itemRef{politiscalesQuiz, "religion_diffusion"}, true, carriedLink},
+	{itemRef{eightValuesQuiz, "assisted_suicide"}, itemRef{politiscalesQuiz, "progressive_euthanasia_legalization"}, true, carriedLink},
+	{itemRef{eightValuesQuiz, "open_borders"}, itemRef{politiscalesQuiz, "internationalism_border_removal"}, true, carriedLink},
+	{itemRef{eightValuesQuiz, "climate_change_threat"}, itemRef{politiscalesQuiz, "ecology_climate_change_combat"}, true, carriedLink},
+	{itemRef{eightValuesQuiz, "tax_the_rich"}, itemRef{politiscalesQuiz, "regulation_income_tax_redistribution"}, true, carriedLink},
+	{itemRef{eightValuesQuiz, "single_payer_healthcare"}, itemRef{politiscalesQuiz, "communism_public_health"}, true, carriedLink},
+	{itemRef{eightValuesQuiz, "public_utilities"}, itemRef{politiscalesQuiz, "communism_public_energy_infrastructure"}, true, carriedLink},
+	{itemRef{eightValuesQuiz, "abortion_prohibition"}, itemRef{politiscalesQuiz, "conservative_abortion_restriction"}, true, carriedLink},
+	{itemRef{eightValuesQuiz, "state_threat_liberty"}, itemRef{politiscalesQuiz, "anarchism_state_abolition"}, true, carriedLink},
+	{itemRef{eightValuesQuiz, "foreigners_concern"}, itemRef{politiscalesQuiz, "internationalism_global_concern"}, true, carriedLink},
+	{itemRef{eightValuesQuiz, "traditions_no_value"}, itemRef{politiscalesQuiz, "progressive_tradition_questioning"}, true, carriedLink},
+	{itemRef{eightValuesQuiz, "nonviolent_protest"}, itemRef{politiscalesQuiz, "reform_violence_solution"}, true, carriedLink},
+	{itemRef{eightValuesQuiz, "same_sex_marriage"}, itemRef{politiscalesQuiz, "conservative_homosexual_equality"}, false, carriedLink},
+
+	// Within a quiz
+	{itemRef{politicalCompassQuiz, "punishment_over_rehabilitation"}, itemRef{politicalCompassQuiz, "rehabilitation_waste"}, true, checkedLink},
+	{itemRef{eightValuesQuiz, "free_markets_free_people"}, itemRef{eightValuesQuiz, "intervention_threat"}, true, checkedLink},
+	{itemRef{eightValuesQuiz, "consumer_protection"}, itemRef{eightValuesQuiz, "intervention_threat"}, false, checkedLink},
+	{itemRef{eightValuesQuiz, "world_government"}, itemRef{eightValuesQuiz, "abolish_un"}, false, checkedLink},
+	{itemRef{eightValuesQuiz, "maintain_traditions"}, itemRef{eightValuesQuiz, "traditions_no_value"}, false, checkedLink},
+	{itemRef{politiscalesQuiz, "communism_wealth_ownership"}, itemRef{politiscalesQuiz, "capitalism_rich_poor_acceptance"}, false, checkedLink},
+}
+
+// keyedAnswer is an answer to a question keyed towards (positive) or against an axis
+type keyedAnswer struct {
+	axis     int
+	positive bool
+	value    float64
+}
+
+// contradiction is a contradictory pair together with the answers given
+type contradiction struct {
+	pair                      itemLink
+	firstAnswer, secondAnswer float64
+}
+
+// responseDiagnostics summarises the quality of one quiz's answers
+type responseDiagnostics struct {
+	Answered       int
+	LongestRun     int      // longest run of identical answers
+	RunValue       float64  // the answer repeated in that run
+	Acquiescent    []string // axes where questions keyed both ways were agreed with
+	NaySaying      []string // axes where questions keyed both ways were disagreed with
+	Contradictions []contradiction
+}
+
+// StraightLining reports whether a long run of identical answers was given
+func (d responseDiagnostics) StraightLining() bool {
+	return d.LongestRun >= straightLineRun
+}
+
+// LowReliability reports whether the answers look too patterned or inconsistent
+// for the scores to be trusted
+func (d responseDiagnostics) LowReliability() bool {
+	return d.StraightLining() || len(d.Acquiescent) > 0 || len(d.NaySaying) > 0 ||
+		len(d.Contradictions) >= contradictionLimit
+}
+
+// reasons lists the problems found, one short phrase each
+func (d responseDiagnostics) reasons() []string {
+	var reasons []string
+	if d.StraightLining() {
+		reasons = append(reasons, fmt.Sprintf("straight-lining (%d × \"%s\" in a row)", d.LongestRun, describeAnswer(d.RunValue)))
+	}
+	if len(d.Acquiescent) > 0 {
+		reasons = append(reasons, "acquiescence on "+strings.Join(d.Acquiescent, ", "))
+	}
+	if len(d.NaySaying) > 0 {
+		reasons = append(reasons, "nay-saying on "+strings.Join(d.NaySaying, ", "))
+	}
+	if len(d.Contradictions) > 0 {
+		reasons = append(reasons, fmt.Sprintf("%d contradictory answer pair(s)", len(d.Contradictions)))
+	}
+	return reasons
+}
+
+// describeAnswer names the answer closest to a value on the -1 to 1 scale
+func describeAnswer(value float64) string {
+	switch {
+	case value >= 0.75:
+		return "strongly agree"
+	case value > 0.1:
+		return "agree"
+	case value <= -0.75:
+		return "strongly disagree"
+	case value < -0.1:
+		return "disagree"
+	default:
+		return "neutral"
+	}
+}
+
+// answerSide is 1 for agreement, -1 for disagreement and 0 for neutral answers
+func answerSide(value float64) int {
+	switch {
+	case value > 0.1:
+		return 1
+	case value < -0.1:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// longestRun finds the longest run of identical consecutive answers
+func longestRun(items []answeredItem) (length int, value float64) {
+	run := 0
+	for i, item := range items {
+		if i > 0 && math.Abs(item.value-items[i-1].value) < 1e-9 {
+			run++
+		} else {
+			run = 1
+		}
+		if run > length {
+			length, value = run, item.value
+		}
+	}
+	return length, value
+}
+
+// keyedBias returns the axes on which questions keyed in both directions were, on
+// average, agreed with (acquiescent) or disagreed with (nay-saying). Someone with
+// a consistent view agrees with one direction and disagrees with the other.
+func keyedBias(answers []keyedAnswer, axisNames []string) (acquiescent, naySaying []string) {
+	for axis, name := range axisNames {
+		var positive, negative []float64
+		for _, answer := range answers {
+			if answer.axis != axis {
+				continue
+			}
+			if answer.positive {
+				positive = append(positive, answer.value)
+			} else {
+				negative = append(negative, answer.value)
+			}
+		}
+		if len(positive) < keyedMinimum || len(negative) < keyedMinimum {
+			continue
+		}
+		positiveMean, negativeMean := mean(positive), mean(negative)
+		if positiveMean >= acquiescenceThreshold && negativeMean >= acquiescenceThreshold {
+			acquiescent = append(acquiescent, name)
+		} else if positiveMean <= -acquiescenceThreshold && negativeMean <= -acquiescenceThreshold {
+			naySaying = append(naySaying, name)
+		}
+	}
+	return acquiescent, naySaying
+}
+
+// mean returns the arithmetic mean of the values
+func mean(values []float64) float64 {
+	var sum float64
+	for _, value := range values {
+		sum += value
+	}
+	return sum / float64(len(values))
+}
+
+// questionIndex finds a question by its translation key, returning -1 if unknown
+func questionIndex(quiz, key string) int {
+	switch quiz {
+	case politicalCompassQuiz:
+		for i, question := range politicalcompass.AllQuestions {
+			if question.Text == key {
+				return i
+			}
+		}
+	case eightValuesQuiz:
+		for i, question := range eightvalues.Questions {
+			if question.Text == key {
+				return i
+			}
+		}
+	case politiscalesQuiz:
+		for i, question := range politiscales.Questions {
+			if question.Text == key {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// questionText returns a question's text in its quiz's current language
+func questionText(ref itemRef) string {
+	switch ref.quiz {
+	case politicalCompassQuiz:
+		return getPoliticalCompassQuestionText(ref.key)
+	case eightValuesQuiz:
+		return getEightValuesQuestionText(ref.key)
+	default:
+		return getPolitiscalesQuestionText(ref.key)
+	}
+}
+
+// answeredValues indexes each quiz's answers by question
+func answeredValues() map[string]map[int]float64 {
+	values := map[string]map[int]float64{}
+	for quiz, items := range map[string][]answeredItem{
+		politicalCompassQuiz: politicalCompassAnswers(),
+		eightValuesQuiz:      eightValuesAnswers(),
+		politiscalesQuiz:     politiscalesAnswers(),
+	} {
+		values[quiz] = map[int]float64{}
+		for _, item := range items {
+			values[quiz][item.question] = item.value
+		}
+	}
+	return values
+}
+
+// findContradictions returns the answered pairs involving the quiz whose answers
+// fall on conflicting sides. Pairs spanning two quizzes need both to be answered
+// in this session.
+func findContradictions(quiz string) []contradiction {
+	values := answeredValues()
+	var found []contradiction
+	for _, pair := range itemLinks {
+		if pair.first.quiz != quiz && pair.second.quiz != quiz {
+			continue
+		}
+		first, firstAnswered := values[pair.first.quiz][questionIndex(pair.first.quiz, pair.first.key)]
+		second, secondAnswered := values[pair.second.quiz][questionIndex(pair.second.quiz, pair.second.key)]
+		if !firstAnswered || !secondAnswered {
+			continue
+		}
+		product := answerSide(first) * answerSide(second)
+		if (pair.same && product < 0) || (!pair.same && product > 0) {
+			found = append(found, contradiction{pair: pair, firstAnswer: first, secondAnswer: second})
+		}
+	}
+	return found
+}
+
+// diagnose runs every check over a quiz's answers
+func diagnose(quiz string, items []answeredItem, keyed []keyedAnswer, axisNames []string) responseDiagnostics {
+	d := responseDiagnostics{Answered: len(items)}
+	d.LongestRun, d.RunValue = longestRun(items)
+	d.Acquiescent, d.NaySaying = keyedBias(keyed, axisNames)
+	d.Contradictions = findContradictions(quiz)
+	return d
+}
+
+// politicalCompassAnswers returns the political compass answers in the order given
+func politicalCompassAnswers() []answeredItem {
+	var items []answeredItem
+	for i, response := range quizState.Responses {
+		if i >= len(shuffledQuestions) {
+			break
+		}
+		items = append(items, answeredItem{question: shuffledQuestions[i], value: politicalCompassAnswerValue(i, response)})
+	}
+	return items
+}
+
+// politicalCompassDiagnostics checks the political compass answers. A question is
+// keyed towards an axis when strong agreement scores higher on it than strong disagreement.
+func politicalCompassDiagnostics() responseDiagnostics {
+	items := politicalCompassAnswers()
+	var keyed []keyedAnswer
+	for _, item := range items {
+		question := politicalcompass.AllQuestions[item.question]
+		for axis, weights := range [][4]float64{question.Economic, question.Social} {
+			if direction := weights[politicalcompass.StronglyAgree] - weights[politicalcompass.StronglyDisagree]; direction != 0 {
+				keyed = append(keyed, keyedAnswer{axis: axis, positive: direction > 0, value: item.value})
+			}
+		}
+	}
+	return diagnose(politicalCompassQuiz, items, keyed, []string{"Economic", "Social"})
+}
+
+// eightValuesAnswers returns the 8values answers in the order given
+func eightValuesAnswers() []answeredItem {
+	var items []answeredItem
+	for i, value := range eightValuesQuizState.Responses {
+		if i >= len(eightValuesShuffledQuestions) {
+			break
+		}
+		items = append(items, answeredItem{question: eightValuesShuffledQuestions[i], value: value})
+	}
+	return items
+}
+
+// eightValuesDiagnostics checks the 8values answers, keying questions by the sign of their effect
+func eightValuesDiagnostics() responseDiagnostics {
+	items := eightValuesAnswers()
+	loads := eightValuesLoads()
+	var keyed []keyedAnswer
+	for _, item := range items {
+		for _, load := range loads[item.question] {
+			keyed = append(keyed, keyedAnswer{axis: load.axis, positive: load.positive, value: item.value})
+		}
+	}
+	return diagnose(eightValuesQuiz, items, keyed, eightValuesAxisNames[:])
+}
+
+// politiscalesAnswers returns the politiscales answers in the order given
+func politiscalesAnswers() []answeredItem {
+	var items []answeredItem
+	for _, question := range politiscalesShuffledQuestions {
+		if value, ok := politiscalesQuizState.Responses[politiscales.Questions[question].Index]; ok {
+			items = append(items, answeredItem{question: question, value: value})
+		}
+	}
+	return items
+}
+
+// politiscalesDiagnostics checks the politiscales answers. Each axis pair is one
+// dimension: agreeing with a question scores towards one side of the pair, so
+// questions are keyed towards the pair's first or second axis.
+func politiscalesDiagnostics() responseDiagnostics {
+	var pairs []string
+	pairIndex := map[string]int{}
+	firstOfPair := map[string]bool{}
+	for _, axis := range politiscales.Axes {
+		if axis.Pair == "" {
+			continue
+		}
+		if _, seen := pairIndex[axis.Pair]; !seen {
+			pairIndex[axis.Pair] = len(pairs)
+			pairs = append(pairs, axis.Pair)
+			firstOfPair[axis.Name] = true
+		}
+	}
+	pairOf := map[string]string{}
+	for _, axis := range politiscales.Axes {
+		pairOf[axis.Name] = axis.Pair
+	}
+
+	items := politiscalesAnswers()
+	var keyed []keyedAnswer
+	for _, item := range items {
+		for _, weight := range politiscales.Questions[item.question].YesWeights {
+			if pair := pairOf[weight.Axis]; pair != "" && weight.Value > 0 {
+				keyed = append(keyed, keyedAnswer{axis: pairIndex[pair], positive: firstOfPair[weight.Axis], value: item.value})
+			}
+		}
+	}
+	return diagnose(politiscalesQuiz, items, keyed, pairs)
+}
+
+// reliabilityWarning flags a low-reliability result for completion messages,
+// pointing at the quiz's status tool, and returns "" when the answers look sound
+func reliabilityWarning(d responseDiagnostics, statusTool string) string {
+	if !d.LowReliability() {
+		return ""
+	}
+	return fmt.Sprintf("⚠️ **Low-reliability result:** %s. These scores may mostly reflect answering patterns rather than views; see `%s` for details.\n\n",
+		strings.Join(d.reasons(), "; "), statusTool)
+}
+
+// formatDiagnostics renders the response quality section of a status report
+func formatDiagnostics(d responseDiagnostics) string {
+	if d.Answered == 0 {
+		return ""
+	}
+
+	text := "\n**Response Quality:**\n"
+	switch {
+	case d.LowReliability():
+		text += "- Reliability: ⚠️ Low (" + strings.Join(d.reasons(), "; ") + ")\n"
+	case len(d.Contradictions) > 0:
+		text += "- Reliability: Some concerns (" + strings.Join(d.reasons(), "; ") + ")\n"
+	default:
+		text += "- Reliability: No issues detected\n"
+	}
+
+	text += fmt.Sprintf("- Longest run of identical answers: %d (\"%s\")\n", d.LongestRun, describeAnswer(d.RunValue))
+	if len(d.Acquiescent) == 0 && len(d.NaySaying) == 0 {
+		text += "- Keyed consistency: no acquiescence or nay-saying detected\n"
+	}
+	for _, c := range d.Contradictions {
+		text += fmt.Sprintf("- Contradiction: %s \"%s\" (%s) vs %s \"%s\" (%s)\n",
+			quizTitles[c.pair.first.quiz], questionText(c.pair.first), describeAnswer(c.firstAnswer),
+			quizTitles[c.pair.second.quiz], questionText(c.pair.second), describeAnswer(c.secondAnswer))
+	}
+	return text
+}
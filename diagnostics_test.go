@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/x86ed/MCP-PoliticalCompass/v3/eightvalues"
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+)
+
+func TestItemLinksResolve(t *testing.T) {
+	seen := map[[2]itemRef]bool{}
+	for _, pair := range itemLinks {
+		for _, ref := range []itemRef{pair.first, pair.second} {
+			if questionIndex(ref.quiz, ref.key) < 0 {
+				t.Errorf("unknown %s question %q", ref.quiz, ref.key)
+			}
+		}
+		if pair.first == pair.second {
+			t.Errorf("pair compares %v with itself", pair.first)
+		}
+		if seen[[2]itemRef{pair.first, pair.second}] || seen[[2]itemRef{pair.second, pair.first}] {
+			t.Errorf("pair %v is listed twice", pair)
+		}
+		seen[[2]itemRef{pair.first, pair.second}] = true
+	}
+}
+
+func TestLongestRun(t *testing.T) {
+	items := []answeredItem{{0, 1}, {1, 0.5}, {2, 0.5}, {3, 0.5}, {4, -1}, {5, -1}}
+	if length, value := longestRun(items); length != 3 || value != 0.5 {
+		t.Errorf("expected a run of 3 × 0.5, got %d × %v", length, value)
+	}
+	if length, _ := longestRun(nil); length != 0 {
+		t.Errorf("expected no run without answers, got %d", length)
+	}
+}
+
+func TestKeyedBias(t *testing.T) {
+	var answers []keyedAnswer
+	for i := 0; i < 4; i++ {
+		// Axis 0: agrees with questions keyed both ways
+		answers = append(answers, keyedAnswer{axis: 0, positive: true, value: 0.5}, keyedAnswer{axis: 0, positive: false, value: 1})
+		// Axis 1: a consistent view
+		answers = append(answers, keyedAnswer{axis: 1, positive: true, value: 1}, keyedAnswer{axis: 1, positive: false, value: -0.5})
+		// Axis 2: disagrees with everything
+		answers = append(answers, keyedAnswer{axis: 2, positive: true, value: -1}, keyedAnswer{axis: 2, positive: false, value: -1})
+	}
+	// Axis 3: too few keyed questions to judge
+	answers = append(answers, keyedAnswer{axis: 3, positive: true, value: 1}, keyedAnswer{axis: 3, positive: false, value: 1})
+
+	acquiescent, naySaying := keyedBias(answers, []string{"A", "B", "C", "D"})
+	if strings.Join(acquiescent, ",") != "A" || strings.Join(naySaying, ",") != "C" {
+		t.Errorf("expected acquiescence on A and nay-saying on C, got %v and %v", acquiescent, naySaying)
+	}
+}
+
+func TestEightValuesStraightLiningFlagged(t *testing.T) {
+	resetState()
+
+	handleEightValues(context.Background(), createRequestWithAnswer(""))
+	var content string
+	for i := 0; i < len(eightvalues.Questions); i++ {
+		response, _ := handleEightValues(context.Background(), createRequestWithAnswer("agree"))
+		content = extractTextContent(response)
+	}
+	if !strings.Contains(content, "Low-reliability result:** straight-lining (70 × \"agree\" in a row)") {
+		t.Errorf("expected a straight-lining warning, got: %s", content)
+	}
+	if !strings.Contains(content, "acquiescence on Economic") {
+		t.Errorf("agreeing with everything should be flagged as acquiescence, got: %s", content)
+	}
+
+	status, _ := handleEightValuesStatus(context.Background(), createEmptyRequest())
+	if !strings.Contains(extractTextContent(status), "- Reliability: ⚠️ Low") {
+		t.Errorf("status should report low reliability, got: %s", extractTextContent(status))
+	}
+}
+
+func TestConsistentAnswersNotFlagged(t *testing.T) {
+	resetState()
+
+	handleEightValues(context.Background(), createRequestWithAnswer(""))
+	var content string
+	for i := 0; i < len(eightvalues.Questions); i++ {
+		// Agree in proportion to how far the question points towards the first pole of each axis
+		question := eightvalues.Questions[eightValuesShuffledQuestions[i]]
+		var direction float64
+		for _, effect := range question.Effect {
+			direction += effect
+		}
+		value := 1.0
+		if i%2 == 1 {
+			value = 0.5
+		}
+		if direction < 0 {
+			value = -value
+		} else if direction == 0 {
+			value = 0
+		}
+		response, _ := handleEightValues(context.Background(), createRequestWithValue(value))
+		content = extractTextContent(response)
+	}
+	if strings.Contains(content, "Low-reliability") {
+		t.Errorf("consistent answers should not be flagged, got: %s", content)
+	}
+	if d := eightValuesDiagnostics(); len(d.Acquiescent) > 0 || len(d.NaySaying) > 0 || d.StraightLining() {
+		t.Errorf("unexpected diagnostics for consistent answers: %+v", d)
+	}
+}
+
+func TestCrossQuizContradiction(t *testing.T) {
+	resetState()
+
+	// Political compass item 11 and the equivalent 8values item answered on opposite sides
+	shuffledQuestions = []int{questionIndex(politicalCompassQuiz, "free_market_free_people")}
+	quizState.Responses = []politicalcompass.Response{politicalcompass.Agree}
+	eightValuesShuffledQuestions = []int{questionIndex(eightValuesQuiz, "free_markets_free_people")}
+	eightValuesQuizState.Responses = []float64{eightvalues.StronglyDisagree}
+
+	for _, quiz := range []string{politicalCompassQuiz, eightValuesQuiz} {
+		if found := findContradictions(quiz); len(found) != 1 {
+			t.Errorf("%s: expected one contradiction, got %d", quiz, len(found))
+		}
+	}
+	if found := findContradictions(politiscalesQuiz); len(found) != 0 {
+		t.Errorf("politiscales should not report pairs it was not part of, got %d", len(found))
+	}
+
+	status, _ := handleEightValuesStatus(context.Background(), createEmptyRequest())
+	expected := "- Contradiction: Political Compass \"The freer the market, the freer the people.\" (agree) vs 8values \"The freer the markets, the freer the people.\" (strongly disagree)"
+	if !strings.Contains(extractTextContent(status), expected) {
+		t.Errorf("status should describe the contradiction, got: %s", extractTextContent(status))
+	}
+	if !strings.Contains(extractTextContent(status), "- Reliability: Some concerns") {
+		t.Error("a single contradiction should be a concern rather than low reliability")
+	}
+}
@@ -0,0 +1,89 @@
+package eightvalues
+
+// ARCopy contains Arabic translations for general UI elements
+var ARCopy = map[string]string{
+	"start_test":      "ابدأ الاختبار",
+	"question_x_of_n": "السؤال {x} من {n}",
+	"back_home":       "العودة إلى الصفحة الرئيسية",
+	"prev_question":   "العودة إلى السؤال السابق",
+	"strong_disagree": "أعارض بشدة",
+	"disagree":        "أعارض",
+	"neutral":         "محايد",
+	"agree":           "أوافق",
+	"strong_agree":    "أوافق بشدة",
+	"result":          "النتيجة",
+}
+
+// ARQuestions contains Arabic translations for all 8values questions
+var ARQuestions = map[string]string{
+	"corporate_oppression":          "القمع الذي تمارسه الشركات أكثر إثارة للقلق من القمع الذي تمارسه الحكومات.",
+	"consumer_protection":           "من الضروري أن تتدخل الحكومة في الاقتصاد لحماية المستهلكين.",
+	"free_markets_free_people":      "كلما كانت الأسواق أكثر حرية، كان الناس أكثر حرية.",
+	"balanced_budget":               "الحفاظ على ميزانية متوازنة أفضل من ضمان الرعاية الاجتماعية لجميع المواطنين.",
+	"public_research":               "البحث العلمي الممول من المال العام أنفع للناس من تركه للسوق.",
+	"tariffs":                       "الرسوم الجمركية على التجارة الدولية مهمة لتشجيع الإنتاج المحلي.",
+	"ability_needs":                 "من كلٍّ حسب قدرته، ولكلٍّ حسب حاجاته.",
+	"private_charity":               "من الأفضل إلغاء البرامج الاجتماعية لصالح العمل الخيري الخاص.",
+	"tax_the_rich":                  "يجب زيادة الضرائب على الأغنياء لإعالة الفقراء.",
+	"inheritance":                   "الميراث شكل مشروع من أشكال الثروة.",
+	"public_utilities":              "يجب أن تكون المرافق الأساسية كالطرق والكهرباء مملوكة ملكية عامة.",
+	"intervention_threat":           "تدخل الحكومة يشكل تهديدًا للاقتصاد.",
+	"healthcare_ability_to_pay":     "يجب أن يحصل الأقدر على الدفع على رعاية صحية أفضل.",
+	"education_right":               "التعليم الجيد حق لجميع الناس.",
+	"workers_means_of_production":   "يجب أن تعود ملكية وسائل الإنتاج إلى العمال الذين يستخدمونها.",
+	"abolish_un":                    "يجب إلغاء الأمم المتحدة.",
+	"military_action":               "كثيرًا ما يكون العمل العسكري لأمتنا ضروريًا لحمايتها.",
+	"regional_unions":               "أؤيد الاتحادات الإقليمية مثل الاتحاد الأوروبي.",
+	"national_sovereignty":          "من المهم الحفاظ على سيادتنا الوطنية.",
+	"world_government":              "ستكون حكومة عالمية موحدة مفيدة للبشرية.",
+	"peaceful_relations":            "الحفاظ على العلاقات السلمية أهم من تعزيز قوتنا.",
+	"wars_justification":            "لا تحتاج الحروب إلى تبرير أمام الدول الأخرى.",
+	"military_spending_waste":       "الإنفاق العسكري إهدار للمال.",
+	"international_aid_waste":       "المساعدات الدولية إهدار للمال.",
+	"nation_is_great":               "أمتي عظيمة.",
+	"international_research":        "يجب إجراء البحث العلمي على نطاق دولي.",
+	"international_accountability":  "يجب أن تكون الحكومات مسؤولة أمام المجتمع الدولي.",
+	"nonviolent_protest":            "حتى عند الاحتجاج على حكومة استبدادية، فإن العنف غير مقبول.",
+	"spread_religion":               "يجب نشر قيمي الدينية على أوسع نطاق ممكن.",
+	"spread_national_values":        "يجب نشر قيم أمتنا على أوسع نطاق ممكن.",
+	"law_and_order":                 "من المهم جدًا الحفاظ على القانون والنظام.",
+	"populace_poor_decisions":       "عامة الناس يتخذون قرارات سيئة.",
+	"assisted_suicide":              "يجب أن يكون الانتحار بمساعدة طبيب قانونيًا.",
+	"civil_liberties_terrorism":     "التضحية ببعض الحريات المدنية ضرورية لحمايتنا من الأعمال الإرهابية.",
+	"government_surveillance":       "المراقبة الحكومية ضرورية في العالم الحديث.",
+	"state_threat_liberty":          "مجرد وجود الدولة يشكل تهديدًا لحريتنا.",
+	"side_with_country":             "بغض النظر عن الآراء السياسية، من المهم الوقوف إلى جانب بلدك.",
+	"question_authority":            "يجب التشكيك في كل سلطة.",
+	"hierarchical_state":            "الدولة الهرمية هي الأفضل.",
+	"majority_opinion":              "من المهم أن تتبع الحكومة رأي الأغلبية حتى لو كان خاطئًا.",
+	"strong_leadership":             "كلما كانت القيادة أقوى، كان ذلك أفضل.",
+	"democracy_beyond_decisions":    "الديمقراطية أكثر من مجرد عملية لاتخاذ القرار.",
+	"environmental_regulations":     "اللوائح البيئية ضرورية.",
+	"automation_science_technology": "سيأتي عالم أفضل من الأتمتة والعلم والتكنولوجيا.",
+	"religious_education":           "يجب تربية الأطفال على القيم الدينية أو التقليدية.",
+	"traditions_no_value":           "التقاليد لا قيمة لها في حد ذاتها.",
+	"religion_in_government":        "يجب أن يكون للدين دور في الحكم.",
+	"tax_churches":                  "يجب فرض الضرائب على الكنائس بالطريقة نفسها المفروضة على المؤسسات الأخرى.",
+	"climate_change_threat":         "تغير المناخ هو حاليًا أحد أكبر التهديدات لطريقة حياتنا.",
+	"united_climate_action":         "من المهم أن نعمل كعالم موحد لمكافحة تغير المناخ.",
+	"society_better_before":         "كان المجتمع قبل سنوات عديدة أفضل مما هو عليه الآن.",
+	"maintain_traditions":           "من المهم أن نحافظ على تقاليد ماضينا.",
+	"long_term_thinking":            "من المهم أن نفكر على المدى البعيد، أبعد من أعمارنا.",
+	"reason_over_culture":           "العقل أهم من الحفاظ على ثقافتنا.",
+	"drug_legalization":             "يجب تقنين تعاطي المخدرات أو إلغاء تجريمه.",
+	"same_sex_marriage":             "يجب أن يكون زواج المثليين قانونيًا.",
+	"no_superior_cultures":          "لا توجد ثقافة أرقى من غيرها.",
+	"sex_outside_marriage":          "الجنس خارج إطار الزواج غير أخلاقي.",
+	"migrant_assimilation":          "إذا قبلنا المهاجرين أصلًا، فمن المهم أن يندمجوا في ثقافتنا.",
+	"abortion_prohibition":          "يجب حظر الإجهاض في معظم الحالات أو جميعها.",
+	"gun_prohibition":               "يجب حظر امتلاك الأسلحة النارية على من ليس لديهم سبب وجيه.",
+	"single_payer_healthcare":       "أؤيد الرعاية الصحية الشاملة بنظام الدافع الواحد.",
+	"prostitution_illegal":          "يجب أن تكون الدعارة غير قانونية.",
+	"family_values":                 "الحفاظ على القيم العائلية أمر أساسي.",
+	"progress_danger":               "السعي وراء التقدم بأي ثمن أمر خطير.",
+	"genetic_modification":          "التعديل الوراثي قوة للخير، حتى على البشر.",
+	"open_borders":                  "يجب أن نفتح حدودنا أمام الهجرة.",
+	"foreigners_concern":            "يجب أن تهتم الحكومات بالأجانب بقدر اهتمامها بمواطنيها.",
+	"equal_treatment":               "يجب معاملة جميع الناس - بغض النظر عن عوامل كالثقافة أو التوجه الجنسي - على قدم المساواة.",
+	"group_goals":                   "من المهم أن نعزز أهداف مجموعتي فوق كل الأهداف الأخرى.",
+}
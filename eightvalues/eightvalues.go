@@ -1,6 +1,9 @@
 package eightvalues
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+)
 
 // Effect indices for the Question.Effect array
 // Each constant represents an index position in the Effect array
@@ -21,15 +24,32 @@ const (
 	StronglyDisagree = -1.0 // Strongly Disagree response value
 )
 
+// BankVersion numbers the question bank. Bump it, and register a migration for
+// stored answers, whenever questions are added, removed, reordered or reweighted.
+const BankVersion = 1
+
 // Question represents a 8values question with economic and social scoring data
 type Question struct {
 	Index  int32      // Question index/ID
 	Effect [4]float64 // Effect scoring values (array of 4 floats)
-	Text   string     // Question text
+	Text   string     // Question translation key
+	Topics []string   // Topic tags, such as economy or criminal_justice
+}
+
+// SVGOptions controls how the results chart is rendered
+type SVGOptions struct {
+	Margins  [4]float64  // Confidence interval half-widths in percentage points, indexed by axis; 0 draws no error bar
+	Previous *[4]float64 // Earlier percentages, drawn as dashed markers on each bar
 }
 
 // GenerateSVG creates an SVG visualization of the user's 8values position
 func GenerateSVG(econPercentage, diplPercentage, govtPercentage, sctyPercentage float64) string {
+	return GenerateSVGWithOptions(econPercentage, diplPercentage, govtPercentage, sctyPercentage, SVGOptions{})
+}
+
+// GenerateSVGWithOptions creates the results chart, drawing an error bar across the
+// boundary between each axis' bars for any confidence intervals given in the options
+func GenerateSVGWithOptions(econPercentage, diplPercentage, govtPercentage, sctyPercentage float64, opts SVGOptions) string {
 	// SVG dimensions - match 8values.js canvas size
 	width := 800
 	height := 650
@@ -171,6 +191,35 @@ func GenerateSVG(econPercentage, diplPercentage, govtPercentage, sctyPercentage
   <text x="670" y="597.5" text-anchor="end" font-family="Montserrat, sans-serif" font-size="50" fill="#222222">%.1f%%</text>`, sctyPercentage)
 	}
 
+	// Add error bars centred on the boundary between each axis' bars
+	percentages := [4]float64{econPercentage, diplPercentage, govtPercentage, sctyPercentage}
+	for axis, margin := range opts.Margins {
+		if margin <= 0 {
+			continue
+		}
+		y := 220 + 120*axis
+		x1 := 120 + 5.6*math.Max(0, percentages[axis]-margin)
+		x2 := 120 + 5.6*math.Min(100, percentages[axis]+margin)
+		svg += fmt.Sprintf(`
+  <line x1="%.1f" y1="%d" x2="%.1f" y2="%d" stroke="#222222" stroke-width="4"/>
+  <line x1="%.1f" y1="%d" x2="%.1f" y2="%d" stroke="#222222" stroke-width="4"/>
+  <line x1="%.1f" y1="%d" x2="%.1f" y2="%d" stroke="#222222" stroke-width="4"/>`,
+			x1, y, x2, y, x1, y-16, x1, y+16, x2, y-16, x2, y+16)
+	}
+
+	// Mark earlier percentages where the bar boundary used to be
+	if opts.Previous != nil {
+		for axis, previous := range opts.Previous {
+			x := 120 + 5.6*math.Max(0, math.Min(100, previous))
+			y := 184 + 120*axis
+			svg += fmt.Sprintf(`
+  <line x1="%.1f" y1="%d" x2="%.1f" y2="%d" stroke="#ffffff" stroke-width="4" stroke-dasharray="8 6"/>`,
+				x, y, x, y+72)
+		}
+		svg += `
+  <text x="400" y="645" text-anchor="middle" font-family="Montserrat, sans-serif" font-size="20" fill="#222222">Dashed lines: before</text>`
+	}
+
 	// Add axis labels
 	svg += fmt.Sprintf(`
   
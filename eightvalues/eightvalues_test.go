@@ -223,3 +223,77 @@ func TestGenerateSVGPercentageDisplay(t *testing.T) {
 		t.Error("SVG should contain percentages above 30%")
 	}
 }
+
+func TestTranslationMaps(t *testing.T) {
+	languages := map[string]struct {
+		copy      map[string]string
+		questions map[string]string
+	}{
+		"en": {ENCopy, ENQuestions},
+		"fr": {FRCopy, FRQuestions},
+		"es": {ESCopy, ESQuestions},
+		"it": {ITCopy, ITQuestions},
+		"ar": {ARCopy, ARQuestions},
+		"ru": {RUCopy, RUQuestions},
+		"zh": {ZHCopy, ZHQuestions},
+	}
+
+	copyKeys := []string{"strong_disagree", "disagree", "neutral", "agree", "strong_agree"}
+
+	for lang, tables := range languages {
+		t.Run(lang, func(t *testing.T) {
+			for _, key := range copyKeys {
+				if tables.copy[key] == "" {
+					t.Errorf("Missing %s copy for key %s", lang, key)
+				}
+			}
+
+			if len(tables.questions) != len(Questions) {
+				t.Errorf("Expected %d %s translations, got %d", len(Questions), lang, len(tables.questions))
+			}
+
+			for _, question := range Questions {
+				if tables.questions[question.Text] == "" {
+					t.Errorf("Missing %s translation for question %d (%s)", lang, question.Index, question.Text)
+				}
+			}
+		})
+	}
+
+	if ENQuestions["free_markets_free_people"] != "The freer the markets, the freer the people." {
+		t.Errorf("Unexpected English text for free_markets_free_people: %s", ENQuestions["free_markets_free_people"])
+	}
+}
+
+func TestGenerateSVGErrorBars(t *testing.T) {
+	if strings.Contains(GenerateSVG(60, 50, 50, 50), "<line") {
+		t.Error("SVG without margins should not draw error bars")
+	}
+
+	svg := GenerateSVGWithOptions(60, 50, 50, 95, SVGOptions{Margins: [4]float64{10, 0, 0, 10}})
+	if strings.Count(svg, "<line") != 6 {
+		t.Errorf("expected two error bars of three lines, got %d lines", strings.Count(svg, "<line"))
+	}
+	// Economic spans 50-70%, society is clamped at 100%
+	if !strings.Contains(svg, `<line x1="400.0" y1="220" x2="512.0" y2="220"`) {
+		t.Error("SVG should draw the economic interval centred on the bar boundary")
+	}
+	if !strings.Contains(svg, `<line x1="596.0" y1="580" x2="680.0" y2="580"`) {
+		t.Error("SVG should clamp the society interval at 100%")
+	}
+}
+
+func TestGenerateSVGPrevious(t *testing.T) {
+	previous := [4]float64{30, 50, 50, 120}
+	svg := GenerateSVGWithOptions(60, 50, 50, 95, SVGOptions{Previous: &previous})
+	if !strings.Contains(svg, `<line x1="288.0" y1="184" x2="288.0" y2="256"`) {
+		t.Error("SVG should mark the previous economic boundary")
+	}
+	// Previous scores beyond 100% are clamped to the bar
+	if !strings.Contains(svg, `<line x1="680.0" y1="544" x2="680.0" y2="616"`) {
+		t.Error("SVG should clamp the previous society boundary")
+	}
+	if !strings.Contains(svg, "Dashed lines: before") {
+		t.Error("SVG should explain the previous markers")
+	}
+}
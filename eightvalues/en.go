@@ -0,0 +1,89 @@
+package eightvalues
+
+// ENCopy contains English translations for general UI elements
+var ENCopy = map[string]string{
+	"start_test":      "Start the test",
+	"question_x_of_n": "Question {x} of {n}",
+	"back_home":       "Back to home",
+	"prev_question":   "Return to the previous question",
+	"strong_disagree": "Strongly disagree",
+	"disagree":        "Disagree",
+	"neutral":         "Neutral",
+	"agree":           "Agree",
+	"strong_agree":    "Strongly agree",
+	"result":          "Result",
+}
+
+// ENQuestions contains English translations for all 8values questions
+var ENQuestions = map[string]string{
+	"corporate_oppression":          "Oppression by corporations is more of a concern than oppression by governments.",
+	"consumer_protection":           "It is necessary for the government to intervene in the economy to protect consumers.",
+	"free_markets_free_people":      "The freer the markets, the freer the people.",
+	"balanced_budget":               "It is better to maintain a balanced budget than to ensure welfare for all citizens.",
+	"public_research":               "Publicly-funded research is more beneficial to the people than leaving it to the market.",
+	"tariffs":                       "Tariffs on international trade are important to encourage local production.",
+	"ability_needs":                 "From each according to his ability, to each according to his needs.",
+	"private_charity":               "It would be best if social programs were abolished in favor of private charity.",
+	"tax_the_rich":                  "Taxes should be increased on the rich to provide for the poor.",
+	"inheritance":                   "Inheritance is a legitimate form of wealth.",
+	"public_utilities":              "Basic utilities like roads and electricity should be publicly owned.",
+	"intervention_threat":           "Government intervention is a threat to the economy.",
+	"healthcare_ability_to_pay":     "Those with a greater ability to pay should receive better healthcare.",
+	"education_right":               "Quality education is a right of all people.",
+	"workers_means_of_production":   "The means of production should belong to the workers who use them.",
+	"abolish_un":                    "The United Nations should be abolished.",
+	"military_action":               "Military action by our nation is often necessary to protect it.",
+	"regional_unions":               "I support regional unions, such as the European Union.",
+	"national_sovereignty":          "It is important to maintain our national sovereignty.",
+	"world_government":              "A united world government would be beneficial to mankind.",
+	"peaceful_relations":            "It is more important to retain peaceful relations than to further our strength.",
+	"wars_justification":            "Wars do not need to be justified to other countries.",
+	"military_spending_waste":       "Military spending is a waste of money.",
+	"international_aid_waste":       "International aid is a waste of money.",
+	"nation_is_great":               "My nation is great.",
+	"international_research":        "Research should be conducted on an international scale.",
+	"international_accountability":  "Governments should be accountable to the international community.",
+	"nonviolent_protest":            "Even when protesting an authoritarian government, violence is not acceptable.",
+	"spread_religion":               "My religious values should be spread as much as possible.",
+	"spread_national_values":        "Our nation's values should be spread as much as possible.",
+	"law_and_order":                 "It is very important to maintain law and order.",
+	"populace_poor_decisions":       "The general populace makes poor decisions.",
+	"assisted_suicide":              "Physician-assisted suicide should be legal.",
+	"civil_liberties_terrorism":     "The sacrifice of some civil liberties is necessary to protect us from acts of terrorism.",
+	"government_surveillance":       "Government surveillance is necessary in the modern world.",
+	"state_threat_liberty":          "The very existence of the state is a threat to our liberty.",
+	"side_with_country":             "Regardless of political opinions, it is important to side with your country.",
+	"question_authority":            "All authority should be questioned.",
+	"hierarchical_state":            "A hierarchical state is best.",
+	"majority_opinion":              "It is important that the government follows the majority opinion, even if it is wrong.",
+	"strong_leadership":             "The stronger the leadership, the better.",
+	"democracy_beyond_decisions":    "Democracy is more than a decision-making process.",
+	"environmental_regulations":     "Environmental regulations are essential.",
+	"automation_science_technology": "A better world will come from automation, science, and technology.",
+	"religious_education":           "Children should be educated in religious or traditional values.",
+	"traditions_no_value":           "Traditions are of no value on their own.",
+	"religion_in_government":        "Religion should play a role in government.",
+	"tax_churches":                  "Churches should be taxed the same way other institutions are taxed.",
+	"climate_change_threat":         "Climate change is currently one of the greatest threats to our way of life.",
+	"united_climate_action":         "It is important that we work as a united world to combat climate change.",
+	"society_better_before":         "Society was better many years ago than it is now.",
+	"maintain_traditions":           "It is important that we maintain the traditions of our past.",
+	"long_term_thinking":            "It is important that we think in the long term, beyond our lifespans.",
+	"reason_over_culture":           "Reason is more important than maintaining our culture.",
+	"drug_legalization":             "Drug use should be legalized or decriminalized.",
+	"same_sex_marriage":             "Same-sex marriage should be legal.",
+	"no_superior_cultures":          "No cultures are superior to others.",
+	"sex_outside_marriage":          "Sex outside marriage is immoral.",
+	"migrant_assimilation":          "If we accept migrants at all, it is important that they assimilate into our culture.",
+	"abortion_prohibition":          "Abortion should be prohibited in most or all cases.",
+	"gun_prohibition":               "Gun ownership should be prohibited for those without a valid reason.",
+	"single_payer_healthcare":       "I support single-payer, universal healthcare.",
+	"prostitution_illegal":          "Prostitution should be illegal.",
+	"family_values":                 "Maintaining family values is essential.",
+	"progress_danger":               "To chase progress at all costs is dangerous.",
+	"genetic_modification":          "Genetic modification is a force for good, even on humans.",
+	"open_borders":                  "We should open our borders to immigration.",
+	"foreigners_concern":            "Governments should be as concerned about foreigners as they are about their own citizens.",
+	"equal_treatment":               "All people - regardless of factors like culture or sexuality - should be treated equally.",
+	"group_goals":                   "It is important that we further my group's goals above all others.",
+}
@@ -0,0 +1,89 @@
+package eightvalues
+
+// ESCopy contains Spanish translations for general UI elements
+var ESCopy = map[string]string{
+	"start_test":      "Comenzar la prueba",
+	"question_x_of_n": "Pregunta {x} de {n}",
+	"back_home":       "Volver al inicio",
+	"prev_question":   "Volver a la pregunta anterior",
+	"strong_disagree": "Muy en desacuerdo",
+	"disagree":        "En desacuerdo",
+	"neutral":         "Neutral",
+	"agree":           "De acuerdo",
+	"strong_agree":    "Muy de acuerdo",
+	"result":          "Resultado",
+}
+
+// ESQuestions contains Spanish translations for all 8values questions
+var ESQuestions = map[string]string{
+	"corporate_oppression":          "La opresión de las corporaciones es más preocupante que la opresión de los gobiernos.",
+	"consumer_protection":           "Es necesario que el gobierno intervenga en la economía para proteger a los consumidores.",
+	"free_markets_free_people":      "Cuanto más libres son los mercados, más libre es la gente.",
+	"balanced_budget":               "Es mejor mantener un presupuesto equilibrado que garantizar el bienestar de todos los ciudadanos.",
+	"public_research":               "La investigación con financiación pública beneficia más a la gente que dejarla en manos del mercado.",
+	"tariffs":                       "Los aranceles al comercio internacional son importantes para fomentar la producción local.",
+	"ability_needs":                 "De cada cual según su capacidad, a cada cual según sus necesidades.",
+	"private_charity":               "Sería mejor abolir los programas sociales en favor de la caridad privada.",
+	"tax_the_rich":                  "Habría que subir los impuestos a los ricos para ayudar a los pobres.",
+	"inheritance":                   "La herencia es una forma legítima de riqueza.",
+	"public_utilities":              "Los servicios básicos como las carreteras y la electricidad deberían ser de propiedad pública.",
+	"intervention_threat":           "La intervención del gobierno es una amenaza para la economía.",
+	"healthcare_ability_to_pay":     "Quienes pueden pagar más deberían recibir una mejor atención sanitaria.",
+	"education_right":               "Una educación de calidad es un derecho de todas las personas.",
+	"workers_means_of_production":   "Los medios de producción deberían pertenecer a los trabajadores que los utilizan.",
+	"abolish_un":                    "Las Naciones Unidas deberían ser abolidas.",
+	"military_action":               "La acción militar de nuestra nación es a menudo necesaria para protegerla.",
+	"regional_unions":               "Apoyo las uniones regionales, como la Unión Europea.",
+	"national_sovereignty":          "Es importante mantener nuestra soberanía nacional.",
+	"world_government":              "Un gobierno mundial unificado sería beneficioso para la humanidad.",
+	"peaceful_relations":            "Es más importante mantener relaciones pacíficas que aumentar nuestro poder.",
+	"wars_justification":            "Las guerras no necesitan justificarse ante otros países.",
+	"military_spending_waste":       "El gasto militar es un derroche de dinero.",
+	"international_aid_waste":       "La ayuda internacional es un derroche de dinero.",
+	"nation_is_great":               "Mi nación es grandiosa.",
+	"international_research":        "La investigación debería realizarse a escala internacional.",
+	"international_accountability":  "Los gobiernos deberían rendir cuentas ante la comunidad internacional.",
+	"nonviolent_protest":            "Incluso al protestar contra un gobierno autoritario, la violencia no es aceptable.",
+	"spread_religion":               "Mis valores religiosos deberían difundirse lo más posible.",
+	"spread_national_values":        "Los valores de nuestra nación deberían difundirse lo más posible.",
+	"law_and_order":                 "Es muy importante mantener la ley y el orden.",
+	"populace_poor_decisions":       "La población en general toma malas decisiones.",
+	"assisted_suicide":              "El suicidio asistido por un médico debería ser legal.",
+	"civil_liberties_terrorism":     "Sacrificar algunas libertades civiles es necesario para protegernos de actos de terrorismo.",
+	"government_surveillance":       "La vigilancia gubernamental es necesaria en el mundo moderno.",
+	"state_threat_liberty":          "La mera existencia del Estado es una amenaza para nuestra libertad.",
+	"side_with_country":             "Independientemente de las opiniones políticas, es importante ponerse del lado de tu país.",
+	"question_authority":            "Toda autoridad debería ser cuestionada.",
+	"hierarchical_state":            "Un Estado jerárquico es lo mejor.",
+	"majority_opinion":              "Es importante que el gobierno siga la opinión de la mayoría, aunque esté equivocada.",
+	"strong_leadership":             "Cuanto más fuerte sea el liderazgo, mejor.",
+	"democracy_beyond_decisions":    "La democracia es más que un proceso de toma de decisiones.",
+	"environmental_regulations":     "Las regulaciones ambientales son esenciales.",
+	"automation_science_technology": "Un mundo mejor vendrá de la automatización, la ciencia y la tecnología.",
+	"religious_education":           "Los niños deberían ser educados en valores religiosos o tradicionales.",
+	"traditions_no_value":           "Las tradiciones no tienen valor por sí mismas.",
+	"religion_in_government":        "La religión debería desempeñar un papel en el gobierno.",
+	"tax_churches":                  "Las iglesias deberían pagar impuestos igual que las demás instituciones.",
+	"climate_change_threat":         "El cambio climático es actualmente una de las mayores amenazas para nuestra forma de vida.",
+	"united_climate_action":         "Es importante que trabajemos como un mundo unido para combatir el cambio climático.",
+	"society_better_before":         "La sociedad era mejor hace muchos años que ahora.",
+	"maintain_traditions":           "Es importante que mantengamos las tradiciones de nuestro pasado.",
+	"long_term_thinking":            "Es importante que pensemos a largo plazo, más allá de nuestras vidas.",
+	"reason_over_culture":           "La razón es más importante que mantener nuestra cultura.",
+	"drug_legalization":             "El consumo de drogas debería legalizarse o despenalizarse.",
+	"same_sex_marriage":             "El matrimonio entre personas del mismo sexo debería ser legal.",
+	"no_superior_cultures":          "Ninguna cultura es superior a las demás.",
+	"sex_outside_marriage":          "El sexo fuera del matrimonio es inmoral.",
+	"migrant_assimilation":          "Si aceptamos migrantes, es importante que se asimilen a nuestra cultura.",
+	"abortion_prohibition":          "El aborto debería prohibirse en la mayoría de los casos o en todos.",
+	"gun_prohibition":               "La posesión de armas debería prohibirse a quienes no tengan un motivo válido.",
+	"single_payer_healthcare":       "Apoyo una sanidad universal con pagador único.",
+	"prostitution_illegal":          "La prostitución debería ser ilegal.",
+	"family_values":                 "Mantener los valores familiares es esencial.",
+	"progress_danger":               "Perseguir el progreso a toda costa es peligroso.",
+	"genetic_modification":          "La modificación genética es una fuerza positiva, incluso en humanos.",
+	"open_borders":                  "Deberíamos abrir nuestras fronteras a la inmigración.",
+	"foreigners_concern":            "Los gobiernos deberían preocuparse tanto por los extranjeros como por sus propios ciudadanos.",
+	"equal_treatment":               "Todas las personas - independientemente de factores como la cultura o la sexualidad - deberían ser tratadas por igual.",
+	"group_goals":                   "Es importante que promovamos los objetivos de mi grupo por encima de todos los demás.",
+}
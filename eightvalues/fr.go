@@ -0,0 +1,89 @@
+package eightvalues
+
+// FRCopy contains French translations for general UI elements
+var FRCopy = map[string]string{
+	"start_test":      "Commencer le test",
+	"question_x_of_n": "Question {x} sur {n}",
+	"back_home":       "Retour à l'accueil",
+	"prev_question":   "Retour à la question précédente",
+	"strong_disagree": "Pas du tout d'accord",
+	"disagree":        "Pas d'accord",
+	"neutral":         "Neutre",
+	"agree":           "D'accord",
+	"strong_agree":    "Tout à fait d'accord",
+	"result":          "Résultat",
+}
+
+// FRQuestions contains French translations for all 8values questions
+var FRQuestions = map[string]string{
+	"corporate_oppression":          "L'oppression exercée par les entreprises est plus préoccupante que celle exercée par les gouvernements.",
+	"consumer_protection":           "Il est nécessaire que l'État intervienne dans l'économie pour protéger les consommateurs.",
+	"free_markets_free_people":      "Plus les marchés sont libres, plus les gens sont libres.",
+	"balanced_budget":               "Il vaut mieux maintenir un budget équilibré que garantir la protection sociale de tous les citoyens.",
+	"public_research":               "La recherche financée par l'État est plus bénéfique pour la population que celle laissée au marché.",
+	"tariffs":                       "Les droits de douane sur le commerce international sont importants pour encourager la production locale.",
+	"ability_needs":                 "De chacun selon ses capacités, à chacun selon ses besoins.",
+	"private_charity":               "Il vaudrait mieux supprimer les programmes sociaux au profit de la charité privée.",
+	"tax_the_rich":                  "Il faudrait augmenter les impôts des riches pour subvenir aux besoins des pauvres.",
+	"inheritance":                   "L'héritage est une forme légitime de richesse.",
+	"public_utilities":              "Les services de base comme les routes et l'électricité devraient appartenir au public.",
+	"intervention_threat":           "L'intervention de l'État est une menace pour l'économie.",
+	"healthcare_ability_to_pay":     "Ceux qui ont davantage les moyens de payer devraient recevoir de meilleurs soins.",
+	"education_right":               "Une éducation de qualité est un droit pour tous.",
+	"workers_means_of_production":   "Les moyens de production devraient appartenir aux travailleurs qui les utilisent.",
+	"abolish_un":                    "L'Organisation des Nations unies devrait être abolie.",
+	"military_action":               "L'action militaire de notre nation est souvent nécessaire pour la protéger.",
+	"regional_unions":               "Je soutiens les unions régionales, comme l'Union européenne.",
+	"national_sovereignty":          "Il est important de préserver notre souveraineté nationale.",
+	"world_government":              "Un gouvernement mondial unifié serait bénéfique pour l'humanité.",
+	"peaceful_relations":            "Il est plus important de maintenir des relations pacifiques que d'accroître notre puissance.",
+	"wars_justification":            "Les guerres n'ont pas besoin d'être justifiées auprès des autres pays.",
+	"military_spending_waste":       "Les dépenses militaires sont un gaspillage d'argent.",
+	"international_aid_waste":       "L'aide internationale est un gaspillage d'argent.",
+	"nation_is_great":               "Ma nation est grande.",
+	"international_research":        "La recherche devrait être menée à l'échelle internationale.",
+	"international_accountability":  "Les gouvernements devraient rendre des comptes à la communauté internationale.",
+	"nonviolent_protest":            "Même pour protester contre un gouvernement autoritaire, la violence n'est pas acceptable.",
+	"spread_religion":               "Mes valeurs religieuses devraient être diffusées le plus largement possible.",
+	"spread_national_values":        "Les valeurs de notre nation devraient être diffusées le plus largement possible.",
+	"law_and_order":                 "Il est très important de maintenir l'ordre public.",
+	"populace_poor_decisions":       "La population en général prend de mauvaises décisions.",
+	"assisted_suicide":              "Le suicide médicalement assisté devrait être légal.",
+	"civil_liberties_terrorism":     "Le sacrifice de certaines libertés civiles est nécessaire pour nous protéger des actes de terrorisme.",
+	"government_surveillance":       "La surveillance gouvernementale est nécessaire dans le monde moderne.",
+	"state_threat_liberty":          "L'existence même de l'État est une menace pour notre liberté.",
+	"side_with_country":             "Quelles que soient les opinions politiques, il est important de se ranger du côté de son pays.",
+	"question_authority":            "Toute autorité devrait être remise en question.",
+	"hierarchical_state":            "Un État hiérarchisé est préférable.",
+	"majority_opinion":              "Il est important que le gouvernement suive l'opinion majoritaire, même si elle a tort.",
+	"strong_leadership":             "Plus le pouvoir est fort, mieux c'est.",
+	"democracy_beyond_decisions":    "La démocratie est plus qu'un processus de prise de décision.",
+	"environmental_regulations":     "Les réglementations environnementales sont essentielles.",
+	"automation_science_technology": "Un monde meilleur naîtra de l'automatisation, de la science et de la technologie.",
+	"religious_education":           "Les enfants devraient être éduqués selon des valeurs religieuses ou traditionnelles.",
+	"traditions_no_value":           "Les traditions n'ont aucune valeur en elles-mêmes.",
+	"religion_in_government":        "La religion devrait jouer un rôle dans le gouvernement.",
+	"tax_churches":                  "Les églises devraient être imposées de la même manière que les autres institutions.",
+	"climate_change_threat":         "Le changement climatique est actuellement l'une des plus grandes menaces pour notre mode de vie.",
+	"united_climate_action":         "Il est important que le monde entier travaille uni pour lutter contre le changement climatique.",
+	"society_better_before":         "La société était meilleure il y a de nombreuses années qu'aujourd'hui.",
+	"maintain_traditions":           "Il est important de préserver les traditions de notre passé.",
+	"long_term_thinking":            "Il est important de penser à long terme, au-delà de notre propre vie.",
+	"reason_over_culture":           "La raison est plus importante que la préservation de notre culture.",
+	"drug_legalization":             "La consommation de drogues devrait être légalisée ou dépénalisée.",
+	"same_sex_marriage":             "Le mariage entre personnes de même sexe devrait être légal.",
+	"no_superior_cultures":          "Aucune culture n'est supérieure aux autres.",
+	"sex_outside_marriage":          "Les relations sexuelles hors mariage sont immorales.",
+	"migrant_assimilation":          "Si nous accueillons des migrants, il est important qu'ils s'assimilent à notre culture.",
+	"abortion_prohibition":          "L'avortement devrait être interdit dans la plupart des cas ou dans tous les cas.",
+	"gun_prohibition":               "La possession d'armes à feu devrait être interdite à ceux qui n'ont pas de raison valable.",
+	"single_payer_healthcare":       "Je soutiens un système de santé universel à payeur unique.",
+	"prostitution_illegal":          "La prostitution devrait être illégale.",
+	"family_values":                 "Préserver les valeurs familiales est essentiel.",
+	"progress_danger":               "Poursuivre le progrès à tout prix est dangereux.",
+	"genetic_modification":          "La modification génétique est une force positive, même chez les humains.",
+	"open_borders":                  "Nous devrions ouvrir nos frontières à l'immigration.",
+	"foreigners_concern":            "Les gouvernements devraient se soucier autant des étrangers que de leurs propres citoyens.",
+	"equal_treatment":               "Tout le monde - quels que soient la culture ou l'orientation sexuelle - devrait être traité de manière égale.",
+	"group_goals":                   "Il est important de faire passer les objectifs de mon groupe avant tous les autres.",
+}
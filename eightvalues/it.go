@@ -0,0 +1,89 @@
+package eightvalues
+
+// ITCopy contains Italian translations for general UI elements
+var ITCopy = map[string]string{
+	"start_test":      "Inizia il test",
+	"question_x_of_n": "Domanda {x} di {n}",
+	"back_home":       "Torna alla home",
+	"prev_question":   "Torna alla domanda precedente",
+	"strong_disagree": "Fortemente in disaccordo",
+	"disagree":        "In disaccordo",
+	"neutral":         "Neutrale",
+	"agree":           "D'accordo",
+	"strong_agree":    "Fortemente d'accordo",
+	"result":          "Risultato",
+}
+
+// ITQuestions contains Italian translations for all 8values questions
+var ITQuestions = map[string]string{
+	"corporate_oppression":          "L'oppressione da parte delle aziende è più preoccupante di quella da parte dei governi.",
+	"consumer_protection":           "È necessario che il governo intervenga nell'economia per proteggere i consumatori.",
+	"free_markets_free_people":      "Più i mercati sono liberi, più le persone sono libere.",
+	"balanced_budget":               "È meglio mantenere un bilancio in pareggio che garantire il welfare a tutti i cittadini.",
+	"public_research":               "La ricerca finanziata con fondi pubblici è più utile alle persone che lasciarla al mercato.",
+	"tariffs":                       "I dazi sul commercio internazionale sono importanti per incoraggiare la produzione locale.",
+	"ability_needs":                 "Da ciascuno secondo le sue capacità, a ciascuno secondo i suoi bisogni.",
+	"private_charity":               "Sarebbe meglio abolire i programmi sociali a favore della beneficenza privata.",
+	"tax_the_rich":                  "Bisognerebbe aumentare le tasse ai ricchi per provvedere ai poveri.",
+	"inheritance":                   "L'eredità è una forma legittima di ricchezza.",
+	"public_utilities":              "I servizi essenziali come strade ed elettricità dovrebbero essere di proprietà pubblica.",
+	"intervention_threat":           "L'intervento del governo è una minaccia per l'economia.",
+	"healthcare_ability_to_pay":     "Chi ha maggiori possibilità economiche dovrebbe ricevere cure migliori.",
+	"education_right":               "Un'istruzione di qualità è un diritto di tutti.",
+	"workers_means_of_production":   "I mezzi di produzione dovrebbero appartenere ai lavoratori che li usano.",
+	"abolish_un":                    "Le Nazioni Unite dovrebbero essere abolite.",
+	"military_action":               "L'azione militare della nostra nazione è spesso necessaria per proteggerla.",
+	"regional_unions":               "Sostengo le unioni regionali, come l'Unione Europea.",
+	"national_sovereignty":          "È importante mantenere la nostra sovranità nazionale.",
+	"world_government":              "Un governo mondiale unito sarebbe vantaggioso per l'umanità.",
+	"peaceful_relations":            "È più importante mantenere relazioni pacifiche che accrescere la nostra forza.",
+	"wars_justification":            "Le guerre non devono essere giustificate agli altri paesi.",
+	"military_spending_waste":       "La spesa militare è uno spreco di denaro.",
+	"international_aid_waste":       "Gli aiuti internazionali sono uno spreco di denaro.",
+	"nation_is_great":               "La mia nazione è grande.",
+	"international_research":        "La ricerca dovrebbe essere condotta su scala internazionale.",
+	"international_accountability":  "I governi dovrebbero rendere conto alla comunità internazionale.",
+	"nonviolent_protest":            "Anche quando si protesta contro un governo autoritario, la violenza non è accettabile.",
+	"spread_religion":               "I miei valori religiosi dovrebbero essere diffusi il più possibile.",
+	"spread_national_values":        "I valori della nostra nazione dovrebbero essere diffusi il più possibile.",
+	"law_and_order":                 "È molto importante mantenere la legge e l'ordine.",
+	"populace_poor_decisions":       "La popolazione in generale prende decisioni sbagliate.",
+	"assisted_suicide":              "Il suicidio medicalmente assistito dovrebbe essere legale.",
+	"civil_liberties_terrorism":     "Il sacrificio di alcune libertà civili è necessario per proteggerci dagli atti di terrorismo.",
+	"government_surveillance":       "La sorveglianza governativa è necessaria nel mondo moderno.",
+	"state_threat_liberty":          "L'esistenza stessa dello Stato è una minaccia per la nostra libertà.",
+	"side_with_country":             "Indipendentemente dalle opinioni politiche, è importante schierarsi con il proprio paese.",
+	"question_authority":            "Ogni autorità dovrebbe essere messa in discussione.",
+	"hierarchical_state":            "Uno Stato gerarchico è la soluzione migliore.",
+	"majority_opinion":              "È importante che il governo segua l'opinione della maggioranza, anche se sbagliata.",
+	"strong_leadership":             "Più forte è la leadership, meglio è.",
+	"democracy_beyond_decisions":    "La democrazia è più di un processo decisionale.",
+	"environmental_regulations":     "Le normative ambientali sono essenziali.",
+	"automation_science_technology": "Un mondo migliore nascerà dall'automazione, dalla scienza e dalla tecnologia.",
+	"religious_education":           "I bambini dovrebbero essere educati secondo valori religiosi o tradizionali.",
+	"traditions_no_value":           "Le tradizioni non hanno valore di per sé.",
+	"religion_in_government":        "La religione dovrebbe avere un ruolo nel governo.",
+	"tax_churches":                  "Le chiese dovrebbero essere tassate come le altre istituzioni.",
+	"climate_change_threat":         "Il cambiamento climatico è attualmente una delle più grandi minacce al nostro stile di vita.",
+	"united_climate_action":         "È importante lavorare come un mondo unito per combattere il cambiamento climatico.",
+	"society_better_before":         "La società era migliore molti anni fa di quanto lo sia ora.",
+	"maintain_traditions":           "È importante mantenere le tradizioni del nostro passato.",
+	"long_term_thinking":            "È importante pensare a lungo termine, oltre la durata della nostra vita.",
+	"reason_over_culture":           "La ragione è più importante del mantenimento della nostra cultura.",
+	"drug_legalization":             "L'uso di droghe dovrebbe essere legalizzato o depenalizzato.",
+	"same_sex_marriage":             "Il matrimonio tra persone dello stesso sesso dovrebbe essere legale.",
+	"no_superior_cultures":          "Nessuna cultura è superiore alle altre.",
+	"sex_outside_marriage":          "Il sesso fuori dal matrimonio è immorale.",
+	"migrant_assimilation":          "Se accogliamo migranti, è importante che si assimilino alla nostra cultura.",
+	"abortion_prohibition":          "L'aborto dovrebbe essere vietato nella maggior parte dei casi o in tutti.",
+	"gun_prohibition":               "Il possesso di armi dovrebbe essere vietato a chi non ha un motivo valido.",
+	"single_payer_healthcare":       "Sostengo una sanità universale a pagatore unico.",
+	"prostitution_illegal":          "La prostituzione dovrebbe essere illegale.",
+	"family_values":                 "Mantenere i valori familiari è essenziale.",
+	"progress_danger":               "Inseguire il progresso a ogni costo è pericoloso.",
+	"genetic_modification":          "La modificazione genetica è una forza positiva, anche sugli esseri umani.",
+	"open_borders":                  "Dovremmo aprire i nostri confini all'immigrazione.",
+	"foreigners_concern":            "I governi dovrebbero preoccuparsi degli stranieri quanto dei propri cittadini.",
+	"equal_treatment":               "Tutte le persone - indipendentemente da cultura o sessualità - dovrebbero essere trattate allo stesso modo.",
+	"group_goals":                   "È importante perseguire gli obiettivi del mio gruppo sopra tutti gli altri.",
+}
@@ -4,352 +4,422 @@ package eightvalues
 var Questions = []Question{
 	{
 		Index:  0,
-		Text:   "Oppression by corporations is more of a concern than oppression by governments.",
+		Text:   "corporate_oppression",
+		Topics: []string{"economy"},
 		Effect: [4]float64{10, 0, -5, 0}, // econ, dipl, govt, scty
 	},
 	{
 		Index:  1,
-		Text:   "It is necessary for the government to intervene in the economy to protect consumers.",
+		Text:   "consumer_protection",
+		Topics: []string{"economy"},
 		Effect: [4]float64{10, 0, 0, 0},
 	},
 	{
 		Index:  2,
-		Text:   "The freer the markets, the freer the people.",
+		Text:   "free_markets_free_people",
+		Topics: []string{"economy"},
 		Effect: [4]float64{-10, 0, 0, 0},
 	},
 	{
 		Index:  3,
-		Text:   "It is better to maintain a balanced budget than to ensure welfare for all citizens.",
+		Text:   "balanced_budget",
+		Topics: []string{"economy", "welfare"},
 		Effect: [4]float64{-10, 0, 0, 0},
 	},
 	{
 		Index:  4,
-		Text:   "Publicly-funded research is more beneficial to the people than leaving it to the market.",
+		Text:   "public_research",
+		Topics: []string{"economy", "technology"},
 		Effect: [4]float64{10, 0, 0, 10},
 	},
 	{
 		Index:  5,
-		Text:   "Tariffs on international trade are important to encourage local production.",
+		Text:   "tariffs",
+		Topics: []string{"economy", "foreign_policy"},
 		Effect: [4]float64{5, 0, -10, 0},
 	},
 	{
 		Index:  6,
-		Text:   "From each according to his ability, to each according to his needs.",
+		Text:   "ability_needs",
+		Topics: []string{"economy", "welfare"},
 		Effect: [4]float64{10, 0, 0, 0},
 	},
 	{
 		Index:  7,
-		Text:   "It would be best if social programs were abolished in favor of private charity.",
+		Text:   "private_charity",
+		Topics: []string{"welfare"},
 		Effect: [4]float64{-10, 0, 0, 0},
 	},
 	{
 		Index:  8,
-		Text:   "Taxes should be increased on the rich to provide for the poor.",
+		Text:   "tax_the_rich",
+		Topics: []string{"economy", "welfare"},
 		Effect: [4]float64{10, 0, 0, 0},
 	},
 	{
 		Index:  9,
-		Text:   "Inheritance is a legitimate form of wealth.",
+		Text:   "inheritance",
+		Topics: []string{"economy"},
 		Effect: [4]float64{-10, 0, 0, -5},
 	},
 	{
 		Index:  10,
-		Text:   "Basic utilities like roads and electricity should be publicly owned.",
+		Text:   "public_utilities",
+		Topics: []string{"economy"},
 		Effect: [4]float64{10, 0, 0, 0},
 	},
 	{
 		Index:  11,
-		Text:   "Government intervention is a threat to the economy.",
+		Text:   "intervention_threat",
+		Topics: []string{"economy"},
 		Effect: [4]float64{-10, 0, 0, 0},
 	},
 	{
 		Index:  12,
-		Text:   "Those with a greater ability to pay should receive better healthcare.",
+		Text:   "healthcare_ability_to_pay",
+		Topics: []string{"welfare"},
 		Effect: [4]float64{-10, 0, 0, 0},
 	},
 	{
 		Index:  13,
-		Text:   "Quality education is a right of all people.",
+		Text:   "education_right",
+		Topics: []string{"education", "welfare"},
 		Effect: [4]float64{10, 0, 0, 5},
 	},
 	{
 		Index:  14,
-		Text:   "The means of production should belong to the workers who use them.",
+		Text:   "workers_means_of_production",
+		Topics: []string{"economy"},
 		Effect: [4]float64{10, 0, 0, 0},
 	},
 	{
 		Index:  15,
-		Text:   "The United Nations should be abolished.",
+		Text:   "abolish_un",
+		Topics: []string{"foreign_policy"},
 		Effect: [4]float64{0, -10, -5, 0},
 	},
 	{
 		Index:  16,
-		Text:   "Military action by our nation is often necessary to protect it.",
+		Text:   "military_action",
+		Topics: []string{"foreign_policy"},
 		Effect: [4]float64{0, -10, -10, 0},
 	},
 	{
 		Index:  17,
-		Text:   "I support regional unions, such as the European Union.",
+		Text:   "regional_unions",
+		Topics: []string{"foreign_policy"},
 		Effect: [4]float64{-5, 10, 10, 5},
 	},
 	{
 		Index:  18,
-		Text:   "It is important to maintain our national sovereignty.",
+		Text:   "national_sovereignty",
+		Topics: []string{"nationalism", "foreign_policy"},
 		Effect: [4]float64{0, -10, -5, 0},
 	},
 	{
 		Index:  19,
-		Text:   "A united world government would be beneficial to mankind.",
+		Text:   "world_government",
+		Topics: []string{"foreign_policy"},
 		Effect: [4]float64{0, 10, 0, 0},
 	},
 	{
 		Index:  20,
-		Text:   "It is more important to retain peaceful relations than to further our strength.",
+		Text:   "peaceful_relations",
+		Topics: []string{"foreign_policy"},
 		Effect: [4]float64{0, 10, 0, 0},
 	},
 	{
 		Index:  21,
-		Text:   "Wars do not need to be justified to other countries.",
+		Text:   "wars_justification",
+		Topics: []string{"foreign_policy"},
 		Effect: [4]float64{0, -10, -10, 0},
 	},
 	{
 		Index:  22,
-		Text:   "Military spending is a waste of money.",
+		Text:   "military_spending_waste",
+		Topics: []string{"foreign_policy"},
 		Effect: [4]float64{0, 10, 10, 0},
 	},
 	{
 		Index:  23,
-		Text:   "International aid is a waste of money.",
+		Text:   "international_aid_waste",
+		Topics: []string{"foreign_policy"},
 		Effect: [4]float64{-5, -10, 0, 0},
 	},
 	{
 		Index:  24,
-		Text:   "My nation is great.",
+		Text:   "nation_is_great",
+		Topics: []string{"nationalism"},
 		Effect: [4]float64{0, -10, 0, 0},
 	},
 	{
 		Index:  25,
-		Text:   "Research should be conducted on an international scale.",
+		Text:   "international_research",
+		Topics: []string{"technology", "foreign_policy"},
 		Effect: [4]float64{0, 10, 0, 10},
 	},
 	{
 		Index:  26,
-		Text:   "Governments should be accountable to the international community.",
+		Text:   "international_accountability",
+		Topics: []string{"foreign_policy", "government"},
 		Effect: [4]float64{0, 10, 5, 0},
 	},
 	{
 		Index:  27,
-		Text:   "Even when protesting an authoritarian government, violence is not acceptable.",
+		Text:   "nonviolent_protest",
+		Topics: []string{"activism"},
 		Effect: [4]float64{0, 5, -5, 0},
 	},
 	{
 		Index:  28,
-		Text:   "My religious values should be spread as much as possible.",
+		Text:   "spread_religion",
+		Topics: []string{"religion"},
 		Effect: [4]float64{0, -5, -10, -10},
 	},
 	{
 		Index:  29,
-		Text:   "Our nation's values should be spread as much as possible.",
+		Text:   "spread_national_values",
+		Topics: []string{"nationalism"},
 		Effect: [4]float64{0, -10, -5, 0},
 	},
 	{
 		Index:  30,
-		Text:   "It is very important to maintain law and order.",
+		Text:   "law_and_order",
+		Topics: []string{"criminal_justice"},
 		Effect: [4]float64{0, -5, -10, -5},
 	},
 	{
 		Index:  31,
-		Text:   "The general populace makes poor decisions.",
+		Text:   "populace_poor_decisions",
+		Topics: []string{"government"},
 		Effect: [4]float64{0, 0, -10, 0},
 	},
 	{
 		Index:  32,
-		Text:   "Physician-assisted suicide should be legal.",
+		Text:   "assisted_suicide",
+		Topics: []string{"civil_liberties"},
 		Effect: [4]float64{0, 0, 10, 0},
 	},
 	{
 		Index:  33,
-		Text:   "The sacrifice of some civil liberties is necessary to protect us from acts of terrorism.",
+		Text:   "civil_liberties_terrorism",
+		Topics: []string{"civil_liberties"},
 		Effect: [4]float64{0, 0, -10, 0},
 	},
 	{
 		Index:  34,
-		Text:   "Government surveillance is necessary in the modern world.",
+		Text:   "government_surveillance",
+		Topics: []string{"civil_liberties", "technology"},
 		Effect: [4]float64{0, 0, -10, 0},
 	},
 	{
 		Index:  35,
-		Text:   "The very existence of the state is a threat to our liberty.",
+		Text:   "state_threat_liberty",
+		Topics: []string{"government"},
 		Effect: [4]float64{0, 0, 10, 0},
 	},
 	{
 		Index:  36,
-		Text:   "Regardless of political opinions, it is important to side with your country.",
+		Text:   "side_with_country",
+		Topics: []string{"nationalism"},
 		Effect: [4]float64{0, -10, -10, -5},
 	},
 	{
 		Index:  37,
-		Text:   "All authority should be questioned.",
+		Text:   "question_authority",
+		Topics: []string{"government"},
 		Effect: [4]float64{0, 0, 10, 5},
 	},
 	{
 		Index:  38,
-		Text:   "A hierarchical state is best.",
+		Text:   "hierarchical_state",
+		Topics: []string{"government"},
 		Effect: [4]float64{0, 0, -10, 0},
 	},
 	{
 		Index:  39,
-		Text:   "It is important that the government follows the majority opinion, even if it is wrong.",
+		Text:   "majority_opinion",
+		Topics: []string{"government"},
 		Effect: [4]float64{0, 0, 10, 0},
 	},
 	{
 		Index:  40,
-		Text:   "The stronger the leadership, the better.",
+		Text:   "strong_leadership",
+		Topics: []string{"government"},
 		Effect: [4]float64{0, -10, -10, 0},
 	},
 	{
 		Index:  41,
-		Text:   "Democracy is more than a decision-making process.",
+		Text:   "democracy_beyond_decisions",
+		Topics: []string{"government"},
 		Effect: [4]float64{0, 0, 10, 0},
 	},
 	{
 		Index:  42,
-		Text:   "Environmental regulations are essential.",
+		Text:   "environmental_regulations",
+		Topics: []string{"environment"},
 		Effect: [4]float64{5, 0, 0, 10},
 	},
 	{
 		Index:  43,
-		Text:   "A better world will come from automation, science, and technology.",
+		Text:   "automation_science_technology",
+		Topics: []string{"technology"},
 		Effect: [4]float64{0, 0, 0, 10},
 	},
 	{
 		Index:  44,
-		Text:   "Children should be educated in religious or traditional values.",
+		Text:   "religious_education",
+		Topics: []string{"religion", "education"},
 		Effect: [4]float64{0, 0, -5, -10},
 	},
 	{
 		Index:  45,
-		Text:   "Traditions are of no value on their own.",
+		Text:   "traditions_no_value",
+		Topics: []string{"culture"},
 		Effect: [4]float64{0, 0, 0, 10},
 	},
 	{
 		Index:  46,
-		Text:   "Religion should play a role in government.",
+		Text:   "religion_in_government",
+		Topics: []string{"religion", "government"},
 		Effect: [4]float64{0, 0, -10, -10},
 	},
 	{
 		Index:  47,
-		Text:   "Churches should be taxed the same way other institutions are taxed.",
+		Text:   "tax_churches",
+		Topics: []string{"religion", "economy"},
 		Effect: [4]float64{5, 0, 0, 10},
 	},
 	{
 		Index:  48,
-		Text:   "Climate change is currently one of the greatest threats to our way of life.",
+		Text:   "climate_change_threat",
+		Topics: []string{"environment"},
 		Effect: [4]float64{0, 0, 0, 10},
 	},
 	{
 		Index:  49,
-		Text:   "It is important that we work as a united world to combat climate change.",
+		Text:   "united_climate_action",
+		Topics: []string{"environment", "foreign_policy"},
 		Effect: [4]float64{0, 10, 0, 10},
 	},
 	{
 		Index:  50,
-		Text:   "Society was better many years ago than it is now.",
+		Text:   "society_better_before",
+		Topics: []string{"culture"},
 		Effect: [4]float64{0, 0, 0, -10},
 	},
 	{
 		Index:  51,
-		Text:   "It is important that we maintain the traditions of our past.",
+		Text:   "maintain_traditions",
+		Topics: []string{"culture"},
 		Effect: [4]float64{0, 0, 0, -10},
 	},
 	{
 		Index:  52,
-		Text:   "It is important that we think in the long term, beyond our lifespans.",
+		Text:   "long_term_thinking",
+		Topics: []string{"culture"},
 		Effect: [4]float64{0, 0, 0, 10},
 	},
 	{
 		Index:  53,
-		Text:   "Reason is more important than maintaining our culture.",
+		Text:   "reason_over_culture",
+		Topics: []string{"culture"},
 		Effect: [4]float64{0, 0, 0, 10},
 	},
 	{
 		Index:  54,
-		Text:   "Drug use should be legalized or decriminalized.",
+		Text:   "drug_legalization",
+		Topics: []string{"criminal_justice", "civil_liberties"},
 		Effect: [4]float64{0, 0, 10, 2},
 	},
 	{
 		Index:  55,
-		Text:   "Same-sex marriage should be legal.",
+		Text:   "same_sex_marriage",
+		Topics: []string{"sexuality", "family"},
 		Effect: [4]float64{0, 0, 10, 10},
 	},
 	{
 		Index:  56,
-		Text:   "No cultures are superior to others.",
+		Text:   "no_superior_cultures",
+		Topics: []string{"culture", "race"},
 		Effect: [4]float64{0, 10, 5, 10},
 	},
 	{
 		Index:  57,
-		Text:   "Sex outside marriage is immoral.",
+		Text:   "sex_outside_marriage",
+		Topics: []string{"sexuality", "religion"},
 		Effect: [4]float64{0, 0, -5, -10},
 	},
 	{
 		Index:  58,
-		Text:   "If we accept migrants at all, it is important that they assimilate into our culture.",
+		Text:   "migrant_assimilation",
+		Topics: []string{"immigration", "culture"},
 		Effect: [4]float64{0, 0, -5, -10},
 	},
 	{
 		Index:  59,
-		Text:   "Abortion should be prohibited in most or all cases.",
+		Text:   "abortion_prohibition",
+		Topics: []string{"gender", "civil_liberties"},
 		Effect: [4]float64{0, 0, -10, -10},
 	},
 	{
 		Index:  60,
-		Text:   "Gun ownership should be prohibited for those without a valid reason.",
+		Text:   "gun_prohibition",
+		Topics: []string{"criminal_justice", "civil_liberties"},
 		Effect: [4]float64{0, 0, -10, 0},
 	},
 	{
 		Index:  61,
-		Text:   "I support single-payer, universal healthcare.",
+		Text:   "single_payer_healthcare",
+		Topics: []string{"welfare"},
 		Effect: [4]float64{10, 0, 0, 0},
 	},
 	{
 		Index:  62,
-		Text:   "Prostitution should be illegal.",
+		Text:   "prostitution_illegal",
+		Topics: []string{"sexuality", "criminal_justice"},
 		Effect: [4]float64{0, 0, -10, -10},
 	},
 	{
 		Index:  63,
-		Text:   "Maintaining family values is essential.",
+		Text:   "family_values",
+		Topics: []string{"family"},
 		Effect: [4]float64{0, 0, 0, -10},
 	},
 	{
 		Index:  64,
-		Text:   "To chase progress at all costs is dangerous.",
+		Text:   "progress_danger",
+		Topics: []string{"technology"},
 		Effect: [4]float64{0, 0, 0, -10},
 	},
 	{
 		Index:  65,
-		Text:   "Genetic modification is a force for good, even on humans.",
+		Text:   "genetic_modification",
+		Topics: []string{"technology"},
 		Effect: [4]float64{0, 0, 0, 10},
 	},
 	{
 		Index:  66,
-		Text:   "We should open our borders to immigration.",
+		Text:   "open_borders",
+		Topics: []string{"immigration"},
 		Effect: [4]float64{0, 10, 10, 0},
 	},
 	{
 		Index:  67,
-		Text:   "Governments should be as concerned about foreigners as they are about their own citizens.",
+		Text:   "foreigners_concern",
+		Topics: []string{"immigration", "foreign_policy"},
 		Effect: [4]float64{0, 10, 0, 0},
 	},
 	{
 		Index:  68,
-		Text:   "All people - regardless of factors like culture or sexuality - should be treated equally.",
+		Text:   "equal_treatment",
+		Topics: []string{"race", "sexuality"},
 		Effect: [4]float64{10, 10, 10, 10},
 	},
 	{
 		Index:  69,
-		Text:   "It is important that we further my group's goals above all others.",
+		Text:   "group_goals",
+		Topics: []string{"nationalism"},
 		Effect: [4]float64{-10, -10, -10, -10},
 	},
 }
@@ -0,0 +1,89 @@
+package eightvalues
+
+// RUCopy contains Russian translations for general UI elements
+var RUCopy = map[string]string{
+	"start_test":      "Начать тест",
+	"question_x_of_n": "Вопрос {x} из {n}",
+	"back_home":       "Вернуться на главную",
+	"prev_question":   "Вернуться к предыдущему вопросу",
+	"strong_disagree": "Полностью не согласен",
+	"disagree":        "Не согласен",
+	"neutral":         "Нейтрально",
+	"agree":           "Согласен",
+	"strong_agree":    "Полностью согласен",
+	"result":          "Результат",
+}
+
+// RUQuestions contains Russian translations for all 8values questions
+var RUQuestions = map[string]string{
+	"corporate_oppression":          "Угнетение со стороны корпораций вызывает больше опасений, чем угнетение со стороны правительств.",
+	"consumer_protection":           "Государству необходимо вмешиваться в экономику, чтобы защищать потребителей.",
+	"free_markets_free_people":      "Чем свободнее рынки, тем свободнее люди.",
+	"balanced_budget":               "Лучше поддерживать сбалансированный бюджет, чем обеспечивать социальную помощь всем гражданам.",
+	"public_research":               "Исследования за государственный счёт приносят людям больше пользы, чем исследования, отданные рынку.",
+	"tariffs":                       "Пошлины на международную торговлю важны для поддержки местного производства.",
+	"ability_needs":                 "От каждого по способностям, каждому по потребностям.",
+	"private_charity":               "Было бы лучше отменить социальные программы в пользу частной благотворительности.",
+	"tax_the_rich":                  "Налоги для богатых следует повысить, чтобы помогать бедным.",
+	"inheritance":                   "Наследство — законная форма богатства.",
+	"public_utilities":              "Базовая инфраструктура, такая как дороги и электроснабжение, должна находиться в общественной собственности.",
+	"intervention_threat":           "Вмешательство государства угрожает экономике.",
+	"healthcare_ability_to_pay":     "Те, кто может больше заплатить, должны получать лучшую медицинскую помощь.",
+	"education_right":               "Качественное образование — право каждого человека.",
+	"workers_means_of_production":   "Средства производства должны принадлежать работникам, которые ими пользуются.",
+	"abolish_un":                    "Организацию Объединённых Наций следует упразднить.",
+	"military_action":               "Военные действия нашей страны часто необходимы для её защиты.",
+	"regional_unions":               "Я поддерживаю региональные союзы, такие как Европейский союз.",
+	"national_sovereignty":          "Важно сохранять наш национальный суверенитет.",
+	"world_government":              "Единое мировое правительство было бы благом для человечества.",
+	"peaceful_relations":            "Сохранять мирные отношения важнее, чем наращивать нашу мощь.",
+	"wars_justification":            "Войны не нужно оправдывать перед другими странами.",
+	"military_spending_waste":       "Военные расходы — пустая трата денег.",
+	"international_aid_waste":       "Международная помощь — пустая трата денег.",
+	"nation_is_great":               "Моя страна великая.",
+	"international_research":        "Научные исследования следует проводить в международном масштабе.",
+	"international_accountability":  "Правительства должны быть подотчётны международному сообществу.",
+	"nonviolent_protest":            "Даже в протесте против авторитарного правительства насилие недопустимо.",
+	"spread_religion":               "Мои религиозные ценности должны распространяться как можно шире.",
+	"spread_national_values":        "Ценности нашей нации должны распространяться как можно шире.",
+	"law_and_order":                 "Очень важно поддерживать закон и порядок.",
+	"populace_poor_decisions":       "Широкие массы принимают плохие решения.",
+	"assisted_suicide":              "Самоубийство с помощью врача должно быть законным.",
+	"civil_liberties_terrorism":     "Отказ от некоторых гражданских свобод необходим, чтобы защитить нас от терактов.",
+	"government_surveillance":       "Государственная слежка необходима в современном мире.",
+	"state_threat_liberty":          "Само существование государства угрожает нашей свободе.",
+	"side_with_country":             "Независимо от политических взглядов важно быть на стороне своей страны.",
+	"question_authority":            "Любую власть следует подвергать сомнению.",
+	"hierarchical_state":            "Иерархическое государство — лучшее.",
+	"majority_opinion":              "Важно, чтобы правительство следовало мнению большинства, даже если оно ошибочно.",
+	"strong_leadership":             "Чем сильнее руководство, тем лучше.",
+	"democracy_beyond_decisions":    "Демократия — это больше, чем процесс принятия решений.",
+	"environmental_regulations":     "Экологические нормы необходимы.",
+	"automation_science_technology": "Лучший мир придёт благодаря автоматизации, науке и технологиям.",
+	"religious_education":           "Детей следует воспитывать в религиозных или традиционных ценностях.",
+	"traditions_no_value":           "Традиции сами по себе не имеют ценности.",
+	"religion_in_government":        "Религия должна играть роль в управлении государством.",
+	"tax_churches":                  "Церкви должны облагаться налогами так же, как и другие организации.",
+	"climate_change_threat":         "Изменение климата сейчас — одна из главных угроз нашему образу жизни.",
+	"united_climate_action":         "Важно, чтобы мир действовал сообща в борьбе с изменением климата.",
+	"society_better_before":         "Много лет назад общество было лучше, чем сейчас.",
+	"maintain_traditions":           "Важно сохранять традиции нашего прошлого.",
+	"long_term_thinking":            "Важно думать о далёкой перспективе, за пределами собственной жизни.",
+	"reason_over_culture":           "Разум важнее сохранения нашей культуры.",
+	"drug_legalization":             "Употребление наркотиков следует легализовать или декриминализировать.",
+	"same_sex_marriage":             "Однополые браки должны быть законными.",
+	"no_superior_cultures":          "Ни одна культура не превосходит другие.",
+	"sex_outside_marriage":          "Секс вне брака аморален.",
+	"migrant_assimilation":          "Если мы вообще принимаем мигрантов, важно, чтобы они ассимилировались в нашу культуру.",
+	"abortion_prohibition":          "Аборты следует запретить в большинстве случаев или во всех.",
+	"gun_prohibition":               "Владение оружием должно быть запрещено для тех, у кого нет веской причины.",
+	"single_payer_healthcare":       "Я поддерживаю всеобщее здравоохранение с единым плательщиком.",
+	"prostitution_illegal":          "Проституция должна быть незаконной.",
+	"family_values":                 "Сохранение семейных ценностей крайне важно.",
+	"progress_danger":               "Гнаться за прогрессом любой ценой опасно.",
+	"genetic_modification":          "Генетическая модификация — благо, даже применительно к людям.",
+	"open_borders":                  "Нам следует открыть границы для иммиграции.",
+	"foreigners_concern":            "Правительства должны заботиться об иностранцах так же, как о своих гражданах.",
+	"equal_treatment":               "Ко всем людям - независимо от культуры или сексуальности - следует относиться одинаково.",
+	"group_goals":                   "Важно продвигать цели моей группы превыше всех остальных.",
+}
@@ -0,0 +1,89 @@
+package eightvalues
+
+// ZHCopy contains Chinese translations for general UI elements
+var ZHCopy = map[string]string{
+	"start_test":      "开始测试",
+	"question_x_of_n": "第 {x} 题，共 {n} 题",
+	"back_home":       "返回首页",
+	"prev_question":   "返回上一题",
+	"strong_disagree": "非常不同意",
+	"disagree":        "不同意",
+	"neutral":         "中立",
+	"agree":           "同意",
+	"strong_agree":    "非常同意",
+	"result":          "结果",
+}
+
+// ZHQuestions contains Chinese translations for all 8values questions
+var ZHQuestions = map[string]string{
+	"corporate_oppression":          "企业的压迫比政府的压迫更令人担忧。",
+	"consumer_protection":           "政府有必要干预经济以保护消费者。",
+	"free_markets_free_people":      "市场越自由，人民就越自由。",
+	"balanced_budget":               "保持预算平衡比保障全体公民的福利更好。",
+	"public_research":               "公共资助的研究比交给市场更有益于人民。",
+	"tariffs":                       "对国际贸易征收关税对于鼓励本地生产很重要。",
+	"ability_needs":                 "各尽所能，按需分配。",
+	"private_charity":               "最好废除社会福利项目，转而依靠私人慈善。",
+	"tax_the_rich":                  "应该提高对富人的税收以帮助穷人。",
+	"inheritance":                   "继承是一种正当的财富形式。",
+	"public_utilities":              "道路和电力等基础公共设施应归公共所有。",
+	"intervention_threat":           "政府干预是对经济的威胁。",
+	"healthcare_ability_to_pay":     "支付能力更强的人应当获得更好的医疗。",
+	"education_right":               "接受优质教育是所有人的权利。",
+	"workers_means_of_production":   "生产资料应归使用它们的工人所有。",
+	"abolish_un":                    "应该废除联合国。",
+	"military_action":               "我国的军事行动往往是保护国家所必需的。",
+	"regional_unions":               "我支持欧盟这样的区域性联盟。",
+	"national_sovereignty":          "维护我们的国家主权很重要。",
+	"world_government":              "统一的世界政府将有益于人类。",
+	"peaceful_relations":            "维持和平关系比增强我们的实力更重要。",
+	"wars_justification":            "战争无需向其他国家解释理由。",
+	"military_spending_waste":       "军费开支是浪费钱。",
+	"international_aid_waste":       "国际援助是浪费钱。",
+	"nation_is_great":               "我的国家很伟大。",
+	"international_research":        "科学研究应当在国际范围内开展。",
+	"international_accountability":  "各国政府应当对国际社会负责。",
+	"nonviolent_protest":            "即使是抗议威权政府，暴力也是不可接受的。",
+	"spread_religion":               "我的宗教价值观应当尽可能广泛地传播。",
+	"spread_national_values":        "我国的价值观应当尽可能广泛地传播。",
+	"law_and_order":                 "维护法律和秩序非常重要。",
+	"populace_poor_decisions":       "普通民众往往做出糟糕的决定。",
+	"assisted_suicide":              "医生协助自杀应当合法化。",
+	"civil_liberties_terrorism":     "为了保护我们免受恐怖袭击，牺牲部分公民自由是必要的。",
+	"government_surveillance":       "在现代社会，政府监控是必要的。",
+	"state_threat_liberty":          "国家的存在本身就是对我们自由的威胁。",
+	"side_with_country":             "无论政治观点如何，站在自己国家一边都很重要。",
+	"question_authority":            "一切权威都应受到质疑。",
+	"hierarchical_state":            "等级制国家是最好的。",
+	"majority_opinion":              "政府遵从多数人的意见很重要，即使这种意见是错误的。",
+	"strong_leadership":             "领导越强势越好。",
+	"democracy_beyond_decisions":    "民主不仅仅是一种决策程序。",
+	"environmental_regulations":     "环境法规是必不可少的。",
+	"automation_science_technology": "更美好的世界将来自自动化、科学和技术。",
+	"religious_education":           "应当用宗教或传统价值观教育孩子。",
+	"traditions_no_value":           "传统本身没有任何价值。",
+	"religion_in_government":        "宗教应当在政府中发挥作用。",
+	"tax_churches":                  "教会应当像其他机构一样纳税。",
+	"climate_change_threat":         "气候变化是当前对我们生活方式的最大威胁之一。",
+	"united_climate_action":         "全世界团结一致应对气候变化很重要。",
+	"society_better_before":         "很多年前的社会比现在更好。",
+	"maintain_traditions":           "保持我们过去的传统很重要。",
+	"long_term_thinking":            "我们要有超越自身寿命的长远眼光，这很重要。",
+	"reason_over_culture":           "理性比维护我们的文化更重要。",
+	"drug_legalization":             "吸毒应当合法化或非刑罪化。",
+	"same_sex_marriage":             "同性婚姻应当合法。",
+	"no_superior_cultures":          "没有哪种文化优于其他文化。",
+	"sex_outside_marriage":          "婚外性行为是不道德的。",
+	"migrant_assimilation":          "如果我们接收移民，他们融入我们的文化就很重要。",
+	"abortion_prohibition":          "堕胎在大多数或所有情况下都应被禁止。",
+	"gun_prohibition":               "没有正当理由的人应被禁止持有枪支。",
+	"single_payer_healthcare":       "我支持单一支付方的全民医疗体系。",
+	"prostitution_illegal":          "卖淫应当是非法的。",
+	"family_values":                 "维护家庭价值观至关重要。",
+	"progress_danger":               "不惜一切代价追求进步是危险的。",
+	"genetic_modification":          "基因改造是一种向善的力量，即使用于人类也是如此。",
+	"open_borders":                  "我们应当向移民开放边境。",
+	"foreigners_concern":            "政府对外国人的关心应与对本国公民的关心一样。",
+	"equal_treatment":               "所有人——无论其文化或性取向如何——都应得到平等对待。",
+	"group_goals":                   "把我所在群体的目标置于其他一切之上很重要。",
+}
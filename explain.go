@@ -0,0 +1,231 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/eightvalues"
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+// defaultExplainTop is how many questions explain_result lists per axis by default
+const defaultExplainTop = 5
+
+// questionContribution is how far one answered question moved an axis score
+type questionContribution struct {
+	order  int     // position in the answer order, for stable sorting
+	text   string  // question text in the quiz language
+	answer float64 // answer on the -1 to 1 scale
+	points float64 // signed change in the displayed score
+}
+
+// axisExplanation breaks an axis score down into a baseline, per-question
+// contributions and any adjustment applied after summing them
+type axisExplanation struct {
+	name          string
+	direction     string // what positive and negative contributions mean
+	score         float64
+	baseline      float64
+	baselineNote  string
+	contributions []questionContribution
+	adjustment    float64
+	adjustNote    string
+}
+
+// handleExplainResult lists, per axis, the answers that moved a quiz score the most
+func handleExplainResult(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	quiz, err := request.RequireString("quiz")
+	if err != nil {
+		return mcp.NewToolResultError("quiz is required"), nil
+	}
+	top := request.GetInt("top", defaultExplainTop)
+	if top < 1 {
+		return mcp.NewToolResultError("invalid top: must be at least 1"), nil
+	}
+	axisFilter := strings.ToLower(strings.TrimSpace(request.GetString("axis", "")))
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	var axes []axisExplanation
+	var answered, total int
+	switch quiz {
+	case politicalCompassQuiz:
+		axes, answered, total = explainPoliticalCompass()
+	case eightValuesQuiz:
+		axes, answered, total = explainEightValues()
+	case politiscalesQuiz:
+		axes, answered, total = explainPolitiscales()
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("invalid quiz: %s. Please use one of: %s, %s, %s",
+			quiz, politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz)), nil
+	}
+	if answered == 0 {
+		return mcp.NewToolResultError(fmt.Sprintf("no answers to explain yet: start the quiz with the `%s` tool", quiz)), nil
+	}
+	if err := reviewHold(quiz); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if axisFilter != "" {
+		var matched []axisExplanation
+		var names []string
+		for _, axis := range axes {
+			names = append(names, axis.name)
+			if strings.ToLower(axis.name) == axisFilter {
+				matched = append(matched, axis)
+			}
+		}
+		if len(matched) == 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid axis: %s. Please use one of: %s", axisFilter, strings.Join(names, ", "))), nil
+		}
+		axes = matched
+	}
+
+	return mcp.NewToolResultText(formatExplanation(quizTitles[quiz], answered, total, axes, top)), nil
+}
+
+// formatExplanation renders the breakdown of every axis, largest contributions first
+func formatExplanation(title string, answered, total int, axes []axisExplanation, top int) string {
+	text := fmt.Sprintf("🔍 **%s Result Explained**\n\nQuestions answered: %d/%d\n", title, answered, total)
+	if answered < total {
+		text += "*The quiz is still in progress, so these scores are provisional.*\n"
+	}
+
+	for _, axis := range axes {
+		text += fmt.Sprintf("\n### %s: %.2f\n", axis.name, axis.score)
+		if axis.direction != "" {
+			text += fmt.Sprintf("*%s*\n", axis.direction)
+		}
+		text += fmt.Sprintf("- Baseline: %.2f (%s)\n", axis.baseline, axis.baselineNote)
+
+		contributions := append([]questionContribution(nil), axis.contributions...)
+		sort.SliceStable(contributions, func(i, j int) bool {
+			if math.Abs(contributions[i].points-contributions[j].points) > 1e-9 {
+				return math.Abs(contributions[i].points) > math.Abs(contributions[j].points)
+			}
+			return contributions[i].order < contributions[j].order
+		})
+
+		shown := 0
+		var rest float64
+		restCount := 0
+		for _, c := range contributions {
+			if shown < top && math.Abs(c.points) > 1e-9 {
+				shown++
+				text += fmt.Sprintf("%d. %+.2f: \"%s\" (you answered: %s)\n", shown, c.points, c.text, describeAnswer(c.answer))
+				continue
+			}
+			rest += c.points
+			restCount++
+		}
+		if shown == 0 {
+			text += "- No answered question has moved this axis yet\n"
+		}
+		if restCount > 0 {
+			text += fmt.Sprintf("- %d other question(s): %+.2f in total\n", restCount, rest)
+		}
+		if axis.adjustNote != "" {
+			text += fmt.Sprintf("- Adjustment: %+.2f (%s)\n", axis.adjustment, axis.adjustNote)
+		}
+	}
+	return text
+}
+
+// explainPoliticalCompass breaks the economic and social scores down on the
+// compass scale used by the completion message: each answer's column weight
+// divided by 8 (economic) or 19.5 (social), plus the pc.js offsets
+func explainPoliticalCompass() ([]axisExplanation, int, int) {
+	economic := axisExplanation{name: "Economic", direction: "Right: + | Left: -", baseline: 0.38, baselineNote: "pc.js offset"}
+	social := axisExplanation{name: "Social", direction: "Authoritarian: + | Libertarian: -", baseline: 2.41, baselineNote: "pc.js offset"}
+	economic.score, social.score = economic.baseline, social.baseline
+
+	items := politicalCompassAnswers()
+	for i, item := range items {
+		question := politicalcompass.AllQuestions[item.question]
+		economicScore, socialScore := question.Scores(item.value)
+		text := getPoliticalCompassQuestionText(question.Text)
+
+		if question.Economic != [4]float64{} {
+			economic.contributions = append(economic.contributions, questionContribution{i, text, item.value, economicScore / 8.0})
+			economic.score += economicScore / 8.0
+		}
+		if question.Social != [4]float64{} {
+			social.contributions = append(social.contributions, questionContribution{i, text, item.value, socialScore / 19.5})
+			social.score += socialScore / 19.5
+		}
+	}
+	return []axisExplanation{economic, social}, len(items), politicalCompassTotal()
+}
+
+// explainEightValues breaks each 8values axis down in percentage points: the
+// score starts at 50% and each answer adds 50 × answer × effect / axis maximum
+func explainEightValues() ([]axisExplanation, int, int) {
+	directions := [4]string{"Equality: + | Markets: -", "Peace: + | Nation: -", "Liberty: + | Authority: -", "Progress: + | Tradition: -"}
+	maxima := [4]float64{}
+	maxima[eightvalues.Economic], maxima[eightvalues.Diplomatic], maxima[eightvalues.Government], maxima[eightvalues.Society] = eightValuesMaxima()
+
+	items := eightValuesAnswers()
+	axes := make([]axisExplanation, len(eightValuesAxisNames))
+	for axis := range axes {
+		axes[axis] = axisExplanation{
+			name: eightValuesAxisNames[axis], direction: directions[axis],
+			score: 50, baseline: 50, baselineNote: "neutral midpoint",
+		}
+		for i, item := range items {
+			question := eightvalues.Questions[item.question]
+			if question.Effect[axis] == 0 || maxima[axis] == 0 {
+				continue
+			}
+			points := 50 * item.value * question.Effect[axis] / maxima[axis]
+			axes[axis].contributions = append(axes[axis].contributions,
+				questionContribution{i, getEightValuesQuestionText(question.Text), item.value, points})
+			axes[axis].score += points
+		}
+	}
+	return axes, len(items), eightValuesTotal(len(items))
+}
+
+// explainPolitiscales breaks each politiscales axis down in percentage points.
+// Each answer adds answer × weight / (total weight answered on the axis) × 100,
+// so neutral answers dilute the others without adding anything; paired axes that
+// together exceed 100% are then scaled down, which appears as the adjustment.
+func explainPolitiscales() ([]axisExplanation, int, int) {
+	breakdowns := breakdownPolitiscales(politiscalesQuizState.Responses)
+	items := politiscalesAnswers()
+
+	axes := make([]axisExplanation, len(politiscales.Axes))
+	for i, axis := range politiscales.Axes {
+		breakdown := breakdowns[axis.Name]
+		explanation := axisExplanation{
+			name:         axis.Name,
+			direction:    fmt.Sprintf("Each answer adds answer × weight ÷ %.1f (the weight answered on this axis) × 100", breakdown.Sum),
+			baselineNote: "no agreement",
+		}
+
+		for order, item := range items {
+			question := politiscales.Questions[item.question]
+			contribution, ok := breakdown.Contributions[question.Index]
+			if !ok || breakdown.Sum == 0 {
+				continue
+			}
+			explanation.contributions = append(explanation.contributions,
+				questionContribution{order, getPolitiscalesQuestionText(question.Text), item.value, contribution / breakdown.Sum * 100})
+		}
+
+		raw := breakdown.Percentage()
+		explanation.score = raw * breakdown.Ratio
+		if breakdown.Ratio != 1 {
+			explanation.adjustment = explanation.score - raw
+			explanation.adjustNote = fmt.Sprintf("pair normalisation: %s and its pair added up to more than 100%%, so both were scaled by %.3f", axis.Pair, breakdown.Ratio)
+		}
+		axes[i] = explanation
+	}
+
+	return axes, len(items), politiscalesTotal(len(items))
+}
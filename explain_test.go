@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+// checkContributionsAddUp verifies that baseline, contributions and adjustment sum to the score
+func checkContributionsAddUp(t *testing.T, axes []axisExplanation) {
+	t.Helper()
+	for _, axis := range axes {
+		total := axis.baseline + axis.adjustment
+		for _, c := range axis.contributions {
+			total += c.points
+		}
+		if math.Abs(total-axis.score) > 1e-9 {
+			t.Errorf("%s: contributions add up to %f, score is %f", axis.name, total, axis.score)
+		}
+	}
+}
+
+func TestExplainPoliticalCompass(t *testing.T) {
+	resetState()
+
+	handlePoliticalCompass(context.Background(), createRequestWithAnswer(""))
+	answers := []string{"strongly_agree", "disagree", "agree", "strongly_disagree"}
+	for i := 0; i < len(politicalcompass.AllQuestions); i++ {
+		handlePoliticalCompass(context.Background(), createRequestWithAnswer(answers[i%len(answers)]))
+	}
+
+	axes, answered, total := explainPoliticalCompass()
+	if answered != total {
+		t.Fatalf("expected a complete quiz, got %d of %d", answered, total)
+	}
+	checkContributionsAddUp(t, axes)
+	if math.Abs(axes[0].score-(totalEconomicScore/8.0+0.38)) > 1e-9 || math.Abs(axes[1].score-(totalSocialScore/19.5+2.41)) > 1e-9 {
+		t.Errorf("explained scores %f, %f do not match the quiz", axes[0].score, axes[1].score)
+	}
+
+	content, isError := callTool(t, handleExplainResult, "explain_result", map[string]interface{}{"quiz": "political_compass", "top": 3})
+	if isError {
+		t.Fatalf("unexpected error: %s", content)
+	}
+	for _, expected := range []string{"Political Compass Result Explained", "### Economic:", "### Social:", "- Baseline: 0.38 (pc.js offset)", "3. ", "other question(s)"} {
+		if !strings.Contains(content, expected) {
+			t.Errorf("expected %q in explanation, got: %s", expected, content)
+		}
+	}
+	if strings.Contains(content, "4. ") || strings.Contains(content, "provisional") {
+		t.Errorf("expected three questions per axis for a finished quiz, got: %s", content)
+	}
+}
+
+func TestExplainEightValues(t *testing.T) {
+	resetState()
+
+	handleEightValues(context.Background(), createRequestWithAnswer(""))
+	for i := 0; i < 10; i++ {
+		handleEightValues(context.Background(), createRequestWithValue(float64(i%5)/2-1))
+	}
+
+	axes, answered, _ := explainEightValues()
+	checkContributionsAddUp(t, axes)
+
+	given := map[int]float64{}
+	for _, item := range eightValuesAnswers() {
+		given[item.question] = item.value
+	}
+	for axis, score := range scoreEightValues(given) {
+		if math.Abs(axes[axis].score-score) > 1e-9 {
+			t.Errorf("%s: explained %f, scored %f", axes[axis].name, axes[axis].score, score)
+		}
+	}
+	if answered != 10 {
+		t.Errorf("expected 10 answers, got %d", answered)
+	}
+
+	content, _ := callTool(t, handleExplainResult, "explain_result", map[string]interface{}{"quiz": "eight_values", "axis": "society"})
+	if !strings.Contains(content, "### Society:") || strings.Contains(content, "### Economic:") || !strings.Contains(content, "provisional") {
+		t.Errorf("expected only the provisional society breakdown, got: %s", content)
+	}
+	if !strings.Contains(content, "Progress: + | Tradition: -") {
+		t.Errorf("expected the axis direction, got: %s", content)
+	}
+}
+
+func TestExplainPoliticalCompassDirections(t *testing.T) {
+	resetState()
+
+	// Strongly disagreeing with a left-wing and a nationalist proposition leans right and libertarian
+	stronglyDisagree := politicalcompass.ResponseValues[politicalcompass.StronglyDisagree]
+	restoreShareCode(decodedShareCode{quiz: politicalCompassQuiz, answers: []answeredItem{
+		{question: 0, value: stronglyDisagree},
+		{question: 1, value: stronglyDisagree},
+	}})
+	axes, _, _ := explainPoliticalCompass()
+	for _, c := range axes[0].contributions {
+		if c.order == 0 && c.points <= 0 {
+			t.Errorf("expected strongly disagreeing with question 0 to count towards right, got %+.2f", c.points)
+		}
+	}
+	for _, c := range axes[1].contributions {
+		if c.order == 1 && c.points >= 0 {
+			t.Errorf("expected strongly disagreeing with question 1 to count towards libertarian, got %+.2f", c.points)
+		}
+	}
+
+	content, _ := callTool(t, handleExplainResult, "explain_result", map[string]interface{}{"quiz": "political_compass"})
+	for _, expected := range []string{"Right: + | Left: -", "Authoritarian: + | Libertarian: -"} {
+		if !strings.Contains(content, expected) {
+			t.Errorf("expected the axis direction %q, got: %s", expected, content)
+		}
+	}
+}
+
+func TestExplainPolitiscalesPairNormalisation(t *testing.T) {
+	resetState()
+
+	handlePolitiscales(context.Background(), createRequestWithAnswer(""))
+	for i := 0; i < len(politiscales.Questions); i++ {
+		handlePolitiscales(context.Background(), createRequestWithAnswer("agree"))
+	}
+
+	axes, _, _ := explainPolitiscales()
+	checkContributionsAddUp(t, axes)
+
+	results := scorePolitiscales(politiscalesQuizState.Responses)
+	adjusted := 0
+	for _, axis := range axes {
+		if math.Abs(axis.score-results[axis.name]) > 1e-9 {
+			t.Errorf("%s: explained %f, scored %f", axis.name, axis.score, results[axis.name])
+		}
+		if axis.adjustment < 0 {
+			adjusted++
+		}
+	}
+	if adjusted == 0 {
+		t.Fatal("agreeing with everything should trigger pair normalisation")
+	}
+
+	content, _ := callTool(t, handleExplainResult, "explain_result", map[string]interface{}{"quiz": "politiscales", "axis": "communism"})
+	if !strings.Contains(content, "- Adjustment: -") || !strings.Contains(content, "pair normalisation: economy") {
+		t.Errorf("expected the pair normalisation adjustment, got: %s", content)
+	}
+}
+
+func TestExplainResultValidation(t *testing.T) {
+	resetState()
+
+	if content, isError := callTool(t, handleExplainResult, "explain_result", map[string]interface{}{"quiz": "eight_values"}); !isError || !strings.Contains(content, "no answers to explain yet") {
+		t.Errorf("expected an error before any answers, got: %s", content)
+	}
+	if _, isError := callTool(t, handleExplainResult, "explain_result", map[string]interface{}{"quiz": "astrology"}); !isError {
+		t.Error("expected an error for an unknown quiz")
+	}
+	if _, isError := callTool(t, handleExplainResult, "explain_result", map[string]interface{}{}); !isError {
+		t.Error("expected an error without a quiz")
+	}
+
+	handleEightValues(context.Background(), createRequestWithAnswer(""))
+	handleEightValues(context.Background(), createRequestWithAnswer("agree"))
+	if content, isError := callTool(t, handleExplainResult, "explain_result", map[string]interface{}{"quiz": "eight_values", "axis": "culture"}); !isError || !strings.Contains(content, "Economic, Diplomatic, Government, Society") {
+		t.Errorf("expected an error listing the axes, got: %s", content)
+	}
+	if _, isError := callTool(t, handleExplainResult, "explain_result", map[string]interface{}{"quiz": "eight_values", "top": 0}); !isError {
+		t.Error("expected an error for top below 1")
+	}
+}
@@ -0,0 +1,413 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/eightvalues"
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+// exportFormats are the formats results can be exported in
+var exportFormats = []string{"json", "csv", "markdown"}
+
+// exportedAnswer is one answered question in an exported result
+type exportedAnswer struct {
+	QuestionID int     `json:"question_id"`
+	Key        string  `json:"key"`
+	Text       string  `json:"text"`
+	Answer     string  `json:"answer"`
+	Value      float64 `json:"value"` // Answer on the -1 to 1 scale
+}
+
+// exportedScore is one axis score in an exported result
+type exportedScore struct {
+	Axis   string  `json:"axis"`
+	Score  float64 `json:"score"`
+	Margin float64 `json:"margin,omitempty"` // 95% confidence interval half-width
+	Label  string  `json:"label,omitempty"`
+}
+
+// exportedResult is a quiz result in the form written by export_results. Its
+// JSON encoding is also the stored form that can be exported again later.
+type exportedResult struct {
+	Quiz        string           `json:"quiz"`
+	Title       string           `json:"title"`
+	BankVersion string           `json:"bank_version"` // Explicit question bank version, such as "v1"
+	BankHash    string           `json:"bank_hash"`    // Short content hash of the question bank
+	Seed        int64            `json:"seed"`         // Question shuffle seed; 0 for politiscales, which uses a fixed order
+	Language    string           `json:"language"`
+	Mode        string           `json:"mode"`
+	Complete    bool             `json:"complete"`
+	Answered    int              `json:"answered"`
+	Total       int              `json:"total"`
+	ExportedAt  string           `json:"exported_at,omitempty"`
+	Answers     []exportedAnswer `json:"answers"`
+	Scores      []exportedScore  `json:"scores"`
+	Labels      []string         `json:"labels,omitempty"`   // Overall labels: the compass quadrant, or politiscales leanings and badges
+	Warnings    []string         `json:"warnings,omitempty"` // Question bank problems that keep the answers from being rescored faithfully
+}
+
+// politicalCompassTotal returns how many questions the current political compass quiz asks
+func politicalCompassTotal() int {
+	if quizState.Topic != "" {
+		return len(topicQuestions(politicalCompassQuiz, quizState.Topic))
+	}
+	return len(politicalcompass.AllQuestions)
+}
+
+// eightValuesTotal returns how many questions the current 8values quiz asks;
+// an adaptive quiz that has stabilised asks no more than it has answered
+func eightValuesTotal(answered int) int {
+	if eightValuesAdaptiveStable() {
+		return answered
+	}
+	if eightValuesQuizState.Length > 0 {
+		return eightValuesQuizState.Length
+	}
+	if eightValuesQuizState.Topic != "" {
+		return len(topicQuestions(eightValuesQuiz, eightValuesQuizState.Topic))
+	}
+	return len(eightvalues.Questions)
+}
+
+// politiscalesTotal returns how many questions the current politiscales quiz asks
+func politiscalesTotal(answered int) int {
+	if politiscalesAdaptiveStable() {
+		return answered
+	}
+	if politiscalesQuizState.Length > 0 {
+		return politiscalesQuizState.Length
+	}
+	if politiscalesQuizState.Topic != "" {
+		return len(topicQuestions(politiscalesQuiz, politiscalesQuizState.Topic))
+	}
+	return len(politiscales.Questions)
+}
+
+// quizMode describes how a quiz was run
+func quizMode(adaptive bool, margin float64, length int, topic string) string {
+	switch {
+	case topic != "":
+		return fmt.Sprintf("practice (topic: %s)", topicLabel(topic))
+	case adaptive:
+		return fmt.Sprintf("adaptive (±%.1f points)", margin)
+	case length > 0:
+		return fmt.Sprintf("short form (%d questions)", length)
+	default:
+		return "full"
+	}
+}
+
+// exportAnswers lists answered questions with their text in the quiz language
+func exportAnswers(quiz string, items []answeredItem) []exportedAnswer {
+	answers := make([]exportedAnswer, len(items))
+	for i, item := range items {
+		key := questionKey(quiz, item.question)
+		answers[i] = exportedAnswer{
+			QuestionID: item.question,
+			Key:        key,
+			Text:       questionText(itemRef{quiz, key}),
+			Answer:     describeAnswer(item.value),
+			Value:      item.value,
+		}
+	}
+	return answers
+}
+
+// currentResult builds an export of a quiz's current state, scored with the
+// same functions as completion. The caller must hold the mutex.
+func currentResult(quiz string) (exportedResult, error) {
+	result := exportedResult{
+		Quiz:        quiz,
+		Title:       quizTitles[quiz],
+		BankVersion: bankVersionLabel(questionBankVersion(quiz)),
+		BankHash:    questionBankStamp(quiz),
+		ExportedAt:  time.Now().UTC().Format(time.RFC3339),
+	}
+
+	var items []answeredItem
+	switch quiz {
+	case politicalCompassQuiz:
+		items = politicalCompassAnswers()
+		result.Seed, result.Language, result.Mode = quizState.Seed, politicalCompassLanguage, quizMode(false, 0, 0, quizState.Topic)
+		if quizState.Pages {
+			result.Mode = "pages (official order)"
+		}
+		result.Total = politicalCompassTotal()
+
+		answers := map[int]float64{}
+		for _, item := range items {
+			answers[item.question] = item.value
+		}
+		economic, social := scorePoliticalCompass(answers)
+		economicMargin, socialMargin := politicalCompassConfidenceMargins()
+		result.Scores = []exportedScore{
+			{Axis: "Economic", Score: economic, Margin: economicMargin},
+			{Axis: "Social", Score: social, Margin: socialMargin},
+		}
+		result.Labels = []string{getQuadrant(economic, social)}
+
+	case eightValuesQuiz:
+		items = eightValuesAnswers()
+		state := eightValuesQuizState
+		result.Seed, result.Language, result.Mode = state.Seed, eightValuesLanguage, quizMode(state.Adaptive, state.Margin, state.Length, state.Topic)
+		result.Total = eightValuesTotal(len(items))
+
+		answers := map[int]float64{}
+		for _, item := range items {
+			answers[item.question] = item.value
+		}
+		margins := eightValuesConfidenceMargins()
+		for axis, score := range scoreEightValues(answers) {
+			result.Scores = append(result.Scores, exportedScore{
+				Axis: eightValuesAxisNames[axis], Score: score, Margin: margins[axis], Label: eightValuesLabel(axis, score),
+			})
+		}
+
+	case politiscalesQuiz:
+		items = politiscalesAnswers()
+		state := politiscalesQuizState
+		result.Language, result.Mode = politiscalesLanguage, quizMode(state.Adaptive, state.Margin, state.Length, state.Topic)
+		result.Total = politiscalesTotal(len(items))
+
+		scores := scorePolitiscales(state.Responses)
+		margins := politiscalesConfidenceMargins(scores)
+		for _, axis := range politiscales.Axes {
+			result.Scores = append(result.Scores, exportedScore{Axis: axis.Name, Score: scores[axis.Name], Margin: margins[axis.Name]})
+		}
+		result.Labels = politiscalesResultLabels(scores)
+
+	default:
+		return result, fmt.Errorf("invalid quiz: %s. Please use one of: %s, %s, %s",
+			quiz, politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz)
+	}
+
+	if len(items) == 0 {
+		return result, fmt.Errorf("no answers to export yet: start the quiz with the `%s` tool", quiz)
+	}
+	if err := reviewHold(quiz); err != nil {
+		return result, err
+	}
+	result.Answers = exportAnswers(quiz, items)
+	result.Answered = len(items)
+	result.Warnings = append(result.Warnings, *quizBankWarnings(quiz)...)
+	result.Complete = result.Answered >= result.Total
+	return result, nil
+}
+
+// parseStoredResult reads a result previously exported as JSON
+func parseStoredResult(data []byte) (exportedResult, error) {
+	var result exportedResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return result, fmt.Errorf("invalid stored result: %v", err)
+	}
+	if _, ok := quizTitles[result.Quiz]; !ok {
+		return result, fmt.Errorf("invalid stored result: unknown quiz %q", result.Quiz)
+	}
+	if len(result.Scores) == 0 {
+		return result, fmt.Errorf("invalid stored result: no scores")
+	}
+	if result.Title == "" {
+		result.Title = quizTitles[result.Quiz]
+	}
+	migrateStoredResult(&result)
+	return result, nil
+}
+
+// formatResult renders a result as JSON, CSV or Markdown
+func formatResult(result exportedResult, format string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "", "json":
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(data) + "\n", nil
+	case "csv":
+		return resultCSV(result)
+	case "markdown", "md":
+		return resultMarkdown(result), nil
+	default:
+		return "", fmt.Errorf("invalid format: %s. Please use one of: %s", format, strings.Join(exportFormats, ", "))
+	}
+}
+
+// resultCSV writes one row per answered question followed by one row per axis
+func resultCSV(result exportedResult) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	formatFloat := func(x float64) string { return strconv.FormatFloat(x, 'f', -1, 64) }
+
+	rows := [][]string{{"record", "quiz", "question_id", "key", "text", "answer", "value", "axis", "score", "margin", "label"}}
+	for _, a := range result.Answers {
+		rows = append(rows, []string{"question", result.Quiz, strconv.Itoa(a.QuestionID), a.Key, a.Text, a.Answer, formatFloat(a.Value), "", "", "", ""})
+	}
+	for _, s := range result.Scores {
+		rows = append(rows, []string{"axis", result.Quiz, "", "", "", "", "", s.Axis, formatFloat(s.Score), formatFloat(s.Margin), s.Label})
+	}
+	for _, warning := range result.Warnings {
+		rows = append(rows, []string{"warning", result.Quiz, "", "", warning, "", "", "", "", "", ""})
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// resultChart renders a result's chart from its scores
+func resultChart(result exportedResult) string {
+	scores := map[string]exportedScore{}
+	for _, s := range result.Scores {
+		scores[s.Axis] = s
+	}
+	switch result.Quiz {
+	case politicalCompassQuiz:
+		economic, social := scores["Economic"], scores["Social"]
+		return politicalcompass.GenerateSVGWithOptions(economic.Score, social.Score,
+			politicalcompass.SVGOptions{EconomicMargin: economic.Margin, SocialMargin: social.Margin})
+	case eightValuesQuiz:
+		var values, margins [4]float64
+		for axis, name := range eightValuesAxisNames {
+			values[axis], margins[axis] = scores[name].Score, scores[name].Margin
+		}
+		return eightvalues.GenerateSVGWithOptions(values[eightvalues.Economic], values[eightvalues.Diplomatic],
+			values[eightvalues.Government], values[eightvalues.Society], eightvalues.SVGOptions{Margins: margins})
+	default:
+		values, margins := map[string]float64{}, map[string]float64{}
+		for name, s := range scores {
+			values[name], margins[name] = s.Score, s.Margin
+		}
+		language := result.Language
+		if !isSupportedLanguage(language) {
+			language = "en"
+		}
+		return politiscalesChart(values, politiscales.SVGOptions{Language: language, Margins: margins})
+	}
+}
+
+// markdownCell escapes text for a Markdown table cell
+func markdownCell(text string) string {
+	return strings.NewReplacer("|", "\\|", "\n", " ").Replace(text)
+}
+
+// resultMarkdown writes a report with the chart embedded as a data URI
+func resultMarkdown(result exportedResult) string {
+	status := "Complete"
+	if !result.Complete {
+		status = "In progress (scores are provisional)"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s Result\n\n", result.Title)
+	b.WriteString("| Field | Value |\n|---|---|\n")
+	fmt.Fprintf(&b, "| Status | %s |\n", status)
+	fmt.Fprintf(&b, "| Questions answered | %d/%d |\n", result.Answered, result.Total)
+	fmt.Fprintf(&b, "| Mode | %s |\n", result.Mode)
+	fmt.Fprintf(&b, "| Language | %s |\n", result.Language)
+	fmt.Fprintf(&b, "| Question bank | %s (%s) |\n", result.BankVersion, result.BankHash)
+	fmt.Fprintf(&b, "| Seed | %d |\n", result.Seed)
+	if result.ExportedAt != "" {
+		fmt.Fprintf(&b, "| Exported | %s |\n", result.ExportedAt)
+	}
+
+	for _, warning := range result.Warnings {
+		fmt.Fprintf(&b, "\n> ⚠️ %s\n", warning)
+	}
+
+	b.WriteString("\n## Scores\n\n| Axis | Score | 95% CI | Label |\n|---|---|---|---|\n")
+	for _, s := range result.Scores {
+		fmt.Fprintf(&b, "| %s | %.2f | ±%.2f | %s |\n", s.Axis, s.Score, s.Margin, markdownCell(s.Label))
+	}
+	if len(result.Labels) > 0 {
+		fmt.Fprintf(&b, "\n**Labels:** %s\n", markdownCell(strings.Join(result.Labels, ", ")))
+	}
+
+	fmt.Fprintf(&b, "\n## Chart\n\n![%s chart](data:image/svg+xml;base64,%s)\n",
+		result.Title, base64.StdEncoding.EncodeToString([]byte(resultChart(result))))
+
+	b.WriteString("\n## Answers\n\n| # | ID | Question | Answer | Value |\n|---|---|---|---|---|\n")
+	for i, a := range result.Answers {
+		fmt.Fprintf(&b, "| %d | %d | %s | %s | %+.2f |\n", i+1, a.QuestionID, markdownCell(a.Text), a.Answer, a.Value)
+	}
+	return b.String()
+}
+
+// handleExportResults exports the current result of a quiz, or a stored result
+// passed as JSON, in the requested format
+func handleExportResults(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	format := request.GetString("format", "json")
+	stored := strings.TrimSpace(request.GetString("result", ""))
+
+	var result exportedResult
+	var err error
+	if stored != "" {
+		result, err = parseStoredResult([]byte(stored))
+	} else {
+		quiz, quizErr := request.RequireString("quiz")
+		if quizErr != nil {
+			return mcp.NewToolResultError("quiz is required unless a stored result is given"), nil
+		}
+		mutex.Lock()
+		result, err = currentResult(quiz)
+		mutex.Unlock()
+	}
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	text, err := formatResult(result, format)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return mcp.NewToolResultText(text), nil
+}
+
+// runExportResults implements the export-results subcommand, which converts a
+// stored JSON result (a file, or stdin when none or "-" is given) to another format
+func runExportResults(args []string, stdin io.Reader, stdout io.Writer) error {
+	flags := flag.NewFlagSet("export-results", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	format := flags.String("format", "json", "Output format: "+strings.Join(exportFormats, ", "))
+	output := flags.String("o", "", "File to write instead of stdout")
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("usage: export-results [-format json|csv|markdown] [-o file] [result.json]: %v", err)
+	}
+
+	var data []byte
+	var err error
+	if path := flags.Arg(0); path != "" && path != "-" {
+		data, err = os.ReadFile(path)
+	} else {
+		data, err = io.ReadAll(stdin)
+	}
+	if err != nil {
+		return fmt.Errorf("reading stored result: %v", err)
+	}
+
+	result, err := parseStoredResult(data)
+	if err != nil {
+		return err
+	}
+	text, err := formatResult(result, *format)
+	if err != nil {
+		return err
+	}
+	if *output != "" {
+		return os.WriteFile(*output, []byte(text), 0o644)
+	}
+	_, err = io.WriteString(stdout, text)
+	return err
+}
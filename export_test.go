@@ -0,0 +1,210 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+func TestExportResultsJSON(t *testing.T) {
+	resetState()
+
+	handleEightValues(context.Background(), createRequestWithAnswer(""))
+	for i := 0; i < 10; i++ {
+		handleEightValues(context.Background(), createRequestWithValue(float64(i%5)/2-1))
+	}
+
+	content, isError := callTool(t, handleExportResults, "export_results", map[string]interface{}{"quiz": "eight_values"})
+	if isError {
+		t.Fatalf("unexpected error: %s", content)
+	}
+	var result exportedResult
+	if err := json.Unmarshal([]byte(content), &result); err != nil {
+		t.Fatalf("export is not valid JSON: %v", err)
+	}
+
+	if result.Quiz != "eight_values" || result.Title != "8values" || result.Mode != "full" {
+		t.Errorf("unexpected result header: %+v", result)
+	}
+	if result.Seed == 0 || result.Seed != eightValuesQuizState.Seed {
+		t.Errorf("expected the shuffle seed %d, got %d", eightValuesQuizState.Seed, result.Seed)
+	}
+	if result.BankVersion != "v1" || result.BankHash != questionBankStamp(eightValuesQuiz) || len(result.BankHash) != 12 {
+		t.Errorf("unexpected bank stamp %q %q", result.BankVersion, result.BankHash)
+	}
+	if result.Answered != 10 || result.Total != 70 || result.Complete {
+		t.Errorf("expected 10 of 70 answers in progress, got %d of %d (complete %v)", result.Answered, result.Total, result.Complete)
+	}
+
+	answers := map[int]float64{}
+	for i, answer := range result.Answers {
+		if answer.QuestionID != eightValuesShuffledQuestions[i] || answer.Value != eightValuesQuizState.Responses[i] {
+			t.Errorf("answer %d: got question %d = %f", i, answer.QuestionID, answer.Value)
+		}
+		answers[answer.QuestionID] = answer.Value
+	}
+	expected := scoreEightValues(answers)
+	for axis, score := range result.Scores {
+		if math.Abs(score.Score-expected[axis]) > 1e-9 || score.Label != eightValuesLabel(axis, expected[axis]) {
+			t.Errorf("%s: got %f %s, want %f", score.Axis, score.Score, score.Label, expected[axis])
+		}
+	}
+}
+
+func TestExportResultsCSV(t *testing.T) {
+	resetState()
+
+	handlePolitiscales(context.Background(), createRequestWithAnswer(""))
+	for i := 0; i < 5; i++ {
+		handlePolitiscales(context.Background(), createRequestWithAnswer("strongly_agree"))
+	}
+
+	content, isError := callTool(t, handleExportResults, "export_results", map[string]interface{}{"quiz": "politiscales", "format": "csv"})
+	if isError {
+		t.Fatalf("unexpected error: %s", content)
+	}
+	rows, err := csv.NewReader(strings.NewReader(content)).ReadAll()
+	if err != nil {
+		t.Fatalf("export is not valid CSV: %v", err)
+	}
+	if len(rows) != 1+5+len(politiscales.Axes) {
+		t.Fatalf("expected a header, 5 question rows and %d axis rows, got %d rows", len(politiscales.Axes), len(rows))
+	}
+	if rows[0][0] != "record" || rows[1][0] != "question" || rows[1][6] != "1" || rows[6][0] != "axis" || rows[6][7] != politiscales.Axes[0].Name {
+		t.Errorf("unexpected CSV layout: %v", rows[:7])
+	}
+}
+
+func TestExportResultsMarkdown(t *testing.T) {
+	resetState()
+
+	handlePoliticalCompass(context.Background(), createRequestWithAnswer(""))
+	for i := 0; i < len(politicalcompass.AllQuestions); i++ {
+		handlePoliticalCompass(context.Background(), createRequestWithAnswer("agree"))
+	}
+
+	content, isError := callTool(t, handleExportResults, "export_results", map[string]interface{}{"quiz": "political_compass", "format": "markdown"})
+	if isError {
+		t.Fatalf("unexpected error: %s", content)
+	}
+	economic, social := politicalCompassPosition(totalEconomicScore, totalSocialScore)
+	for _, expected := range []string{
+		"# Political Compass Result", "| Status | Complete |", "| Questions answered | 62/62 |",
+		"## Scores", "**Labels:** " + getQuadrant(economic, social), "## Answers", "| 62 |",
+	} {
+		if !strings.Contains(content, expected) {
+			t.Errorf("expected %q in Markdown report, got: %s", expected, content)
+		}
+	}
+
+	prefix := "data:image/svg+xml;base64,"
+	start := strings.Index(content, prefix)
+	if start < 0 {
+		t.Fatal("expected the chart to be embedded as a data URI")
+	}
+	encoded := content[start+len(prefix):]
+	encoded = encoded[:strings.Index(encoded, ")")]
+	svg, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || !strings.Contains(string(svg), "<svg") {
+		t.Errorf("expected an embedded SVG chart, got error %v", err)
+	}
+}
+
+func TestExportStoredResult(t *testing.T) {
+	resetState()
+
+	handleEightValues(context.Background(), createRequestWithAnswer(""))
+	for i := 0; i < 3; i++ {
+		handleEightValues(context.Background(), createRequestWithAnswer("agree"))
+	}
+	stored, _ := callTool(t, handleExportResults, "export_results", map[string]interface{}{"quiz": "eight_values"})
+	current, _ := callTool(t, handleExportResults, "export_results", map[string]interface{}{"quiz": "eight_values", "format": "csv"})
+
+	// A stored result exports the same way once the live quiz has moved on
+	resetState()
+	content, isError := callTool(t, handleExportResults, "export_results", map[string]interface{}{"result": stored, "format": "csv"})
+	if isError || content != current {
+		t.Errorf("stored result should export like the live one, got: %s", content)
+	}
+}
+
+func TestExportResultsErrors(t *testing.T) {
+	resetState()
+
+	tests := []struct {
+		name     string
+		args     map[string]interface{}
+		expected string
+	}{
+		{"missing quiz", map[string]interface{}{}, "quiz is required"},
+		{"invalid quiz", map[string]interface{}{"quiz": "sapply"}, "invalid quiz"},
+		{"no answers", map[string]interface{}{"quiz": "politiscales"}, "no answers to export yet"},
+		{"invalid stored result", map[string]interface{}{"result": "{"}, "invalid stored result"},
+		{"unknown stored quiz", map[string]interface{}{"result": `{"quiz": "sapply", "scores": [{"axis": "x"}]}`}, "unknown quiz"},
+		{"invalid format", map[string]interface{}{"result": `{"quiz": "political_compass", "scores": [{"axis": "Economic"}]}`, "format": "xml"}, "invalid format: xml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, isError := callTool(t, handleExportResults, "export_results", tt.args)
+			if !isError || !strings.Contains(content, tt.expected) {
+				t.Errorf("expected error containing %q, got: %s", tt.expected, content)
+			}
+		})
+	}
+}
+
+func TestRunExportResults(t *testing.T) {
+	stored := `{"quiz": "political_compass", "language": "en", "scores": [{"axis": "Economic", "score": -3.5}, {"axis": "Social", "score": 2}], "labels": ["Libertarian Left"]}`
+
+	var stdout bytes.Buffer
+	if err := runExportResults([]string{"-format", "csv"}, strings.NewReader(stored), &stdout); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(stdout.String(), "axis,political_compass,,,,,,Economic,-3.5,0,") {
+		t.Errorf("unexpected CSV from stdin: %s", stdout.String())
+	}
+
+	dir := t.TempDir()
+	input, output := filepath.Join(dir, "result.json"), filepath.Join(dir, "report.md")
+	if err := os.WriteFile(input, []byte(stored), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := runExportResults([]string{"-format", "markdown", "-o", output, input}, nil, &stdout); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	report, err := os.ReadFile(output)
+	if err != nil || !strings.Contains(string(report), "# Political Compass Result") {
+		t.Errorf("expected a Markdown report in %s, got %q (%v)", output, report, err)
+	}
+
+	if err := runExportResults([]string{filepath.Join(dir, "missing.json")}, nil, &stdout); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
+
+func TestQuestionBankStamp(t *testing.T) {
+	stamps := map[string]bool{}
+	for _, quiz := range []string{politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz} {
+		stamp := questionBankStamp(quiz)
+		if stamp != questionBankStamp(quiz) {
+			t.Errorf("%s: bank hash is not stable", quiz)
+		}
+		if questionBankVersion(quiz) < 1 {
+			t.Errorf("%s: expected an explicit bank version, got %d", quiz, questionBankVersion(quiz))
+		}
+		stamps[stamp] = true
+	}
+	if len(stamps) != 3 {
+		t.Errorf("expected a distinct hash per bank, got %v", stamps)
+	}
+}
@@ -2,10 +2,14 @@ module github.com/x86ed/MCP-PoliticalCompass/v3
 
 go 1.23.4
 
-require github.com/mark3labs/mcp-go v0.32.0
+require (
+	github.com/mark3labs/mcp-go v0.32.0
+	golang.org/x/image v0.25.0
+)
 
 require (
 	github.com/google/uuid v1.6.0 // indirect
 	github.com/spf13/cast v1.7.1 // indirect
 	github.com/yosida95/uritemplate/v3 v3.0.2 // indirect
+	golang.org/x/text v0.23.0 // indirect
 )
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/raster"
+)
+
+// chartImages is tool middleware that attaches a PNG rendering of every SVG chart
+// in a result's text, for clients that cannot render inline SVG markup
+func chartImages(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		result, err := next(ctx, request)
+		if err != nil || result == nil || result.IsError {
+			return result, err
+		}
+		return withChartImages(result), nil
+	}
+}
+
+// withChartImages appends image content for the SVG charts in a result's text,
+// leaving the text as it is for clients that do render SVG
+func withChartImages(result *mcp.CallToolResult) *mcp.CallToolResult {
+	var images []mcp.Content
+	for _, content := range result.Content {
+		text, ok := mcp.AsTextContent(content)
+		if !ok {
+			continue
+		}
+		for _, svg := range inlineSVGs(text.Text) {
+			data, err := raster.PNG(svg)
+			if errors.Is(err, raster.ErrMissingGlyphs) {
+				// The embedded fonts lack the chart's script, so its English copy is drawn instead
+				if english, ok := chartFallback(svg); ok {
+					data, err = raster.PNG(english)
+				}
+			}
+			if errors.Is(err, raster.ErrMissingGlyphs) {
+				// A chart with its words left out would mislead, so only the SVG is sent
+				fmt.Fprintf(os.Stderr, "Skipping chart image: %v\n", err)
+				continue
+			}
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error rendering chart: %v\n", err)
+				continue
+			}
+			images = append(images, mcp.NewImageContent(base64.StdEncoding.EncodeToString(data), "image/png"))
+		}
+	}
+	result.Content = append(result.Content, images...)
+	return result
+}
+
+// maxChartFallbacks bounds how many localised charts keep an English copy
+const maxChartFallbacks = 16
+
+// chartFallbacks maps localised charts to English-labelled copies, oldest first in
+// chartFallbackOrder, for charts whose script the embedded fonts lack
+var (
+	chartFallbackMutex sync.Mutex
+	chartFallbacks     = map[string]string{}
+	chartFallbackOrder []string
+)
+
+// localizedChart records the English-labelled copy of a localised chart for its
+// PNG and returns the chart
+func localizedChart(svg, english string) string {
+	if svg == english {
+		return svg
+	}
+	chartFallbackMutex.Lock()
+	defer chartFallbackMutex.Unlock()
+	if _, ok := chartFallbacks[svg]; !ok {
+		chartFallbackOrder = append(chartFallbackOrder, svg)
+		if len(chartFallbackOrder) > maxChartFallbacks {
+			delete(chartFallbacks, chartFallbackOrder[0])
+			chartFallbackOrder = chartFallbackOrder[1:]
+		}
+	}
+	chartFallbacks[svg] = english
+	return svg
+}
+
+// chartFallback returns the English-labelled copy recorded for a localised chart
+func chartFallback(svg string) (string, bool) {
+	chartFallbackMutex.Lock()
+	defer chartFallbackMutex.Unlock()
+	english, ok := chartFallbacks[svg]
+	return english, ok
+}
+
+// politiscalesChart draws the politiscales results chart in a language, with an
+// English copy for its PNG
+func politiscalesChart(results map[string]float64, opts politiscales.SVGOptions) string {
+	svg := politiscales.GeneratePolitiscalesResultsSVGWithOptions(results, opts)
+	opts.Language = "en"
+	return localizedChart(svg, politiscales.GeneratePolitiscalesResultsSVGWithOptions(results, opts))
+}
+
+// politiscalesBadgesChart draws the earned politiscales badges in a language, with
+// an English copy for its PNG
+func politiscalesBadgesChart(results map[string]float64, language string) string {
+	return localizedChart(politiscales.GenerateBadgesSVG(results, language), politiscales.GenerateBadgesSVG(results, "en"))
+}
+
+// inlineSVGs returns the SVG documents pasted into a text, in order
+func inlineSVGs(text string) []string {
+	var svgs []string
+	for {
+		start := strings.Index(text, "<svg")
+		if start < 0 {
+			return svgs
+		}
+		end := strings.Index(text[start:], "</svg>")
+		if end < 0 {
+			return svgs
+		}
+		end += start + len("</svg>")
+		svgs = append(svgs, text[start:end])
+		text = text[end:]
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+func TestInlineSVGs(t *testing.T) {
+	text := `Before <svg width="1" height="1"></svg> between <svg width="2" height="2"><rect/></svg> after <svg unclosed`
+	svgs := inlineSVGs(text)
+	if len(svgs) != 2 || svgs[0] != `<svg width="1" height="1"></svg>` || svgs[1] != `<svg width="2" height="2"><rect/></svg>` {
+		t.Errorf("unexpected SVGs: %q", svgs)
+	}
+	if svgs := inlineSVGs("no charts here"); len(svgs) != 0 {
+		t.Errorf("expected no SVGs, got %q", svgs)
+	}
+}
+
+func TestChartImages(t *testing.T) {
+	resetState()
+	eightValues := chartImages(handleEightValues)
+
+	response, _ := eightValues(context.Background(), createRequestWithAnswer(""))
+	if len(response.Content) != 1 {
+		t.Errorf("expected a question to have no image, got %d contents", len(response.Content))
+	}
+	for i := 0; i < 70; i++ {
+		response, _ = eightValues(context.Background(), createRequestWithAnswer("agree"))
+	}
+	if len(response.Content) != 2 {
+		t.Fatalf("expected the results text and a chart image, got %d contents", len(response.Content))
+	}
+	picture, ok := mcp.AsImageContent(response.Content[1])
+	if !ok || picture.Type != "image" || picture.MIMEType != "image/png" {
+		t.Fatalf("expected PNG image content, got %+v", response.Content[1])
+	}
+	data, err := base64.StdEncoding.DecodeString(picture.Data)
+	if err != nil {
+		t.Fatalf("invalid base64: %v", err)
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil || img.Bounds() != image.Rect(0, 0, 800, 650) {
+		t.Errorf("expected an 800x650 chart, got %v (%v)", img, err)
+	}
+
+	// Errors pass through untouched
+	failing := chartImages(func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultError(`bad chart <svg width="10" height="10"></svg>`), nil
+	})
+	if response, _ := failing(context.Background(), createMockRequest("", nil)); len(response.Content) != 1 {
+		t.Errorf("expected an error result to get no image, got %d contents", len(response.Content))
+	}
+}
+
+func TestChartImagesSkipBrokenSVG(t *testing.T) {
+	result := withChartImages(mcp.NewToolResultText(`<svg width="0" height="0"></svg> <svg width="4" height="4"></svg>`))
+	if len(result.Content) != 2 {
+		t.Errorf("expected only the valid chart rendered, got %d contents", len(result.Content))
+	}
+}
+
+func TestChartImagesSkipMissingScripts(t *testing.T) {
+	svg := politiscales.GeneratePolitiscalesResultsSVGWithOptions(map[string]float64{"constructivism": 50}, politiscales.SVGOptions{Language: "ar"})
+	result := withChartImages(mcp.NewToolResultText("Results\n" + svg))
+	if len(result.Content) != 1 {
+		t.Errorf("expected no image for a chart whose text cannot be drawn and that has no English copy, got %d contents", len(result.Content))
+	}
+}
+
+func TestChartImagesLocalizedCompletion(t *testing.T) {
+	defer restoreDefaultLanguages()
+	for _, language := range []string{"ar", "zh"} {
+		resetState()
+		handleSetLanguage(context.Background(), createRequestWithLanguage(language))
+		politiscalesTool := chartImages(handlePolitiscales)
+
+		response, _ := politiscalesTool(context.Background(), createRequestWithAnswer(""))
+		for i := 0; i < len(politiscales.Questions) && len(response.Content) == 1; i++ {
+			response, _ = politiscalesTool(context.Background(), createRequestWithAnswer("agree"))
+		}
+		if !strings.Contains(extractTextContent(response), "Politiscales Quiz Complete") {
+			t.Fatalf("%s: expected the completion message, got: %s", language, extractTextContent(response))
+		}
+		// The chart keeps its translated labels in the text, and its PNG is drawn from the English copy
+		if len(response.Content) != 2 || !strings.Contains(extractTextContent(response), `<svg`) {
+			t.Fatalf("%s: expected the results text and a chart image, got %d contents", language, len(response.Content))
+		}
+		if picture, ok := mcp.AsImageContent(response.Content[1]); !ok || picture.MIMEType != "image/png" {
+			t.Errorf("%s: expected PNG image content, got %+v", language, response.Content[1])
+		}
+	}
+}
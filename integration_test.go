@@ -147,7 +147,7 @@ func TestIntegrationEdgeCases(t *testing.T) {
 		}
 
 		// Try various invalid responses
-		invalidResponses := []string{"maybe", "sometimes", "invalid", "yes", "no", "1", "2"}
+		invalidResponses := []string{"maybe", "sometimes", "invalid", "yes", "no", "0", "6", "3"}
 		for _, invalid := range invalidResponses {
 			response, err := handlePoliticalCompass(context.Background(), createMockRequest("political_compass", map[string]interface{}{"answer": invalid}))
 			if err != nil {
@@ -164,7 +164,7 @@ func TestIntegrationEdgeCases(t *testing.T) {
 			t.Fatalf("Error starting 8values: %v", err)
 		}
 
-		invalidEightValuesResponses := []string{"maybe", "sometimes", "invalid", "yes", "no", "1", "2", "kinda"}
+		invalidEightValuesResponses := []string{"maybe", "sometimes", "invalid", "yes", "no", "0", "6", "kinda"}
 		for _, invalid := range invalidEightValuesResponses {
 			response, err := handleEightValues(context.Background(), createMockRequest("eight_values", map[string]interface{}{"answer": invalid}))
 			if err != nil {
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/eightvalues"
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+// supportedLanguages lists the language codes every quiz can be run in
+var supportedLanguages = []string{"en", "fr", "es", "it", "ar", "ru", "zh"}
+
+// Question translation tables for each quiz, keyed by language code
+var (
+	politicalCompassQuestionTables = map[string]map[string]string{
+		"en": politicalcompass.ENQuestions,
+		"fr": politicalcompass.FRQuestions,
+		"es": politicalcompass.ESQuestions,
+		"it": politicalcompass.ITQuestions,
+		"ar": politicalcompass.ARQuestions,
+		"ru": politicalcompass.RUQuestions,
+		"zh": politicalcompass.ZHQuestions,
+	}
+
+	eightValuesQuestionTables = map[string]map[string]string{
+		"en": eightvalues.ENQuestions,
+		"fr": eightvalues.FRQuestions,
+		"es": eightvalues.ESQuestions,
+		"it": eightvalues.ITQuestions,
+		"ar": eightvalues.ARQuestions,
+		"ru": eightvalues.RUQuestions,
+		"zh": eightvalues.ZHQuestions,
+	}
+
+	politiscalesQuestionTables = map[string]map[string]string{
+		"en": politiscales.ENQuestions,
+		"fr": politiscales.FRQuestions,
+		"es": politiscales.ESQuestions,
+		"it": politiscales.ITQuestions,
+		"ar": politiscales.ARQuestions,
+		"ru": politiscales.RUQuestions,
+		"zh": politiscales.ZHQuestions,
+	}
+
+	// UI copy tables (response labels and other short strings) for each quiz
+	politicalCompassCopyTables = map[string]map[string]string{
+		"en": politicalcompass.ENCopy,
+		"fr": politicalcompass.FRCopy,
+		"es": politicalcompass.ESCopy,
+		"it": politicalcompass.ITCopy,
+		"ar": politicalcompass.ARCopy,
+		"ru": politicalcompass.RUCopy,
+		"zh": politicalcompass.ZHCopy,
+	}
+
+	eightValuesCopyTables = map[string]map[string]string{
+		"en": eightvalues.ENCopy,
+		"fr": eightvalues.FRCopy,
+		"es": eightvalues.ESCopy,
+		"it": eightvalues.ITCopy,
+		"ar": eightvalues.ARCopy,
+		"ru": eightvalues.RUCopy,
+		"zh": eightvalues.ZHCopy,
+	}
+
+	politiscalesCopyTables = map[string]map[string]string{
+		"en": politiscales.ENCopy,
+		"fr": politiscales.FRCopy,
+		"es": politiscales.ESCopy,
+		"it": politiscales.ITCopy,
+		"ar": politiscales.ARCopy,
+		"ru": politiscales.RUCopy,
+		"zh": politiscales.ZHCopy,
+	}
+)
+
+// translationTable names one set of translatable strings. Translation packs and
+// the coverage report address tables by quiz and section, e.g. "politiscales/questions".
+type translationTable struct {
+	quiz    string
+	section string
+	tables  map[string]map[string]string
+}
+
+// translationTables lists every table a translation pack can provide
+var translationTables = []translationTable{
+	{"political_compass", "questions", politicalCompassQuestionTables},
+	{"political_compass", "copy", politicalCompassCopyTables},
+	{"eight_values", "questions", eightValuesQuestionTables},
+	{"eight_values", "copy", eightValuesCopyTables},
+	{"politiscales", "questions", politiscalesQuestionTables},
+	{"politiscales", "copy", politiscalesCopyTables},
+	{"politiscales", "results", politiscales.ResultsCopy},
+}
+
+// name returns the "quiz/section" identifier used in packs and reports
+func (t translationTable) name() string {
+	return t.quiz + "/" + t.section
+}
+
+// isSupportedLanguage reports whether the language code is one of supportedLanguages
+func isSupportedLanguage(language string) bool {
+	for _, lang := range supportedLanguages {
+		if language == lang {
+			return true
+		}
+	}
+	return false
+}
+
+// translate looks up a key in the table for the given language, falling back
+// to English and finally to the key itself
+func translate(tables map[string]map[string]string, language, key string) string {
+	if text, ok := tables[language][key]; ok {
+		return text
+	}
+	if text, ok := tables["en"][key]; ok {
+		return text
+	}
+	return key
+}
+
+// Get political compass question text in the current language
+func getPoliticalCompassQuestionText(key string) string {
+	return translate(politicalCompassQuestionTables, politicalCompassLanguage, key)
+}
+
+// Get 8values question text in the current language
+func getEightValuesQuestionText(key string) string {
+	return translate(eightValuesQuestionTables, eightValuesLanguage, key)
+}
+
+// Handler function for setting the language of every quiz
+func handleSetLanguage(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Extract the language argument
+	language, err := request.RequireString("language")
+	if err != nil {
+		return mcp.NewToolResultError("Language is required"), nil
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if !isSupportedLanguage(language) {
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid language: %s. Valid languages are: %s",
+			language, strings.Join(supportedLanguages, ", "))), nil
+	}
+
+	// A quiz in progress keeps the language it was started in
+	quizzes := []struct {
+		name       string
+		inProgress bool
+		current    *string
+	}{
+		{"Political Compass", len(quizState.Responses) > 0, &politicalCompassLanguage},
+		{"8values", len(eightValuesQuizState.Responses) > 0, &eightValuesLanguage},
+		{"Politiscales", len(politiscalesQuizState.Responses) > 0, &politiscalesLanguage},
+	}
+
+	message := fmt.Sprintf("🌐 Language set to %s\n\n", language)
+	for _, quiz := range quizzes {
+		if quiz.inProgress && *quiz.current != language {
+			message += fmt.Sprintf("- %s: kept %s (quiz in progress, reset it to change language)\n", quiz.name, *quiz.current)
+			continue
+		}
+		message += fmt.Sprintf("- %s: %s → %s\n", quiz.name, *quiz.current, language)
+		*quiz.current = language
+	}
+
+	return mcp.NewToolResultText(message), nil
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/x86ed/MCP-PoliticalCompass/v3/eightvalues"
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+// restoreDefaultLanguages puts every quiz back to English after a test
+func restoreDefaultLanguages() {
+	politicalCompassLanguage = "en"
+	eightValuesLanguage = "en"
+	politiscalesLanguage = "en"
+}
+
+func TestSetLanguageAppliesToAllQuizzes(t *testing.T) {
+	resetState()
+	defer restoreDefaultLanguages()
+
+	for _, lang := range supportedLanguages {
+		t.Run(lang, func(t *testing.T) {
+			response, err := handleSetLanguage(context.Background(), createRequestWithLanguage(lang))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if isErrorResult(response) {
+				t.Fatalf("unexpected error result: %s", extractTextContent(response))
+			}
+
+			if politicalCompassLanguage != lang || eightValuesLanguage != lang || politiscalesLanguage != lang {
+				t.Errorf("expected all quizzes in %s, got compass=%s 8values=%s politiscales=%s",
+					lang, politicalCompassLanguage, eightValuesLanguage, politiscalesLanguage)
+			}
+		})
+	}
+}
+
+func TestSetLanguageInvalid(t *testing.T) {
+	resetState()
+	defer restoreDefaultLanguages()
+
+	response, err := handleSetLanguage(context.Background(), createRequestWithLanguage("xx"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !isErrorResult(response) {
+		t.Error("expected error result for invalid language")
+	}
+	if !strings.Contains(extractTextContent(response), "Invalid language: xx") {
+		t.Errorf("unexpected error message: %s", extractTextContent(response))
+	}
+
+	response, _ = handleSetLanguage(context.Background(), createEmptyRequest())
+	if !isErrorResult(response) {
+		t.Error("expected error result for missing language")
+	}
+}
+
+func TestSetLanguageKeepsQuizInProgress(t *testing.T) {
+	resetState()
+	defer restoreDefaultLanguages()
+
+	// Start and answer one 8values question
+	handleEightValues(context.Background(), createRequestWithAnswer(""))
+	handleEightValues(context.Background(), createRequestWithAnswer("agree"))
+
+	response, _ := handleSetLanguage(context.Background(), createRequestWithLanguage("fr"))
+	content := extractTextContent(response)
+
+	if eightValuesLanguage != "en" {
+		t.Errorf("8values language should not change mid-quiz, got %s", eightValuesLanguage)
+	}
+	if politicalCompassLanguage != "fr" || politiscalesLanguage != "fr" {
+		t.Error("quizzes that have not started should switch language")
+	}
+	if !strings.Contains(content, "8values: kept en") {
+		t.Errorf("expected in-progress note, got: %s", content)
+	}
+}
+
+func TestTranslatedQuestionText(t *testing.T) {
+	resetState()
+	defer restoreDefaultLanguages()
+
+	handleSetLanguage(context.Background(), createRequestWithLanguage("es"))
+
+	response, _ := handlePoliticalCompass(context.Background(), createRequestWithAnswer(""))
+	question := politicalcompass.AllQuestions[shuffledQuestions[0]]
+	if !strings.Contains(extractTextContent(response), politicalcompass.ESQuestions[question.Text]) {
+		t.Error("political compass question should be shown in Spanish")
+	}
+
+	response, _ = handleEightValues(context.Background(), createRequestWithAnswer(""))
+	evQuestion := eightvalues.Questions[eightValuesShuffledQuestions[0]]
+	if !strings.Contains(extractTextContent(response), eightvalues.ESQuestions[evQuestion.Text]) {
+		t.Error("8values question should be shown in Spanish")
+	}
+
+	response, _ = handlePolitiscales(context.Background(), createRequestWithAnswer(""))
+	psQuestion := politiscales.Questions[politiscalesShuffledQuestions[0]]
+	if !strings.Contains(extractTextContent(response), politiscales.ESQuestions[psQuestion.Text]) {
+		t.Error("politiscales question should be shown in Spanish")
+	}
+}
+
+func TestTranslateFallbacks(t *testing.T) {
+	if got := translate(politicalCompassQuestionTables, "xx", "astrology"); got != politicalcompass.ENQuestions["astrology"] {
+		t.Errorf("unknown language should fall back to English, got %s", got)
+	}
+	if got := translate(eightValuesQuestionTables, "fr", "missing_key"); got != "missing_key" {
+		t.Errorf("unknown key should fall back to the key, got %s", got)
+	}
+}
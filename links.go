@@ -0,0 +1,293 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+// Results pages of the original quiz websites
+const (
+	politicalCompassResultsURL = "https://www.politicalcompass.org/analysis2"
+	eightValuesResultsURL      = "https://8values.github.io/results.html"
+	politiscalesResultsURL     = "https://politiscales.party/results"
+)
+
+// eightValuesLinkKeys are the 8values results query keys, in Effect order
+var eightValuesLinkKeys = [4]string{"e", "d", "g", "s"}
+
+// politiscalesLinkKeys maps politiscales axes to their results query keys: a
+// letter per pair with 0 for the first side and 1 for the second, and a short
+// name per badge axis
+var politiscalesLinkKeys = map[string]string{
+	"constructivism": "c0", "essentialism": "c1",
+	"rehabilitative_justice": "j0", "punitive_justice": "j1",
+	"progressive": "s0", "conservative": "s1",
+	"internationalism": "b0", "nationalism": "b1",
+	"communism": "p0", "capitalism": "p1",
+	"regulation": "m0", "laissez_faire": "m1",
+	"ecology": "e0", "production": "e1",
+	"revolution": "t0", "reform": "t1",
+	"anarchism": "anar", "pragmatism": "prag", "feminism": "femi", "complotism": "comp",
+	"veganism": "vega", "monarchism": "mona", "religion": "reli",
+}
+
+// linkQuery extracts the query parameters from a results link. Bare query strings
+// and single-page-app links that carry the query after a "#" are accepted too.
+func linkQuery(link string) (url.Values, error) {
+	link = strings.TrimSpace(link)
+	if link == "" {
+		return nil, fmt.Errorf("url is required")
+	}
+	raw := link
+	if u, err := url.Parse(link); err == nil && (u.Scheme != "" || strings.HasPrefix(link, "/")) {
+		raw = u.RawQuery
+		if raw == "" {
+			if i := strings.Index(u.Fragment, "?"); i >= 0 {
+				raw = u.Fragment[i+1:]
+			}
+		}
+	} else if i := strings.Index(link, "?"); i >= 0 {
+		raw = link[i+1:]
+	}
+	query, err := url.ParseQuery(raw)
+	if err != nil || len(query) == 0 {
+		return nil, fmt.Errorf("invalid results link: no query parameters found")
+	}
+	return query, nil
+}
+
+// detectLinkQuiz works out which quiz a results query belongs to
+func detectLinkQuiz(query url.Values) (string, error) {
+	if query.Has("ec") && query.Has("soc") {
+		return politicalCompassQuiz, nil
+	}
+	eightValues := true
+	for _, key := range eightValuesLinkKeys {
+		eightValues = eightValues && query.Has(key)
+	}
+	if eightValues {
+		return eightValuesQuiz, nil
+	}
+	for axis, key := range politiscalesLinkKeys {
+		if query.Has(key) || query.Has(axis) {
+			return politiscalesQuiz, nil
+		}
+	}
+	return "", fmt.Errorf("invalid results link: expected 8values (e, d, g, s), Political Compass (ec, soc) or Politiscales (c0, c1, ...) parameters")
+}
+
+// linkNumber reads a numeric query parameter within [min, max]
+func linkNumber(query url.Values, key string, min, max float64) (float64, error) {
+	value, err := strconv.ParseFloat(strings.TrimSpace(query.Get(key)), 64)
+	if err != nil || math.IsNaN(value) || value < min || value > max {
+		return 0, fmt.Errorf("invalid results link: %s must be a number between %g and %g", key, min, max)
+	}
+	return value, nil
+}
+
+// politiscalesResultLabels names the leading side of each pair, followed by the badges earned
+func politiscalesResultLabels(scores map[string]float64) []string {
+	var labels []string
+	leaning := politiscalesLeaning(scores)
+	for _, pair := range politiscalesPairs() {
+		labels = append(labels, pair+": "+leaning[pair])
+	}
+	return append(labels, politiscalesBadges(scores)...)
+}
+
+// parseResultsLink turns a results link from an original quiz website into a
+// result with scores and labels but no answers. quiz may be "" to detect it.
+func parseResultsLink(link, quiz string) (exportedResult, error) {
+	query, err := linkQuery(link)
+	if err != nil {
+		return exportedResult{}, err
+	}
+	if quiz == "" {
+		if quiz, err = detectLinkQuiz(query); err != nil {
+			return exportedResult{}, err
+		}
+	}
+	result := exportedResult{Quiz: quiz, Title: quizTitles[quiz], Mode: "imported link", Complete: true}
+
+	switch quiz {
+	case politicalCompassQuiz:
+		// Our compass scores use the pc.js scale, which is the scale of ec and soc
+		result.Language = politicalCompassLanguage
+		economic, err := linkNumber(query, "ec", -10, 10)
+		if err != nil {
+			return result, err
+		}
+		social, err := linkNumber(query, "soc", -10, 10)
+		if err != nil {
+			return result, err
+		}
+		result.Scores = []exportedScore{{Axis: "Economic", Score: economic}, {Axis: "Social", Score: social}}
+		result.Labels = []string{getQuadrant(economic, social)}
+
+	case eightValuesQuiz:
+		result.Language = eightValuesLanguage
+		for axis, key := range eightValuesLinkKeys {
+			score, err := linkNumber(query, key, 0, 100)
+			if err != nil {
+				return result, err
+			}
+			result.Scores = append(result.Scores, exportedScore{Axis: eightValuesAxisNames[axis], Score: score, Label: eightValuesLabel(axis, score)})
+		}
+
+	case politiscalesQuiz:
+		result.Language = politiscalesLanguage
+		scores := map[string]float64{}
+		fractions, fractional := true, false
+		for _, axis := range politiscales.Axes {
+			key := politiscalesLinkKeys[axis.Name]
+			if !query.Has(key) {
+				key = axis.Name
+			}
+			if !query.Has(key) {
+				continue
+			}
+			score, err := linkNumber(query, key, 0, 100)
+			if err != nil {
+				return result, err
+			}
+			scores[axis.Name] = score
+			fractions = fractions && score <= 1
+			fractional = fractional || score != math.Trunc(score)
+		}
+		if len(scores) == 0 {
+			return result, fmt.Errorf("invalid results link: no Politiscales axes found")
+		}
+		// Some links give shares from 0 to 1 rather than percentages; whole
+		// numbers are always read as percentages
+		if fractions && fractional {
+			for axis := range scores {
+				scores[axis] *= 100
+			}
+		}
+		for _, axis := range politiscales.Axes {
+			result.Scores = append(result.Scores, exportedScore{Axis: axis.Name, Score: scores[axis.Name]})
+		}
+		result.Labels = politiscalesResultLabels(scores)
+
+	default:
+		return result, fmt.Errorf("invalid quiz: %s. Please use one of: %s, %s, %s",
+			quiz, politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz)
+	}
+	return result, nil
+}
+
+// resultsLink builds the original website's results link for a result
+func resultsLink(result exportedResult) string {
+	scores := map[string]float64{}
+	for _, score := range result.Scores {
+		scores[score.Axis] = score.Score
+	}
+	switch result.Quiz {
+	case politicalCompassQuiz:
+		return fmt.Sprintf("%s?ec=%.2f&soc=%.2f", politicalCompassResultsURL, scores["Economic"], scores["Social"])
+	case eightValuesQuiz:
+		params := make([]string, len(eightValuesLinkKeys))
+		for axis, key := range eightValuesLinkKeys {
+			params[axis] = fmt.Sprintf("%s=%.1f", key, scores[eightValuesAxisNames[axis]])
+		}
+		return eightValuesResultsURL + "?" + strings.Join(params, "&")
+	default:
+		var params []string
+		for _, axis := range politiscales.Axes {
+			params = append(params, fmt.Sprintf("%s=%d", politiscalesLinkKeys[axis.Name], int(math.Round(scores[axis.Name]))))
+		}
+		return politiscalesResultsURL + "?" + strings.Join(params, "&")
+	}
+}
+
+// formatResultScores lists a result's scores and overall labels
+func formatResultScores(result exportedResult) string {
+	text := "**Scores:**\n"
+	for _, score := range result.Scores {
+		text += fmt.Sprintf("- %s: %.2f", score.Axis, score.Score)
+		if score.Label != "" {
+			text += " " + score.Label
+		}
+		text += "\n"
+	}
+	if len(result.Labels) > 0 {
+		text += fmt.Sprintf("- Labels: %s\n", strings.Join(result.Labels, ", "))
+	}
+	return text
+}
+
+// handleImportResultsLink renders a result from an original quiz website's results link
+func handleImportResultsLink(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	link, err := request.RequireString("url")
+	if err != nil {
+		return mcp.NewToolResultError("url is required"), nil
+	}
+	quiz := request.GetString("quiz", "")
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	result, err := parseResultsLink(link, quiz)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	stored, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+
+	unknown := []string{}
+	query, _ := linkQuery(link)
+	known := map[string]bool{"ec": true, "soc": true}
+	for _, key := range eightValuesLinkKeys {
+		known[key] = true
+	}
+	for axis, key := range politiscalesLinkKeys {
+		known[axis], known[key] = true, true
+	}
+	for key := range query {
+		if !known[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+
+	text := fmt.Sprintf("🌐 **%s Result Imported from Link**\n\n", result.Title) + formatResultScores(result)
+	if len(unknown) > 0 {
+		text += fmt.Sprintf("- Ignored parameters: %s\n", strings.Join(unknown, ", "))
+	}
+	text += "\n" + resultChart(result) + "\n\n" +
+		"The link only carries scores, so the quiz state is unchanged and there are no answers to explain. " +
+		"To export this result, pass the stored result below to `export_results`:\n\n" + string(stored)
+	return mcp.NewToolResultText(text), nil
+}
+
+// handleResultsLink builds the original website's results link for a quiz's current result
+func handleResultsLink(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	quiz, err := request.RequireString("quiz")
+	if err != nil {
+		return mcp.NewToolResultError("quiz is required"), nil
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	result, err := currentResult(quiz)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	text := fmt.Sprintf("🔗 **%s Results Link**\n\n%s\n", result.Title, resultsLink(result))
+	if !result.Complete {
+		text += "\n*The quiz is still in progress, so the link shows provisional scores.*\n"
+	}
+	return mcp.NewToolResultText(text), nil
+}
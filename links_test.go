@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"math"
+	"regexp"
+	"strings"
+	"testing"
+
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+func resultScores(result exportedResult) map[string]float64 {
+	scores := map[string]float64{}
+	for _, score := range result.Scores {
+		scores[score.Axis] = score.Score
+	}
+	return scores
+}
+
+func TestParseResultsLink(t *testing.T) {
+	tests := []struct {
+		name     string
+		link     string
+		quiz     string
+		expected map[string]float64
+	}{
+		{"8values", "https://8values.github.io/results.html?e=63.4&d=55.2&g=47.1&s=71.9", eightValuesQuiz,
+			map[string]float64{"Economic": 63.4, "Diplomatic": 55.2, "Government": 47.1, "Society": 71.9}},
+		{"political compass", "https://www.politicalcompass.org/analysis2?ec=-3.5&soc=-4.21", politicalCompassQuiz,
+			map[string]float64{"Economic": -3.5, "Social": -4.21}},
+		{"politiscales keys", "https://politiscales.party/results?c0=45&c1=20&mona=60", politiscalesQuiz,
+			map[string]float64{"constructivism": 45, "essentialism": 20, "monarchism": 60, "nationalism": 0}},
+		{"politiscales axis names as fractions", "https://example.org/#/results?constructivism=0.45&essentialism=0.2", politiscalesQuiz,
+			map[string]float64{"constructivism": 45, "essentialism": 20}},
+		{"bare query", "ec=1&soc=2", politicalCompassQuiz, map[string]float64{"Economic": 1, "Social": 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := parseResultsLink(tt.link, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Quiz != tt.quiz {
+				t.Errorf("expected quiz %s, got %s", tt.quiz, result.Quiz)
+			}
+			scores := resultScores(result)
+			for axis, expected := range tt.expected {
+				if math.Abs(scores[axis]-expected) > 1e-9 {
+					t.Errorf("%s: expected %f, got %f", axis, expected, scores[axis])
+				}
+			}
+		})
+	}
+}
+
+func TestParseResultsLinkLabels(t *testing.T) {
+	result, err := parseResultsLink("https://8values.github.io/results.html?e=95&d=50&g=20&s=5", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	labels := []string{}
+	for _, score := range result.Scores {
+		labels = append(labels, score.Label)
+	}
+	if strings.Join(labels, ",") != "Communist,Balanced,Authoritarian,Reactionary" {
+		t.Errorf("unexpected labels %v", labels)
+	}
+
+	result, _ = parseResultsLink("?c0=60&c1=10&mona=55&anar=80", "")
+	joined := strings.Join(result.Labels, ", ")
+	if !strings.Contains(joined, "identity: constructivism") || !strings.Contains(joined, "Monarchist") || strings.Contains(joined, "Anarchist") {
+		t.Errorf("unexpected politiscales labels %v", result.Labels)
+	}
+}
+
+func TestParseResultsLinkErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		link     string
+		quiz     string
+		expected string
+	}{
+		{"empty", "", "", "url is required"},
+		{"no query", "https://8values.github.io/results.html", "", "no query parameters"},
+		{"unknown parameters", "https://example.org/?x=1", "", "expected 8values"},
+		{"incomplete 8values", "https://8values.github.io/results.html?e=50&d=50", "", "expected 8values"},
+		{"out of range", "https://8values.github.io/results.html?e=150&d=50&g=50&s=50", "", "e must be a number between 0 and 100"},
+		{"not a number", "https://www.politicalcompass.org/analysis2?ec=left&soc=1", "", "ec must be a number"},
+		{"forced quiz", "https://www.politicalcompass.org/analysis2?ec=1&soc=1", eightValuesQuiz, "e must be a number"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := parseResultsLink(tt.link, tt.quiz); err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("expected error containing %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestImportResultsLink(t *testing.T) {
+	resetState()
+
+	response, err := handleImportResultsLink(context.Background(), createMockRequest("import_results_link", map[string]interface{}{
+		"url": "https://www.politicalcompass.org/analysis2?ec=-3.5&soc=-4.21&utm_source=x",
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	content := extractTextContent(response)
+	for _, expected := range []string{"Political Compass Result Imported from Link", "- Economic: -3.50", "Ignored parameters: utm_source", "<svg", `"mode":"imported link"`} {
+		if !strings.Contains(content, expected) {
+			t.Errorf("expected %q in import output, got: %s", expected, content)
+		}
+	}
+	if len(quizState.Responses) != 0 {
+		t.Error("importing a link should not change the quiz state")
+	}
+
+	// The stored result can be exported like any other
+	stored := content[strings.Index(content, "{"):]
+	if result, err := parseStoredResult([]byte(stored)); err != nil || result.Quiz != politicalCompassQuiz {
+		t.Errorf("expected a stored result to export, got %v", err)
+	}
+}
+
+func TestResultsLinkRoundTrip(t *testing.T) {
+	resetState()
+
+	handlePoliticalCompass(context.Background(), createRequestWithAnswer(""))
+	for i := 0; i < len(politicalcompass.AllQuestions); i++ {
+		handlePoliticalCompass(context.Background(), createRequestWithAnswer("agree"))
+	}
+	handleEightValues(context.Background(), createRequestWithAnswer(""))
+	for i := 0; i < 12; i++ {
+		handleEightValues(context.Background(), createRequestWithValue(float64(i%5)/2-1))
+	}
+	handlePolitiscales(context.Background(), createRequestWithAnswer(""))
+	for i := 0; i < len(politiscales.Questions); i++ {
+		handlePolitiscales(context.Background(), createRequestWithAnswer([]string{"agree", "strongly_disagree", "neutral"}[i%3]))
+	}
+
+	// Links round each score to a step per quiz, so scores come back within half a
+	// step, give or take floating-point error
+	for quiz, step := range map[string]float64{politicalCompassQuiz: 0.01, eightValuesQuiz: 0.1, politiscalesQuiz: 1} {
+		tolerance := step/2 + 1e-9
+		response, _ := handleResultsLink(context.Background(), createMockRequest("results_link", map[string]interface{}{"quiz": quiz}))
+		content := extractTextContent(response)
+		link := regexp.MustCompile(`https://\S+`).FindString(content)
+		if quiz == eightValuesQuiz && !strings.Contains(content, "provisional") {
+			t.Errorf("expected an unfinished 8values link to be marked provisional, got: %s", content)
+		}
+
+		parsed, err := parseResultsLink(link, "")
+		if err != nil || parsed.Quiz != quiz {
+			t.Fatalf("%s: link %s did not parse back (%v)", quiz, link, err)
+		}
+		current, _ := currentResult(quiz)
+		expected, got := resultScores(current), resultScores(parsed)
+		for axis, score := range expected {
+			if math.Abs(score-got[axis]) > tolerance {
+				t.Errorf("%s %s: link gives %f, result is %f", quiz, axis, got[axis], score)
+			}
+		}
+	}
+}
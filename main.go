@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -12,6 +13,28 @@ import (
 // Version is set during build time via ldflags
 var Version = "dev"
 
+// answerDescription documents the wordings accepted by the answer normaliser
+const answerDescription = "The user's response to the question: strongly_agree, agree, neutral, disagree or strongly_disagree. " +
+	"Translated labels (e.g. \"pas d'accord\"), short codes (SA/A/N/D/SD) and the 1-5 scale (1 = strongly disagree) are also accepted. " +
+	"Required unless value is given"
+
+// politicalCompassAnswerDescription documents the four answers the political compass accepts
+const politicalCompassAnswerDescription = "The user's response to the question: strongly_agree, agree, disagree or strongly_disagree; there is no neutral answer. " +
+	"Translated labels (e.g. \"pas d'accord\"), short codes (SD/D/A/SA) and 1, 2, 4 or 5 on the 1-5 scale (1 = strongly disagree) are also accepted. " +
+	"Required unless value is given"
+
+// valueDescription documents the optional slider answer
+const valueDescription = "Optional slider answer from -1 (strongly disagree) to 1 (strongly agree) for finer-grained responses; takes precedence over answer"
+
+// prefillDescription documents carrying answers over from the other quizzes
+const prefillDescription = "When starting a quiz, pre-fill the questions that match answers already given in the other quizzes and skip them"
+
+// reviewDescription documents the review step before scoring
+const reviewDescription = "When starting a quiz, list every question with its answer after the last one so answers can be amended with amend_answer; scores are only shown once finalize is called"
+
+// topicDescription documents topic practice runs
+const topicDescription = "When starting a quiz, make it a practice run that asks only the questions on one topic, such as economy, immigration or criminal_justice"
+
 // setupServer creates and configures an MCP server with all tools registered
 func setupServer() *server.MCPServer {
 	// Create a new server
@@ -19,12 +42,20 @@ func setupServer() *server.MCPServer {
 		"Political Compass MCP Server",
 		Version,
 		server.WithToolCapabilities(true),
+		server.WithToolHandlerMiddleware(chartImages),
 	)
 
 	// Register political compass question tool
 	politicalCompassTool := mcp.NewTool("political_compass",
 		mcp.WithDescription("Presents a political compass question and accepts a response"),
-		mcp.WithString("answer", mcp.Required(), mcp.Enum("strongly_agree", "agree", "neutral", "disagree", "strongly_disagree"), mcp.Description("The user's response to the question")),
+		mcp.WithString("answer", mcp.Description(politicalCompassAnswerDescription)),
+		mcp.WithNumber("value", mcp.Min(-1), mcp.Max(1), mcp.Description(valueDescription)),
+		mcp.WithBoolean("prefill", mcp.Description(prefillDescription)),
+		mcp.WithBoolean("review", mcp.Description(reviewDescription)),
+		mcp.WithString("topic", mcp.Description(topicDescription)),
+		mcp.WithBoolean("pages", mcp.Description("When starting a quiz, present the propositions a page at a time in the official six-page order instead of shuffled one by one")),
+		mcp.WithArray("answers", mcp.Description("In page mode, the answers to the current page's propositions in order, each an answer such as agree or a value from -1 to 1"),
+			mcp.Items(map[string]any{"type": []string{"string", "number"}})),
 	)
 	s.AddTool(politicalCompassTool, handlePoliticalCompass)
 
@@ -43,7 +74,14 @@ func setupServer() *server.MCPServer {
 	// Register 8values quiz tool
 	eightValuesTool := mcp.NewTool("eight_values",
 		mcp.WithDescription("Presents an 8values political question and accepts a response"),
-		mcp.WithString("answer", mcp.Required(), mcp.Enum("strongly_agree", "agree", "neutral", "disagree", "strongly_disagree"), mcp.Description("The user's response to the question")),
+		mcp.WithString("answer", mcp.Description(answerDescription)),
+		mcp.WithNumber("value", mcp.Min(-1), mcp.Max(1), mcp.Description(valueDescription)),
+		mcp.WithBoolean("adaptive", mcp.Description("When starting a quiz, ask the most informative questions first and stop once every axis is stable")),
+		mcp.WithNumber("margin", mcp.Description("Adaptive stopping margin in percentage points (default 10)")),
+		mcp.WithNumber("length", mcp.Description("When starting a quiz, ask a fixed short form with this many questions, balanced per axis (e.g. 20)")),
+		mcp.WithBoolean("prefill", mcp.Description(prefillDescription)),
+		mcp.WithBoolean("review", mcp.Description(reviewDescription)),
+		mcp.WithString("topic", mcp.Description(topicDescription)),
 	)
 	s.AddTool(eightValuesTool, handleEightValues)
 
@@ -62,7 +100,14 @@ func setupServer() *server.MCPServer {
 	// Register politiscales quiz tool
 	politiscalesTool := mcp.NewTool("politiscales",
 		mcp.WithDescription("Presents a politiscales political question and accepts a response"),
-		mcp.WithString("answer", mcp.Required(), mcp.Enum("strongly_agree", "agree", "neutral", "disagree", "strongly_disagree"), mcp.Description("The user's response to the question")),
+		mcp.WithString("answer", mcp.Description(answerDescription)),
+		mcp.WithNumber("value", mcp.Min(-1), mcp.Max(1), mcp.Description(valueDescription)),
+		mcp.WithBoolean("adaptive", mcp.Description("When starting a quiz, ask the most informative questions first and stop once every axis is stable")),
+		mcp.WithNumber("margin", mcp.Description("Adaptive stopping margin in percentage points (default 20)")),
+		mcp.WithNumber("length", mcp.Description("When starting a quiz, ask a fixed short form with this many questions, balanced per axis (e.g. 40)")),
+		mcp.WithBoolean("prefill", mcp.Description(prefillDescription)),
+		mcp.WithBoolean("review", mcp.Description(reviewDescription)),
+		mcp.WithString("topic", mcp.Description(topicDescription)),
 	)
 	s.AddTool(politiscalesTool, handlePolitiscales)
 
@@ -78,18 +123,151 @@ func setupServer() *server.MCPServer {
 	)
 	s.AddTool(politiscalesStatusTool, handlePolitiscalesStatus)
 
+	// Register politiscales flag tool
+	politiscalesFlagTool := mcp.NewTool("politiscales_flag",
+		mcp.WithDescription("Renders a flag composed from the dominant politiscales axis colours, with the badges earned for unpaired axes"),
+	)
+	s.AddTool(politiscalesFlagTool, handlePolitiscalesFlag)
+
+	// Register amend answer tool
+	amendAnswerTool := mcp.NewTool("amend_answer",
+		mcp.WithDescription("Changes one answer while a quiz started with review is waiting to be finalized"),
+		mcp.WithString("quiz", mcp.Required(), mcp.Enum(politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz), mcp.Description("The quiz being reviewed")),
+		mcp.WithNumber("question", mcp.Required(), mcp.Min(1), mcp.Description("The number of the answer in the review list")),
+		mcp.WithString("answer", mcp.Description(answerDescription+". The political compass has no neutral answer, so neutral, N and 3 are rejected for it")),
+		mcp.WithNumber("value", mcp.Min(-1), mcp.Max(1), mcp.Description(valueDescription)),
+	)
+	s.AddTool(amendAnswerTool, handleAmendAnswer)
+
+	// Register finalize tool
+	finalizeTool := mcp.NewTool("finalize",
+		mcp.WithDescription("Ends the review step of a quiz started with review, scoring the answers and showing the results"),
+		mcp.WithString("quiz", mcp.Required(), mcp.Enum(politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz), mcp.Description("The quiz to finalize")),
+	)
+	s.AddTool(finalizeTool, handleFinalize)
+
+	// Register topic breakdown tool
+	topicBreakdownTool := mcp.NewTool("topic_breakdown",
+		mcp.WithDescription("Shows a quiz's leaning topic by topic, scored on the answered questions tagged with each topic"),
+		mcp.WithString("quiz", mcp.Required(), mcp.Enum(politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz), mcp.Description("The quiz to break down")),
+		mcp.WithString("topic", mcp.Description("Optional topic to show alone, with the answers given on it")),
+	)
+	s.AddTool(topicBreakdownTool, handleTopicBreakdown)
+
 	// Register set politiscales language tool
 	setPolitiscalesLanguageTool := mcp.NewTool("set_politiscales_language",
 		mcp.WithDescription("Sets the language for the politiscales quiz"),
-		mcp.WithString("language", mcp.Required(), mcp.Enum("en", "fr", "es", "it", "ru", "zh", "ar"), mcp.Description("The language code for the quiz")),
+		mcp.WithString("language", mcp.Required(), mcp.Enum(supportedLanguages...), mcp.Description("The language code for the quiz")),
 	)
 	s.AddTool(setPolitiscalesLanguageTool, handleSetPolitiscalesLanguage)
 
+	// Register set language tool
+	setLanguageTool := mcp.NewTool("set_language",
+		mcp.WithDescription("Sets the language for all quizzes (political compass, 8values and politiscales)"),
+		mcp.WithString("language", mcp.Required(), mcp.Enum(supportedLanguages...), mcp.Description("The language code for the quizzes")),
+	)
+	s.AddTool(setLanguageTool, handleSetLanguage)
+
+	// Register translation coverage tool
+	translationCoverageTool := mcp.NewTool("translation_coverage",
+		mcp.WithDescription("Lists missing and extra translation keys per language compared to English"),
+		mcp.WithString("language", mcp.Enum(supportedLanguages...), mcp.Description("Only report this language (defaults to all languages)")),
+	)
+	s.AddTool(translationCoverageTool, handleTranslationCoverage)
+
+	// Register explain result tool
+	explainResultTool := mcp.NewTool("explain_result",
+		mcp.WithDescription("Explains a quiz result: lists, per axis, the answers that moved the score most with their signed contributions"),
+		mcp.WithString("quiz", mcp.Required(), mcp.Enum(politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz), mcp.Description("The quiz to explain")),
+		mcp.WithString("axis", mcp.Description("Only explain this axis, e.g. Economic or communism (defaults to all axes)")),
+		mcp.WithNumber("top", mcp.Description("How many questions to list per axis (default 5)")),
+	)
+	s.AddTool(explainResultTool, handleExplainResult)
+
+	// Register what-if tool
+	whatIfTool := mcp.NewTool("what_if",
+		mcp.WithDescription("Rescores a quiz with some answers changed, showing the hypothetical scores, label or quadrant changes and a before/after chart without altering the recorded answers"),
+		mcp.WithString("quiz", mcp.Required(), mcp.Enum(politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz), mcp.Description("The quiz to rescore")),
+		mcp.WithObject("overrides", mcp.Required(), mcp.Description("Answers to try, keyed by question ID or question key, e.g. {\"3\": \"agree\", \"tax_the_rich\": -0.5}; values are answers like strongly_agree or numbers from -1 to 1")),
+	)
+	s.AddTool(whatIfTool, handleWhatIf)
+
+	// Register export results tool
+	exportResultsTool := mcp.NewTool("export_results",
+		mcp.WithDescription("Exports the current or a stored quiz result as JSON (answers per question ID, scores, labels, seed and question bank version), CSV (one row per question and per axis) or a Markdown report with the chart embedded"),
+		mcp.WithString("quiz", mcp.Enum(politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz), mcp.Description("The quiz whose current result to export; required unless result is given")),
+		mcp.WithString("format", mcp.Enum(exportFormats...), mcp.Description("Output format (default json)")),
+		mcp.WithString("result", mcp.Description("A stored result previously exported as JSON, to export again in another format")),
+	)
+	s.AddTool(exportResultsTool, handleExportResults)
+
+	// Register share code tools
+	shareCodeTool := mcp.NewTool("share_code",
+		mcp.WithDescription("Returns a short URL-safe code encoding a quiz's answers, mode and question bank version, to restore the result elsewhere"),
+		mcp.WithString("quiz", mcp.Required(), mcp.Enum(politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz), mcp.Description("The quiz to share")),
+	)
+	s.AddTool(shareCodeTool, handleShareCode)
+
+	importShareCodeTool := mcp.NewTool("import_share_code",
+		mcp.WithDescription("Restores a quiz from a share code, replacing its current answers, and re-renders the result chart"),
+		mcp.WithString("code", mcp.Required(), mcp.Description("The share code from share_code or a completion message")),
+	)
+	s.AddTool(importShareCodeTool, handleImportShareCode)
+
+	// Register results link tools
+	importResultsLinkTool := mcp.NewTool("import_results_link",
+		mcp.WithDescription("Renders a result from an original quiz website's results link (8values results.html?e=&d=&g=&s=, politicalcompass.org ec=/soc=, or Politiscales) with the local charts"),
+		mcp.WithString("url", mcp.Required(), mcp.Description("The results link, or just its query string")),
+		mcp.WithString("quiz", mcp.Enum(politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz), mcp.Description("The quiz the link belongs to (detected from its parameters by default)")),
+	)
+	s.AddTool(importResultsLinkTool, handleImportResultsLink)
+
+	resultsLinkTool := mcp.NewTool("results_link",
+		mcp.WithDescription("Builds the original quiz website's results link for a quiz's current result"),
+		mcp.WithString("quiz", mcp.Required(), mcp.Enum(politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz), mcp.Description("The quiz to link")),
+	)
+	s.AddTool(resultsLinkTool, handleResultsLink)
+
+	// Register combined profile tool
+	combinedProfileTool := mcp.NewTool("combined_profile",
+		mcp.WithDescription("Lines up the comparable dimensions of the political compass, 8values and politiscales results (economic, authority, diplomatic) on a common scale, flags where the quizzes disagree and renders one summary chart"),
+	)
+	s.AddTool(combinedProfileTool, handleCombinedProfile)
+
+	// Register estimate results tool
+	estimateResultsTool := mcp.NewTool("estimate_results",
+		mcp.WithDescription("Predicts a provisional 8values or politiscales profile from the answers already given to near-identical questions in the other quizzes"),
+		mcp.WithString("quiz", mcp.Required(), mcp.Enum(eightValuesQuiz, politiscalesQuiz), mcp.Description("The quiz to estimate")),
+		mcp.WithString("from", mcp.Enum(politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz), mcp.Description("Only use answers from this quiz (defaults to every other quiz)")),
+	)
+	s.AddTool(estimateResultsTool, handleEstimateResults)
+
+	// Register nearest reference points tool
+	nearestReferencePointsTool := mcp.NewTool("nearest_reference_points",
+		mcp.WithDescription("Lists the well-known figures and parties nearest the user's political compass position and overlays them on the compass chart"),
+		mcp.WithNumber("count", mcp.Description("How many reference points to list (default 5)")),
+		mcp.WithString("category", mcp.Description("Only list points in this category, e.g. figure or party")),
+		mcp.WithString("show", mcp.Description("Further reference points to draw on the chart: comma-separated names or categories, or all")),
+	)
+	s.AddTool(nearestReferencePointsTool, handleNearestReferencePoints)
+
+	// Register match parties tool
+	matchPartiesTool := mcp.NewTool("match_parties",
+		mcp.WithDescription("Ranks the parties in a loaded positions file by their agreement with the user's quiz answers, showing where they agree and disagree on each question"),
+		mcp.WithString("quiz", mcp.Required(), mcp.Enum(politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz), mcp.Description("The quiz to match")),
+		mcp.WithString("format", mcp.Enum(exportFormats...), mcp.Description("Output format (default markdown)")),
+		mcp.WithString("party", mcp.Description("Only show this party")),
+	)
+	s.AddTool(matchPartiesTool, handleMatchParties)
+
 	return s
 }
 
 func main() {
 	showVersion := flag.Bool("version", false, "Show version")
+	translationsDir := flag.String("translations", "", "Directory of translation packs (.json or .po) to load at startup")
+	referencePointsFile := flag.String("reference-points", "", "JSON file of political compass reference points to add at startup")
+	partyPositionFiles := flag.String("party-positions", "", "Comma-separated JSON files of party positions to match quiz results against")
 	flag.Parse()
 
 	if *showVersion {
@@ -97,6 +275,49 @@ func main() {
 		os.Exit(0)
 	}
 
+	if *translationsDir != "" {
+		if _, err := loadTranslationPacks(*translationsDir); err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading translations: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	if *referencePointsFile != "" {
+		if _, err := loadReferencePointsFile(*referencePointsFile); err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading reference points: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	for _, path := range strings.Split(*partyPositionFiles, ",") {
+		if path = strings.TrimSpace(path); path == "" {
+			continue
+		}
+		if _, err := loadPartyPositionsFile(path); err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading party positions: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	// translation-coverage [language...] prints the coverage report instead of serving
+	if flag.Arg(0) == "translation-coverage" {
+		languages := flag.Args()[1:]
+		if len(languages) == 0 {
+			languages = supportedLanguages
+		}
+		fmt.Print(formatTranslationCoverage(languages))
+		os.Exit(0)
+	}
+
+	// export-results [-format f] [-o file] [result.json] converts a stored result instead of serving
+	if flag.Arg(0) == "export-results" {
+		if err := runExportResults(flag.Args()[1:], os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "Error exporting results: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	s := setupServer()
 
 	if err := server.ServeStdio(s); err != nil {
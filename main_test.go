@@ -56,7 +56,8 @@ func TestPoliticalCompassInvalidResponse(t *testing.T) {
 	}
 
 	responseText := extractTextContent(response)
-	expectedError := "invalid response: Invalid Response. Please use one of: strongly_disagree, disagree, agree, strongly_agree"
+	expectedError := "invalid response: Invalid Response. Please use one of: strongly_disagree, disagree, agree, strongly_agree\n" +
+		"Accepted forms: \"Strongly disagree\" (SD, 1), \"Disagree\" (D, 2), \"Agree\" (A, 4), \"Strongly agree\" (SA, 5)"
 	if responseText != expectedError {
 		t.Errorf("expected error message '%s', got '%s'", expectedError, responseText)
 	}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+)
+
+// handlePoliticalCompassPage runs the political compass a page at a time, as on the
+// official site: each call records answers to the propositions on the current page
+// and, once the page is complete, presents the next one. The caller must hold the mutex.
+func handlePoliticalCompassPage(request mcp.CallToolRequest, answer string, value float64, hasValue bool) *mcp.CallToolResult {
+	if questionCount == 0 {
+		return mcp.NewToolResultText(politicalCompassPage(true))
+	}
+	if currentIndex >= len(shuffledQuestions) && len(quizState.Responses) == len(shuffledQuestions) {
+		return mcp.NewToolResultText(finishQuiz(politicalCompassQuiz))
+	}
+
+	pending := shuffledQuestions[len(quizState.Responses):currentIndex]
+	page := politicalcompass.PageOf(pending[0])
+
+	// A list answers the propositions in order; a single answer goes to the next one
+	var given []any
+	if raw, ok := request.GetArguments()["answers"]; ok {
+		list, ok := raw.([]any)
+		if !ok {
+			return mcp.NewToolResultError("answers must be a list with one answer per proposition, in page order")
+		}
+		given = list
+	} else if hasValue {
+		given = []any{value}
+	} else {
+		given = []any{answer}
+	}
+	if len(given) == 0 || len(given) > len(pending) {
+		return mcp.NewToolResultError(fmt.Sprintf("expected up to %d answer(s) for the rest of page %d, got %d",
+			len(pending), page+1, len(given)))
+	}
+
+	// Check every answer before recording any, so one mistake does not leave the page half answered
+	responses := make([]politicalcompass.Response, len(given))
+	values := make([]float64, len(given))
+	for i, raw := range given {
+		switch v := raw.(type) {
+		case string:
+			response, ok := parsePoliticalCompassAnswer(v)
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("answer %d: %s", i+1, invalidPoliticalCompassAnswer(v)))
+			}
+			responses[i], values[i] = response, politicalcompass.ResponseValues[response]
+		case float64:
+			if math.IsNaN(v) || v < -1 || v > 1 {
+				return mcp.NewToolResultError(fmt.Sprintf("answer %d: invalid value: must be a number between -1 (strongly disagree) and 1 (strongly agree)", i+1))
+			}
+			responses[i], values[i] = politicalcompass.NearestResponse(v), v
+		default:
+			return mcp.NewToolResultError(fmt.Sprintf("answer %d: expected an answer such as \"agree\" or a number between -1 and 1", i+1))
+		}
+	}
+	for i := range given {
+		recordPoliticalCompassAnswer(politicalcompass.AllQuestions[pending[i]], responses[i], values[i])
+	}
+
+	if remaining := pending[len(given):]; len(remaining) > 0 {
+		return mcp.NewToolResultText(fmt.Sprintf("✅ %d answer(s) recorded!\n\n"+
+			"%d proposition(s) left on page %d of %d:\n%s\n"+
+			"Call this tool again with `answers` listing the user's responses to these, in order, to finish the page.",
+			len(given), len(remaining), page+1, len(politicalcompass.Pages), politicalCompassPageList(remaining)))
+	}
+	if currentIndex >= len(shuffledQuestions) {
+		return mcp.NewToolResultText(finishQuiz(politicalCompassQuiz))
+	}
+	return mcp.NewToolResultText(politicalCompassPage(false))
+}
+
+// politicalCompassPage presents the next page of propositions and marks them as asked.
+// The caller must hold the mutex.
+func politicalCompassPage(first bool) string {
+	index := politicalcompass.PageOf(shuffledQuestions[currentIndex])
+	page := politicalcompass.Pages[index]
+	total := len(politicalcompass.AllQuestions)
+
+	var message string
+	if first {
+		message = fmt.Sprintf("🗳️ Political Compass Quiz Started in page mode! (Language: %s)\n\n", politicalCompassLanguage)
+	} else {
+		// index counts from zero, so it is also the number of the page just answered
+		message = fmt.Sprintf("✅ Page %d recorded!\n\nProgress: %d of %d questions completed\n\n", index, len(quizState.Responses), total)
+	}
+	message += fmt.Sprintf("**Page %d of %d: %s** (questions %d-%d of %d)\n%s\n"+
+		"Please respond to each proposition with: strongly_disagree, disagree, agree, or strongly_agree\n\n"+
+		"**Important Instructions:**\n"+
+		"1. Present every proposition on this page in the chat for the user to see\n"+
+		"2. After the user answers them, show each proposition with their answer in chat\n"+
+		"3. Then call this tool again with `answers` listing their responses in the same order to continue to the next page",
+		index+1, len(politicalcompass.Pages), page.Title, page.Start+1, page.End, total,
+		politicalCompassPageList(shuffledQuestions[currentIndex:page.End]))
+
+	currentIndex = page.End
+	questionCount = currentIndex
+	return message
+}
+
+// politicalCompassPageList numbers propositions by their position in the quiz
+func politicalCompassPageList(questions []int) string {
+	var b strings.Builder
+	for _, question := range questions {
+		fmt.Fprintf(&b, "%d. %s\n", question+1, getPoliticalCompassQuestionText(politicalcompass.AllQuestions[question].Text))
+	}
+	return b.String()
+}
+
+// politicalCompassPageStatus reports page progress for quiz_status, or "" outside page mode
+func politicalCompassPageStatus() string {
+	if !quizState.Pages {
+		return ""
+	}
+	answered := len(quizState.Responses)
+	if answered >= len(shuffledQuestions) {
+		return fmt.Sprintf("- Mode: pages (official order), all %d pages complete\n", len(politicalcompass.Pages))
+	}
+	index := politicalcompass.PageOf(shuffledQuestions[answered])
+	page := politicalcompass.Pages[index]
+	return fmt.Sprintf("- Mode: pages (official order)\n- Page: %d of %d (%s), %d/%d answered\n",
+		index+1, len(politicalcompass.Pages), page.Title, answered-page.Start, page.End-page.Start)
+}
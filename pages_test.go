@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+)
+
+func TestPoliticalCompassPages(t *testing.T) {
+	resetState()
+	call := func(args map[string]interface{}) (string, bool) {
+		response, err := handlePoliticalCompass(context.Background(), createMockRequest("political_compass", args))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return extractTextContent(response), isErrorResult(response)
+	}
+	answers := func(n int, answer interface{}) []interface{} {
+		list := make([]interface{}, n)
+		for i := range list {
+			list[i] = answer
+		}
+		return list
+	}
+
+	content, isError := call(map[string]interface{}{"pages": true})
+	if isError || !strings.Contains(content, "**Page 1 of 6: Country and the world** (questions 1-7 of 62)") || !strings.Contains(content, "7. ") || strings.Contains(content, "8. ") {
+		t.Fatalf("expected the whole first page, got: %s", content)
+	}
+	if quizState.Seed != 0 || shuffledQuestions[10] != 10 {
+		t.Errorf("expected the official order, got seed %d", quizState.Seed)
+	}
+
+	// Answers are checked before any is recorded
+	for _, list := range [][]interface{}{answers(8, "agree"), {"agree", "maybe"}, {"agree", 2.0}, {true}, {}} {
+		if content, isError := call(map[string]interface{}{"answers": list}); !isError {
+			t.Errorf("expected an error for %v, got: %s", list, content)
+		}
+	}
+	if len(quizState.Responses) != 0 {
+		t.Fatalf("expected no answers recorded after errors, got %d", len(quizState.Responses))
+	}
+
+	// A partial page lists what is left; a single answer goes to the next proposition
+	content, _ = call(map[string]interface{}{"answers": []interface{}{"strongly_agree", -0.5}})
+	if !strings.Contains(content, "5 proposition(s) left on page 1 of 6") || !strings.Contains(content, "3. ") {
+		t.Errorf("expected the rest of page 1, got: %s", content)
+	}
+	if quizState.Values[1] != -0.5 || quizState.Responses[1] != politicalcompass.Disagree {
+		t.Errorf("expected a slider answer, got %v %v", quizState.Responses, quizState.Values)
+	}
+	call(map[string]interface{}{"answer": "agree"})
+	status, _ := handleQuizStatus(context.Background(), createMockRequest("quiz_status", nil))
+	if text := extractTextContent(status); !strings.Contains(text, "- Page: 1 of 6 (Country and the world), 3/7 answered") {
+		t.Errorf("expected page progress in the status, got: %s", text)
+	}
+
+	content, _ = call(map[string]interface{}{"answers": answers(4, "agree")})
+	if !strings.Contains(content, "Page 1 recorded!") || !strings.Contains(content, "**Page 2 of 6: The economy** (questions 8-21 of 62)") {
+		t.Errorf("expected the second page, got: %s", content)
+	}
+
+	for _, page := range politicalcompass.Pages[1:] {
+		content, isError = call(map[string]interface{}{"answers": answers(page.End-page.Start, "agree")})
+		if isError {
+			t.Fatalf("unexpected error on %s: %s", page.Title, content)
+		}
+	}
+	if !strings.Contains(content, "Political Compass Quiz Complete!") {
+		t.Errorf("expected the completion message, got: %s", content)
+	}
+	status, _ = handleQuizStatus(context.Background(), createMockRequest("quiz_status", nil))
+	if text := extractTextContent(status); !strings.Contains(text, "all 6 pages complete") {
+		t.Errorf("expected completed pages in the status, got: %s", text)
+	}
+	if result, _ := currentResult(politicalCompassQuiz); result.Mode != "pages (official order)" || !result.Complete {
+		t.Errorf("expected the page mode in the result, got %+v", result)
+	}
+}
+
+func TestPoliticalCompassPagesArguments(t *testing.T) {
+	resetState()
+	response, _ := handlePoliticalCompass(context.Background(), createMockRequest("political_compass", map[string]interface{}{"pages": true, "prefill": true}))
+	if !isErrorResult(response) {
+		t.Error("expected pages and prefill to be rejected together")
+	}
+
+	resetState()
+	handlePoliticalCompass(context.Background(), createRequestWithAnswer(""))
+	response, _ = handlePoliticalCompass(context.Background(), createMockRequest("political_compass", map[string]interface{}{"answers": []interface{}{"agree"}}))
+	if !isErrorResult(response) || !strings.Contains(extractTextContent(response), "page mode") {
+		t.Errorf("expected answers to need page mode, got: %s", extractTextContent(response))
+	}
+	status, _ := handleQuizStatus(context.Background(), createMockRequest("quiz_status", nil))
+	if strings.Contains(extractTextContent(status), "Page:") {
+		t.Error("expected no page progress outside page mode")
+	}
+}
@@ -0,0 +1,412 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+// partyMatchSummary is how many parties completion messages list
+const partyMatchSummary = 3
+
+// partyPositionFile is a voting-advice file: the positions of parties on one
+// quiz's questions or axes
+type partyPositionFile struct {
+	Title   string          `json:"title"`
+	Quiz    string          `json:"quiz"`
+	Parties []partyPosition `json:"parties"`
+}
+
+// partyPosition is one party's answers, keyed by question ID or key, and axis scores
+type partyPosition struct {
+	Name      string             `json:"name"`
+	Positions map[string]any     `json:"positions,omitempty"` // Answer strings or numbers from -1 to 1
+	Axes      map[string]float64 `json:"axes,omitempty"`      // Scores on the quiz's own scale
+	answers   map[int]float64    // Positions resolved to question indices
+}
+
+// partyPositions are the loaded position files by quiz
+var partyPositions = map[string]partyPositionFile{}
+
+// quizAxes returns a quiz's axis names with the range of their scores
+func quizAxes(quiz string) (names []string, min, max float64) {
+	switch quiz {
+	case politicalCompassQuiz:
+		return []string{"Economic", "Social"}, -10, 10
+	case eightValuesQuiz:
+		return eightValuesAxisNames[:], 0, 100
+	default:
+		for _, axis := range politiscales.Axes {
+			names = append(names, axis.Name)
+		}
+		return names, 0, 100
+	}
+}
+
+// parsePartyPositions reads and checks a party positions file, resolving each
+// party's positions against the quiz's questions and axes
+func parsePartyPositions(data []byte) (partyPositionFile, error) {
+	var file partyPositionFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return file, fmt.Errorf("invalid party positions: %v", err)
+	}
+	if _, ok := quizTitles[file.Quiz]; !ok {
+		return file, fmt.Errorf("invalid party positions: unknown quiz %q. Please use one of: %s, %s, %s",
+			file.Quiz, politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz)
+	}
+	if len(file.Parties) == 0 {
+		return file, fmt.Errorf("invalid party positions: no parties")
+	}
+	if file.Title == "" {
+		file.Title = quizTitles[file.Quiz] + " party positions"
+	}
+
+	axisNames, min, max := quizAxes(file.Quiz)
+	seen := map[string]bool{}
+	for i := range file.Parties {
+		party := &file.Parties[i]
+		party.Name = strings.TrimSpace(party.Name)
+		if party.Name == "" {
+			return file, fmt.Errorf("invalid party %d: name is required", i)
+		}
+		if seen[strings.ToLower(party.Name)] {
+			return file, fmt.Errorf("invalid party %q: listed twice", party.Name)
+		}
+		seen[strings.ToLower(party.Name)] = true
+		if len(party.Positions) == 0 && len(party.Axes) == 0 {
+			return file, fmt.Errorf("invalid party %q: give positions on questions or axes", party.Name)
+		}
+
+		positions, err := parseWhatIfOverrides(file.Quiz, party.Positions, nil)
+		if err != nil {
+			return file, fmt.Errorf("invalid party %q: %v", party.Name, err)
+		}
+		party.answers = map[int]float64{}
+		for _, position := range positions {
+			party.answers[position.question] = position.after
+		}
+
+		// Axis names are matched ignoring case and stored as the quiz spells them
+		axes := map[string]float64{}
+		for name, score := range party.Axes {
+			axis := ""
+			for _, known := range axisNames {
+				if strings.EqualFold(strings.TrimSpace(name), known) {
+					axis = known
+				}
+			}
+			if axis == "" {
+				return file, fmt.Errorf("invalid party %q: unknown axis %s. Please use one of: %s", party.Name, name, strings.Join(axisNames, ", "))
+			}
+			if math.IsNaN(score) || score < min || score > max {
+				return file, fmt.Errorf("invalid party %q: %s must be between %g and %g", party.Name, axis, min, max)
+			}
+			axes[axis] = score
+		}
+		party.Axes = axes
+	}
+	return file, nil
+}
+
+// loadPartyPositionsFile loads a party positions file, replacing any loaded
+// earlier for the same quiz
+func loadPartyPositionsFile(path string) (partyPositionFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return partyPositionFile{}, fmt.Errorf("reading party positions: %w", err)
+	}
+	file, err := parsePartyPositions(data)
+	if err != nil {
+		return file, err
+	}
+	partyPositions[file.Quiz] = file
+	return file, nil
+}
+
+// partyQuestionMatch compares the user's answer to a question with a party's
+type partyQuestionMatch struct {
+	exportedAnswer
+	PartyAnswer string  `json:"party_answer"`
+	PartyValue  float64 `json:"party_value"`
+	Agreement   float64 `json:"agreement"` // 0 to 100
+	Verdict     string  `json:"verdict"`   // agree, partial or disagree
+}
+
+// partyAxisMatch compares the user's score on an axis with a party's
+type partyAxisMatch struct {
+	Axis       string  `json:"axis"`
+	Score      float64 `json:"score"`
+	PartyScore float64 `json:"party_score"`
+	Agreement  float64 `json:"agreement"` // 0 to 100
+}
+
+// partyMatch is one party's agreement with the user. Agreement is averaged over
+// the questions both answered, or over the axes when no question is shared.
+type partyMatch struct {
+	Rank      int                  `json:"rank"`
+	Name      string               `json:"name"`
+	Agreement float64              `json:"agreement"`
+	Basis     string               `json:"basis"` // questions, axes or none
+	Questions []partyQuestionMatch `json:"questions,omitempty"`
+	Axes      []partyAxisMatch     `json:"axes,omitempty"`
+}
+
+// Verdicts counts the questions the user and party agree, partly agree and
+// disagree on
+func (m partyMatch) Verdicts() (agree, partial, disagree int) {
+	for _, q := range m.Questions {
+		switch q.Verdict {
+		case "agree":
+			agree++
+		case "partial":
+			partial++
+		default:
+			disagree++
+		}
+	}
+	return agree, partial, disagree
+}
+
+// partyMatchResult ranks the parties of a positions file against a quiz result
+type partyMatchResult struct {
+	Quiz        string       `json:"quiz"`
+	Title       string       `json:"title"`
+	Source      string       `json:"source"` // Title of the positions file
+	BankVersion string       `json:"bank_version"`
+	BankHash    string       `json:"bank_hash"`
+	Complete    bool         `json:"complete"`
+	Answered    int          `json:"answered"`
+	Total       int          `json:"total"`
+	ExportedAt  string       `json:"exported_at,omitempty"`
+	Parties     []partyMatch `json:"parties"`
+}
+
+// partyVerdict compares two answers Wahl-O-Mat style: the same side agrees,
+// opposite sides disagree, and a neutral answer against a side partly agrees
+func partyVerdict(user, party float64) string {
+	switch answerSide(user) * answerSide(party) {
+	case 1:
+		return "agree"
+	case -1:
+		return "disagree"
+	}
+	if answerSide(user) == answerSide(party) {
+		return "agree"
+	}
+	return "partial"
+}
+
+// matchParties ranks parties by their agreement with a result, most agreeing first
+func matchParties(result exportedResult, file partyPositionFile) partyMatchResult {
+	match := partyMatchResult{
+		Quiz: result.Quiz, Title: result.Title, Source: file.Title, BankVersion: result.BankVersion, BankHash: result.BankHash,
+		Complete: result.Complete, Answered: result.Answered, Total: result.Total, ExportedAt: result.ExportedAt,
+	}
+	_, min, max := quizAxes(result.Quiz)
+
+	for _, party := range file.Parties {
+		m := partyMatch{Name: party.Name, Basis: "none"}
+		var questionTotal, axisTotal float64
+		for _, answer := range result.Answers {
+			value, ok := party.answers[answer.QuestionID]
+			if !ok {
+				continue
+			}
+			agreement := 100 * (1 - math.Abs(answer.Value-value)/2)
+			questionTotal += agreement
+			m.Questions = append(m.Questions, partyQuestionMatch{
+				exportedAnswer: answer, PartyAnswer: describeAnswer(value), PartyValue: value,
+				Agreement: agreement, Verdict: partyVerdict(answer.Value, value),
+			})
+		}
+		for _, score := range result.Scores {
+			partyScore, ok := party.Axes[score.Axis]
+			if !ok {
+				continue
+			}
+			agreement := 100 * (1 - math.Abs(score.Score-partyScore)/(max-min))
+			axisTotal += agreement
+			m.Axes = append(m.Axes, partyAxisMatch{Axis: score.Axis, Score: score.Score, PartyScore: partyScore, Agreement: agreement})
+		}
+
+		switch {
+		case len(m.Questions) > 0:
+			m.Agreement, m.Basis = questionTotal/float64(len(m.Questions)), "questions"
+		case len(m.Axes) > 0:
+			m.Agreement, m.Basis = axisTotal/float64(len(m.Axes)), "axes"
+		}
+		match.Parties = append(match.Parties, m)
+	}
+
+	// Parties with nothing to compare go last
+	sort.SliceStable(match.Parties, func(i, j int) bool {
+		a, b := match.Parties[i], match.Parties[j]
+		if (a.Basis == "none") != (b.Basis == "none") {
+			return b.Basis == "none"
+		}
+		return a.Agreement > b.Agreement
+	})
+	for i := range match.Parties {
+		match.Parties[i].Rank = i + 1
+	}
+	return match
+}
+
+// formatPartyMatch renders a party match as JSON, CSV or Markdown
+func formatPartyMatch(match partyMatchResult, format string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "json":
+		data, err := json.MarshalIndent(match, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(data) + "\n", nil
+	case "csv":
+		return partyMatchCSV(match)
+	case "", "markdown", "md":
+		return partyMatchMarkdown(match), nil
+	default:
+		return "", fmt.Errorf("invalid format: %s. Please use one of: %s", format, strings.Join(exportFormats, ", "))
+	}
+}
+
+// partyMatchCSV writes one row per party followed by its question and axis rows
+func partyMatchCSV(match partyMatchResult) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	formatFloat := func(x float64) string { return strconv.FormatFloat(x, 'f', -1, 64) }
+
+	rows := [][]string{{"record", "quiz", "rank", "party", "agreement", "basis", "question_id", "key", "text", "answer", "value", "party_answer", "party_value", "verdict", "axis", "score", "party_score"}}
+	for _, p := range match.Parties {
+		rank := strconv.Itoa(p.Rank)
+		rows = append(rows, []string{"party", match.Quiz, rank, p.Name, formatFloat(p.Agreement), p.Basis, "", "", "", "", "", "", "", "", "", "", ""})
+		for _, q := range p.Questions {
+			rows = append(rows, []string{"question", match.Quiz, rank, p.Name, formatFloat(q.Agreement), "", strconv.Itoa(q.QuestionID), q.Key, q.Text,
+				q.Answer, formatFloat(q.Value), q.PartyAnswer, formatFloat(q.PartyValue), q.Verdict, "", "", ""})
+		}
+		for _, a := range p.Axes {
+			rows = append(rows, []string{"axis", match.Quiz, rank, p.Name, formatFloat(a.Agreement), "", "", "", "", "", "", "", "", "", a.Axis, formatFloat(a.Score), formatFloat(a.PartyScore)})
+		}
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// partyMatchMarkdown writes the ranking followed by each party's agreement per question and axis
+func partyMatchMarkdown(match partyMatchResult) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s Party Match: %s\n\n", match.Title, match.Source)
+	if !match.Complete {
+		fmt.Fprintf(&b, "*The quiz is still in progress (%d/%d answered), so the ranking is provisional.*\n\n", match.Answered, match.Total)
+	}
+
+	b.WriteString("| Rank | Party | Agreement | Agree | Partly | Disagree | Based on |\n|---|---|---|---|---|---|---|\n")
+	for _, p := range match.Parties {
+		agree, partial, disagree := p.Verdicts()
+		fmt.Fprintf(&b, "| %d | %s | %.0f%% | %d | %d | %d | %s |\n", p.Rank, markdownCell(p.Name), p.Agreement, agree, partial, disagree, p.Basis)
+	}
+
+	for _, p := range match.Parties {
+		fmt.Fprintf(&b, "\n## %d. %s (%.0f%%)\n\n", p.Rank, p.Name, p.Agreement)
+		if p.Basis == "none" {
+			b.WriteString("No positions on the questions answered or the quiz's axes.\n")
+			continue
+		}
+		if len(p.Questions) > 0 {
+			b.WriteString("| ID | Question | You | Party | Verdict |\n|---|---|---|---|---|\n")
+			for _, q := range p.Questions {
+				fmt.Fprintf(&b, "| %d | %s | %s | %s | %s |\n", q.QuestionID, markdownCell(q.Text), q.Answer, q.PartyAnswer, q.Verdict)
+			}
+		}
+		if len(p.Axes) > 0 {
+			if len(p.Questions) > 0 {
+				b.WriteString("\n")
+			}
+			b.WriteString("| Axis | You | Party | Agreement |\n|---|---|---|---|\n")
+			for _, a := range p.Axes {
+				fmt.Fprintf(&b, "| %s | %.1f | %.1f | %.0f%% |\n", a.Axis, a.Score, a.PartyScore, a.Agreement)
+			}
+		}
+	}
+	return b.String()
+}
+
+// partyMatchLine is the party ranking note added to completion messages when
+// positions are loaded for the quiz. The caller must hold the mutex.
+func partyMatchLine(quiz string) string {
+	file, ok := partyPositions[quiz]
+	if !ok {
+		return ""
+	}
+	result, err := currentResult(quiz)
+	if err != nil {
+		return ""
+	}
+	match := matchParties(result, file)
+	var ranking []string
+	for _, p := range match.Parties {
+		if len(ranking) == partyMatchSummary || p.Basis == "none" {
+			break
+		}
+		ranking = append(ranking, fmt.Sprintf("%d. %s (%.0f%%)", p.Rank, p.Name, p.Agreement))
+	}
+	if len(ranking) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("\n\nParty match (%s): %s. Use `match_parties` for the agreement on each question.", file.Title, strings.Join(ranking, ", "))
+}
+
+// handleMatchParties ranks the parties in the loaded positions file for a quiz
+// by their agreement with the user's answers
+func handleMatchParties(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	quiz, err := request.RequireString("quiz")
+	if err != nil {
+		return mcp.NewToolResultError("quiz is required"), nil
+	}
+	format := request.GetString("format", "markdown")
+	only := strings.TrimSpace(request.GetString("party", ""))
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	result, err := currentResult(quiz)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	file, ok := partyPositions[quiz]
+	if !ok {
+		return mcp.NewToolResultError(fmt.Sprintf("no party positions loaded for %s: start the server with -party-positions", quiz)), nil
+	}
+
+	match := matchParties(result, file)
+	if only != "" {
+		var names []string
+		for _, p := range match.Parties {
+			names = append(names, p.Name)
+			if strings.EqualFold(p.Name, only) {
+				match.Parties = []partyMatch{p}
+			}
+		}
+		if len(match.Parties) != 1 || !strings.EqualFold(match.Parties[0].Name, only) {
+			return mcp.NewToolResultError(fmt.Sprintf("unknown party: %s. Parties: %s", only, strings.Join(names, ", "))), nil
+		}
+	}
+
+	text, err := formatPartyMatch(match, format)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return mcp.NewToolResultText(text), nil
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/x86ed/MCP-PoliticalCompass/v3/eightvalues"
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+)
+
+// testPartyPositions positions four parties on the political compass: one that
+// mostly agrees, one that disagrees, one placed by axes only and one with a
+// single position
+const testPartyPositions = `{
+	"title": "Test election",
+	"quiz": "political_compass",
+	"parties": [
+		{"name": "Nay Party", "positions": {"0": "strongly_disagree", "1": -1}},
+		{"name": "Yea Party", "positions": {"0": "agree", "1": "agree", "2": 1}},
+		{"name": "Axis Party", "axes": {"economic": -10, "SOCIAL": 0}},
+		{"name": "Half Party", "positions": {"0": "agree"}, "axes": {"Economic": 0}}
+	]
+}`
+
+func TestParsePartyPositions(t *testing.T) {
+	file, err := parsePartyPositions([]byte(testPartyPositions))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if file.Title != "Test election" || len(file.Parties) != 4 {
+		t.Fatalf("unexpected file %+v", file)
+	}
+	if yea := file.Parties[1]; len(yea.answers) != 3 || yea.answers[0] != politicalcompass.ResponseValues[politicalcompass.Agree] || yea.answers[2] != 1 {
+		t.Errorf("expected positions resolved to answer values, got %v", yea.answers)
+	}
+	if axes := file.Parties[2].Axes; axes["Economic"] != -10 || len(axes) != 2 {
+		t.Errorf("expected axis names in the quiz's spelling, got %v", axes)
+	}
+
+	key := eightvalues.Questions[3].Text
+	file, err = parsePartyPositions([]byte(`{"quiz": "eight_values", "parties": [{"name": "P", "positions": {"` + key + `": "neutral"}}]}`))
+	if err != nil || file.Parties[0].answers[3] != eightvalues.Neutral || file.Title != "8values party positions" {
+		t.Errorf("expected a question key to resolve and a default title, got %+v (%v)", file, err)
+	}
+
+	tests := []struct {
+		name     string
+		data     string
+		expected string
+	}{
+		{"not json", `[`, "invalid party positions"},
+		{"unknown quiz", `{"quiz": "astrology", "parties": [{"name": "P", "axes": {"Economic": 1}}]}`, "unknown quiz"},
+		{"no parties", `{"quiz": "political_compass"}`, "no parties"},
+		{"no name", `{"quiz": "political_compass", "parties": [{"axes": {"Economic": 1}}]}`, "name is required"},
+		{"listed twice", `{"quiz": "political_compass", "parties": [{"name": "P", "axes": {"Economic": 1}}, {"name": "p", "axes": {"Economic": 2}}]}`, "listed twice"},
+		{"no positions", `{"quiz": "political_compass", "parties": [{"name": "P"}]}`, "give positions"},
+		{"unknown question", `{"quiz": "political_compass", "parties": [{"name": "P", "positions": {"999": "agree"}}]}`, "invalid question"},
+		{"bad answer", `{"quiz": "political_compass", "parties": [{"name": "P", "positions": {"0": "maybe"}}]}`, "invalid answer"},
+		{"unknown axis", `{"quiz": "eight_values", "parties": [{"name": "P", "axes": {"Social": 50}}]}`, "unknown axis"},
+		{"off the scale", `{"quiz": "eight_values", "parties": [{"name": "P", "axes": {"Economic": 150}}]}`, "between 0 and 100"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := parsePartyPositions([]byte(tt.data)); err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("expected error containing %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestMatchParties(t *testing.T) {
+	resetState()
+	completePoliticalCompass("agree")
+
+	file, _ := parsePartyPositions([]byte(testPartyPositions))
+	result, err := currentResult(politicalCompassQuiz)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	match := matchParties(result, file)
+
+	var names []string
+	for i, p := range match.Parties {
+		names = append(names, p.Name)
+		if p.Rank != i+1 {
+			t.Errorf("expected rank %d for %s, got %d", i+1, p.Name, p.Rank)
+		}
+	}
+	if strings.Join(names, ",") != "Half Party,Yea Party,Axis Party,Nay Party" {
+		t.Errorf("unexpected ranking %v", names)
+	}
+
+	half, yea, axis, nay := match.Parties[0], match.Parties[1], match.Parties[2], match.Parties[3]
+	if yea.Basis != "questions" || len(yea.Questions) != 3 || yea.Questions[0].Verdict != "agree" || math.Abs(yea.Agreement-800.0/9) > 1e-9 {
+		t.Errorf("unexpected Yea Party match %+v", yea)
+	}
+	if half.Agreement != 100 || len(half.Axes) != 1 {
+		t.Errorf("expected questions to take precedence over axes, got %+v", half)
+	}
+	if axis.Basis != "axes" || len(axis.Questions) != 0 || len(axis.Axes) != 2 {
+		t.Errorf("expected an axis-only match, got %+v", axis)
+	}
+	if agree, partial, disagree := nay.Verdicts(); agree != 0 || partial != 0 || disagree != 2 {
+		t.Errorf("expected two disagreements for Nay Party, got %d/%d/%d", agree, partial, disagree)
+	}
+	if nay.Questions[0].PartyAnswer != "strongly disagree" || nay.Questions[0].Text == "" {
+		t.Errorf("expected question details, got %+v", nay.Questions[0])
+	}
+
+	// Parties with nothing in common with the answers go last
+	resetState()
+	handlePoliticalCompass(context.Background(), createRequestWithAnswer(""))
+	handlePoliticalCompass(context.Background(), createRequestWithAnswer("agree"))
+	result, _ = currentResult(politicalCompassQuiz)
+	file, _ = parsePartyPositions([]byte(`{"quiz": "political_compass", "parties": [
+		{"name": "Elsewhere", "positions": {"` + politicalcompass.AllQuestions[shuffledQuestions[1]].Text + `": "agree"}},
+		{"name": "Placed", "axes": {"Economic": 10}}
+	]}`))
+	if match := matchParties(result, file); match.Parties[0].Name != "Placed" || match.Parties[1].Basis != "none" {
+		t.Errorf("expected the unmatched party last, got %+v", match.Parties)
+	}
+}
+
+func TestHandleMatchParties(t *testing.T) {
+	resetState()
+	defer func() { partyPositions = map[string]partyPositionFile{} }()
+
+	request := func(args map[string]interface{}) (string, bool) {
+		response, err := handleMatchParties(context.Background(), createMockRequest("match_parties", args))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return extractTextContent(response), isErrorResult(response)
+	}
+
+	handlePoliticalCompass(context.Background(), createRequestWithAnswer(""))
+	handlePoliticalCompass(context.Background(), createRequestWithAnswer("agree"))
+	if content, isError := request(map[string]interface{}{"quiz": "political_compass"}); !isError || !strings.Contains(content, "-party-positions") {
+		t.Errorf("expected an error without a positions file, got: %s", content)
+	}
+
+	path := filepath.Join(t.TempDir(), "parties.json")
+	os.WriteFile(path, []byte(testPartyPositions), 0o644)
+	if _, err := loadPartyPositionsFile(path); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	content, isError := request(map[string]interface{}{"quiz": "political_compass"})
+	if isError || !strings.Contains(content, "Party Match: Test election") || !strings.Contains(content, "provisional") {
+		t.Errorf("unexpected provisional match: %s", content)
+	}
+
+	// The completion message lists the best matches
+	var message string
+	for i := 1; i < len(politicalcompass.AllQuestions); i++ {
+		response, _ := handlePoliticalCompass(context.Background(), createRequestWithAnswer("agree"))
+		message = extractTextContent(response)
+	}
+	if !strings.Contains(message, "Party match (Test election): 1. Half Party (100%), 2. Yea Party") {
+		t.Errorf("expected the party ranking in the completion message, got: %s", message)
+	}
+
+	content, isError = request(map[string]interface{}{"quiz": "political_compass", "format": "json", "party": "nay party"})
+	var match partyMatchResult
+	if isError || json.Unmarshal([]byte(content), &match) != nil {
+		t.Fatalf("expected a JSON match, got: %s", content)
+	}
+	if len(match.Parties) != 1 || match.Parties[0].Name != "Nay Party" || match.Parties[0].Rank != 4 || !match.Complete {
+		t.Errorf("expected only Nay Party with its rank, got %+v", match)
+	}
+	if !strings.Contains(content, `"question_id": 0`) || !strings.Contains(content, `"verdict": "disagree"`) {
+		t.Errorf("expected per-question details in the JSON, got: %s", content)
+	}
+
+	content, _ = request(map[string]interface{}{"quiz": "political_compass", "format": "csv"})
+	if !strings.HasPrefix(content, "record,quiz,rank,party") || strings.Count(content, "\nparty,") != 4 {
+		t.Errorf("unexpected CSV: %s", content)
+	}
+
+	for _, args := range []map[string]interface{}{{"quiz": "political_compass", "party": "Nobody"}, {"quiz": "political_compass", "format": "xml"}, {"quiz": "eight_values"}} {
+		if content, isError := request(args); !isError {
+			t.Errorf("expected an error for %v, got: %s", args, content)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package politicalcompass
+
+// ARCopy contains Arabic translations for general UI elements
+var ARCopy = map[string]string{
+	"start_test":      "ابدأ الاختبار",
+	"question_x_of_n": "السؤال {x} من {n}",
+	"back_home":       "العودة إلى الصفحة الرئيسية",
+	"prev_question":   "العودة إلى السؤال السابق",
+	"strong_disagree": "أعارض بشدة",
+	"disagree":        "أعارض",
+	"neutral":         "محايد",
+	"agree":           "أوافق",
+	"strong_agree":    "أوافق بشدة",
+	"result":          "النتيجة",
+}
+
+// ARQuestions contains Arabic translations for all political compass questions
+var ARQuestions = map[string]string{
+	"globalisation_humanity":                "إذا كانت العولمة الاقتصادية حتمية، فيجب أن تخدم البشرية في المقام الأول لا مصالح الشركات العابرة للحدود.",
+	"country_right_or_wrong":                "سأدعم بلدي دائمًا، سواء كان على حق أو على خطأ.",
+	"birth_country_pride":                   "لا أحد يختار بلد مولده، لذا من الحماقة أن يفخر به.",
+	"race_superiority":                      "يتمتع عرقنا بالعديد من الصفات المتفوقة مقارنة بالأعراق الأخرى.",
+	"enemy_of_enemy":                        "عدو عدوي صديقي.",
+	"military_international_law":            "العمل العسكري الذي يتحدى القانون الدولي مبرر في بعض الأحيان.",
+	"infotainment_fusion":                   "هناك الآن اندماج مقلق بين المعلومات والترفيه.",
+	"class_over_nationality":                "في النهاية، يفرّق الطبقةُ بين الناس أكثر مما تفرّقهم الجنسية.",
+	"inflation_over_unemployment":           "السيطرة على التضخم أهم من السيطرة على البطالة.",
+	"corporate_environment_regulation":      "لأنه لا يمكن الوثوق بالشركات لحماية البيئة طوعًا، فهي بحاجة إلى تنظيم.",
+	"ability_need":                          "«من كلٍّ حسب قدرته، ولكلٍّ حسب حاجته» فكرة جيدة في جوهرها.",
+	"free_market_free_people":               "كلما كانت السوق أكثر حرية، كان الناس أكثر حرية.",
+	"bottled_water":                         "من المؤسف لمجتمعنا أن شيئًا أساسيًا كمياه الشرب أصبح الآن منتجًا استهلاكيًا معبأً يحمل علامة تجارية.",
+	"land_commodity":                        "لا ينبغي أن تكون الأرض سلعة تُباع وتُشترى.",
+	"money_manipulation_fortunes":           "من المؤسف أن كثيرًا من الثروات الشخصية يصنعها أناس يكتفون بالتلاعب بالمال ولا يقدمون شيئًا لمجتمعهم.",
+	"protectionism_trade":                   "الحمائية ضرورية أحيانًا في التجارة.",
+	"company_profit_responsibility":         "يجب أن تكون المسؤولية الاجتماعية الوحيدة للشركة هي تحقيق الربح لمساهميها.",
+	"rich_overtaxed":                        "الأغنياء يدفعون ضرائب مرتفعة أكثر من اللازم.",
+	"paid_medical_care":                     "يجب أن يحصل القادرون على الدفع على مستوى أعلى من الرعاية الطبية.",
+	"penalise_misleading_business":          "يجب على الحكومات معاقبة الشركات التي تضلل الجمهور.",
+	"free_market_monopoly_restrictions":     "تتطلب السوق الحرة الحقيقية تقييد قدرة الشركات متعددة الجنسيات المفترسة على إنشاء احتكارات.",
+	"abortion_illegal":                      "يجب أن يكون الإجهاض غير قانوني دائمًا إذا لم تكن حياة المرأة مهددة.",
+	"question_authority":                    "يجب التشكيك في كل سلطة.",
+	"eye_for_eye":                           "العين بالعين والسن بالسن.",
+	"theatre_museum_subsidies":              "لا ينبغي أن يُتوقع من دافعي الضرائب دعم المسارح أو المتاحف التي لا تستطيع البقاء على أساس تجاري.",
+	"school_attendance_optional":            "لا ينبغي للمدارس أن تجعل حضور الفصول إلزاميًا.",
+	"keep_to_own_kind":                      "لكل الناس حقوقهم، لكن من الأفضل لنا جميعًا أن يبقى كل صنف من الناس مع أمثاله.",
+	"spank_children":                        "يضطر الآباء الصالحون أحيانًا إلى ضرب أطفالهم على سبيل التأديب.",
+	"children_secrets":                      "من الطبيعي أن يخفي الأطفال بعض الأسرار عن والديهم.",
+	"marijuana_decriminalise":               "لا ينبغي أن تكون حيازة الماريجوانا للاستخدام الشخصي جريمة جنائية.",
+	"schooling_for_jobs":                    "يجب أن تكون الوظيفة الأساسية للتعليم المدرسي هي تأهيل الجيل القادم لإيجاد وظائف.",
+	"inheritable_disabilities_reproduction": "لا ينبغي السماح للأشخاص المصابين بإعاقات وراثية خطيرة بالإنجاب.",
+	"children_discipline":                   "أهم ما يجب أن يتعلمه الأطفال هو تقبّل الانضباط.",
+	"no_savage_peoples":                     "لا توجد شعوب متوحشة وأخرى متحضرة، بل توجد ثقافات مختلفة فحسب.",
+	"work_refusal_support":                  "من يستطيع العمل ويرفض الفرصة لا ينبغي أن ينتظر دعم المجتمع.",
+	"troubled_keep_busy":                    "عندما تكون مهمومًا، من الأفضل ألا تفكر في الأمر وأن تنشغل بأشياء أكثر بهجة.",
+	"immigrant_integration":                 "لا يمكن أبدًا للمهاجرين من الجيل الأول أن يندمجوا بالكامل في بلدهم الجديد.",
+	"corporations_good_for_all":             "ما هو جيد لأنجح الشركات هو دائمًا، في نهاية المطاف، جيد لنا جميعًا.",
+	"broadcasting_public_funding":           "لا ينبغي لأي مؤسسة بث، مهما كان محتواها مستقلًا، أن تتلقى تمويلًا عامًا.",
+	"counter_terrorism_liberties":           "يتم تقييد حرياتنا المدنية بشكل مفرط باسم مكافحة الإرهاب.",
+	"one_party_state":                       "من المزايا المهمة لدولة الحزب الواحد أنها تتجنب كل الجدالات التي تؤخر التقدم في النظام السياسي الديمقراطي.",
+	"surveillance_wrongdoers":               "مع أن العصر الإلكتروني يسهّل المراقبة الرسمية، فإن المخطئين وحدهم هم من يجب أن يقلقوا.",
+	"death_penalty":                         "يجب أن تكون عقوبة الإعدام خيارًا متاحًا لأخطر الجرائم.",
+	"hierarchy_obedience":                   "في المجتمع المتحضر، لا بد دائمًا من وجود من هم أعلى ليُطاعوا ومن هم أدنى ليُؤمروا.",
+	"abstract_art":                          "الفن التجريدي الذي لا يمثل شيئًا لا ينبغي اعتباره فنًا على الإطلاق.",
+	"punishment_over_rehabilitation":        "في العدالة الجنائية، يجب أن يكون العقاب أهم من إعادة التأهيل.",
+	"rehabilitation_waste":                  "محاولة إعادة تأهيل بعض المجرمين مضيعة للوقت.",
+	"business_over_artists":                 "رجل الأعمال والصانع أهم من الكاتب والفنان.",
+	"mothers_homemakers":                    "يمكن للأمهات أن يكون لهن مسيرة مهنية، لكن واجبهن الأول هو رعاية المنزل.",
+	"growth_climate":                        "يعد جميع السياسيين تقريبًا بالنمو الاقتصادي، لكن علينا أن نصغي إلى تحذيرات علم المناخ من أن النمو يضر بجهودنا للحد من الاحتباس الحراري.",
+	"peace_with_establishment":              "التصالح مع المؤسسة القائمة جانب مهم من جوانب النضج.",
+	"astrology":                             "يفسّر علم التنجيم أشياء كثيرة بدقة.",
+	"morality_religion":                     "لا يمكن أن تكون أخلاقيًا من دون أن تكون متدينًا.",
+	"charity_over_social_security":          "الأعمال الخيرية أفضل من الضمان الاجتماعي كوسيلة لمساعدة المحرومين حقًا.",
+	"naturally_unlucky":                     "بعض الناس سيئو الحظ بطبيعتهم.",
+	"school_religious_values":               "من المهم أن تغرس مدرسة طفلي القيم الدينية.",
+	"sex_outside_marriage":                  "الجنس خارج إطار الزواج غير أخلاقي عادةً.",
+	"same_sex_adoption":                     "لا ينبغي حرمان زوجين من الجنس نفسه تجمعهما علاقة مستقرة ومحبة من إمكانية تبني طفل.",
+	"pornography_legal":                     "يجب أن تكون المواد الإباحية التي تصور بالغين متراضين قانونية للبالغين.",
+	"bedroom_privacy":                       "ما يجري في غرفة نوم خاصة بين بالغين متراضين ليس من شأن الدولة.",
+	"homosexuality_natural":                 "لا أحد يمكن أن يشعر بأنه مثلي بطبيعته.",
+	"sex_openness":                          "في هذه الأيام، تجاوز الانفتاح بشأن الجنس الحدود.",
+}
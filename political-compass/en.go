@@ -0,0 +1,81 @@
+package politicalcompass
+
+// ENCopy contains English translations for general UI elements
+var ENCopy = map[string]string{
+	"start_test":      "Start the test",
+	"question_x_of_n": "Question {x} of {n}",
+	"back_home":       "Back to home",
+	"prev_question":   "Return to the previous question",
+	"strong_disagree": "Strongly disagree",
+	"disagree":        "Disagree",
+	"neutral":         "Neutral",
+	"agree":           "Agree",
+	"strong_agree":    "Strongly agree",
+	"result":          "Result",
+}
+
+// ENQuestions contains English translations for all political compass questions
+var ENQuestions = map[string]string{
+	"globalisation_humanity":                "If economic globalisation is inevitable, it should primarily serve humanity rather than the interests of trans-national corporations.",
+	"country_right_or_wrong":                "I'd always support my country, whether it was right or wrong.",
+	"birth_country_pride":                   "No one chooses their country of birth, so it's foolish to be proud of it.",
+	"race_superiority":                      "Our race has many superior qualities, compared with other races.",
+	"enemy_of_enemy":                        "The enemy of my enemy is my friend.",
+	"military_international_law":            "Military action that defies international law is sometimes justified.",
+	"infotainment_fusion":                   "There is now a worrying fusion of information and entertainment.",
+	"class_over_nationality":                "People are ultimately divided more by class than by nationality.",
+	"inflation_over_unemployment":           "Controlling inflation is more important than controlling unemployment.",
+	"corporate_environment_regulation":      "Because corporations cannot be trusted to voluntarily protect the environment, they require regulation.",
+	"ability_need":                          "\"from each according to his ability, to each according to his need\" is a fundamentally good idea.",
+	"free_market_free_people":               "The freer the market, the freer the people.",
+	"bottled_water":                         "It's a sad reflection on our society that something as basic as drinking water is now a bottled, branded consumer product.",
+	"land_commodity":                        "Land shouldn't be a commodity to be bought and sold.",
+	"money_manipulation_fortunes":           "It is regrettable that many personal fortunes are made by people who simply manipulate money and contribute nothing to their society.",
+	"protectionism_trade":                   "Protectionism is sometimes necessary in trade.",
+	"company_profit_responsibility":         "The only social responsibility of a company should be to deliver a profit to its shareholders.",
+	"rich_overtaxed":                        "The rich are too highly taxed.",
+	"paid_medical_care":                     "Those with the ability to pay should have access to higher standards of medical care.",
+	"penalise_misleading_business":          "Governments should penalise businesses that mislead the public.",
+	"free_market_monopoly_restrictions":     "A genuine free market requires restrictions on the ability of predator multinationals to create monopolies.",
+	"abortion_illegal":                      "Abortion, when the woman's life is not threatened, should always be illegal.",
+	"question_authority":                    "All authority should be questioned.",
+	"eye_for_eye":                           "An eye for an eye and a tooth for a tooth.",
+	"theatre_museum_subsidies":              "Taxpayers should not be expected to prop up any theatres or museums that cannot survive on a commercial basis.",
+	"school_attendance_optional":            "Schools should not make classroom attendance compulsory.",
+	"keep_to_own_kind":                      "All people have their rights, but it is better for all of us that different sorts of people should keep to their own kind.",
+	"spank_children":                        "Good parents sometimes have to spank their children.",
+	"children_secrets":                      "It's natural for children to keep some secrets from their parents.",
+	"marijuana_decriminalise":               "Possessing marijuana for personal use should not be a criminal offence.",
+	"schooling_for_jobs":                    "The prime function of schooling should be to equip the future generation to find jobs.",
+	"inheritable_disabilities_reproduction": "People with serious inheritable disabilities should not be allowed to reproduce.",
+	"children_discipline":                   "The most important thing for children to learn is to accept discipline.",
+	"no_savage_peoples":                     "There are no savage and civilised peoples; there are only different cultures.",
+	"work_refusal_support":                  "Those who are able to work, and refuse the opportunity, should not expect society's support.",
+	"troubled_keep_busy":                    "When you are troubled, it's better not to think about it, but to keep busy with more cheerful things.",
+	"immigrant_integration":                 "First-generation immigrants can never be fully integrated within their new country.",
+	"corporations_good_for_all":             "What's good for the most successful corporations is always, ultimately, good for all of us.",
+	"broadcasting_public_funding":           "No broadcasting institution, however independent its content, should receive public funding.",
+	"counter_terrorism_liberties":           "Our civil liberties are being excessively curbed in the name of counter-terrorism.",
+	"one_party_state":                       "A significant advantage of a one-party state is that it avoids all the arguments that delay progress in a democratic political system.",
+	"surveillance_wrongdoers":               "Although the electronic age makes official surveillance easier, only wrongdoers need to be worried.",
+	"death_penalty":                         "The death penalty should be an option for the most serious crimes.",
+	"hierarchy_obedience":                   "In a civilised society, one must always have people above to be obeyed and people below to be commanded.",
+	"abstract_art":                          "Abstract art that doesn't represent anything shouldn't be considered art at all.",
+	"punishment_over_rehabilitation":        "In criminal justice, punishment should be more important than rehabilitation.",
+	"rehabilitation_waste":                  "It is a waste of time to try to rehabilitate some criminals.",
+	"business_over_artists":                 "The businessperson and the manufacturer are more important than the writer and the artist.",
+	"mothers_homemakers":                    "Mothers may have careers, but their first duty is to be homemakers.",
+	"growth_climate":                        "Almost all politicians promise economic growth, but we should heed the warnings of climate science that growth is detrimental to our efforts to curb global warming.",
+	"peace_with_establishment":              "Making peace with the establishment is an important aspect of maturity.",
+	"astrology":                             "Astrology accurately explains many things.",
+	"morality_religion":                     "You cannot be moral without being religious.",
+	"charity_over_social_security":          "Charity is better than social security as a means of helping the genuinely disadvantaged.",
+	"naturally_unlucky":                     "Some people are naturally unlucky.",
+	"school_religious_values":               "It is important that my child's school instills religious values.",
+	"sex_outside_marriage":                  "Sex outside marriage is usually immoral.",
+	"same_sex_adoption":                     "A same sex couple in a stable, loving relationship should not be excluded from the possibility of child adoption.",
+	"pornography_legal":                     "Pornography, depicting consenting adults, should be legal for the adult population.",
+	"bedroom_privacy":                       "What goes on in a private bedroom between consenting adults is no business of the state.",
+	"homosexuality_natural":                 "No one can feel naturally homosexual.",
+	"sex_openness":                          "These days openness about sex has gone too far.",
+}
@@ -0,0 +1,81 @@
+package politicalcompass
+
+// ESCopy contains Spanish translations for general UI elements
+var ESCopy = map[string]string{
+	"start_test":      "Comenzar la prueba",
+	"question_x_of_n": "Pregunta {x} de {n}",
+	"back_home":       "Volver al inicio",
+	"prev_question":   "Volver a la pregunta anterior",
+	"strong_disagree": "Muy en desacuerdo",
+	"disagree":        "En desacuerdo",
+	"neutral":         "Neutral",
+	"agree":           "De acuerdo",
+	"strong_agree":    "Muy de acuerdo",
+	"result":          "Resultado",
+}
+
+// ESQuestions contains Spanish translations for all political compass questions
+var ESQuestions = map[string]string{
+	"globalisation_humanity":                "Si la globalización económica es inevitable, debería servir principalmente a la humanidad y no a los intereses de las corporaciones transnacionales.",
+	"country_right_or_wrong":                "Siempre apoyaría a mi país, tuviera razón o no.",
+	"birth_country_pride":                   "Nadie elige su país de nacimiento, así que es absurdo estar orgulloso de él.",
+	"race_superiority":                      "Nuestra raza tiene muchas cualidades superiores en comparación con otras razas.",
+	"enemy_of_enemy":                        "El enemigo de mi enemigo es mi amigo.",
+	"military_international_law":            "Una acción militar que desafía el derecho internacional está a veces justificada.",
+	"infotainment_fusion":                   "Existe hoy una preocupante fusión entre información y entretenimiento.",
+	"class_over_nationality":                "En última instancia, las personas están más divididas por la clase que por la nacionalidad.",
+	"inflation_over_unemployment":           "Controlar la inflación es más importante que controlar el desempleo.",
+	"corporate_environment_regulation":      "Como no se puede confiar en que las empresas protejan voluntariamente el medio ambiente, necesitan regulación.",
+	"ability_need":                          "«De cada cual según su capacidad, a cada cual según su necesidad» es una idea fundamentalmente buena.",
+	"free_market_free_people":               "Cuanto más libre es el mercado, más libre es la gente.",
+	"bottled_water":                         "Es un triste reflejo de nuestra sociedad que algo tan básico como el agua potable sea ahora un producto de consumo embotellado y de marca.",
+	"land_commodity":                        "La tierra no debería ser una mercancía que se compra y se vende.",
+	"money_manipulation_fortunes":           "Es lamentable que muchas fortunas personales las hagan personas que simplemente manipulan dinero y no aportan nada a la sociedad.",
+	"protectionism_trade":                   "El proteccionismo es a veces necesario en el comercio.",
+	"company_profit_responsibility":         "La única responsabilidad social de una empresa debería ser generar beneficios para sus accionistas.",
+	"rich_overtaxed":                        "Los ricos pagan demasiados impuestos.",
+	"paid_medical_care":                     "Quienes pueden pagar deberían tener acceso a una atención médica de mayor calidad.",
+	"penalise_misleading_business":          "Los gobiernos deberían sancionar a las empresas que engañan al público.",
+	"free_market_monopoly_restrictions":     "Un verdadero mercado libre requiere restringir la capacidad de las multinacionales depredadoras para crear monopolios.",
+	"abortion_illegal":                      "El aborto, cuando la vida de la mujer no está en peligro, debería ser siempre ilegal.",
+	"question_authority":                    "Toda autoridad debería ser cuestionada.",
+	"eye_for_eye":                           "Ojo por ojo, diente por diente.",
+	"theatre_museum_subsidies":              "No se debería esperar que los contribuyentes mantengan teatros o museos que no pueden sobrevivir comercialmente.",
+	"school_attendance_optional":            "Las escuelas no deberían hacer obligatoria la asistencia a clase.",
+	"keep_to_own_kind":                      "Todas las personas tienen sus derechos, pero es mejor para todos que cada tipo de gente se mantenga entre los suyos.",
+	"spank_children":                        "Los buenos padres a veces tienen que dar una azotaina a sus hijos.",
+	"children_secrets":                      "Es natural que los niños guarden algunos secretos a sus padres.",
+	"marijuana_decriminalise":               "La posesión de marihuana para uso personal no debería ser un delito.",
+	"schooling_for_jobs":                    "La función principal de la escuela debería ser preparar a la próxima generación para encontrar empleo.",
+	"inheritable_disabilities_reproduction": "A las personas con discapacidades hereditarias graves no se les debería permitir reproducirse.",
+	"children_discipline":                   "Lo más importante que deben aprender los niños es a aceptar la disciplina.",
+	"no_savage_peoples":                     "No hay pueblos salvajes y pueblos civilizados; solo hay culturas diferentes.",
+	"work_refusal_support":                  "Quienes pueden trabajar y rechazan la oportunidad no deberían esperar el apoyo de la sociedad.",
+	"troubled_keep_busy":                    "Cuando algo te preocupa, es mejor no pensar en ello y mantenerse ocupado con cosas más alegres.",
+	"immigrant_integration":                 "Los inmigrantes de primera generación nunca pueden integrarse plenamente en su nuevo país.",
+	"corporations_good_for_all":             "Lo que es bueno para las empresas más exitosas es siempre, en última instancia, bueno para todos.",
+	"broadcasting_public_funding":           "Ninguna institución de radiodifusión, por independiente que sea su contenido, debería recibir financiación pública.",
+	"counter_terrorism_liberties":           "Nuestras libertades civiles están siendo excesivamente restringidas en nombre de la lucha antiterrorista.",
+	"one_party_state":                       "Una ventaja importante de un Estado de partido único es que evita todas las discusiones que retrasan el progreso en un sistema político democrático.",
+	"surveillance_wrongdoers":               "Aunque la era electrónica facilita la vigilancia oficial, solo los que obran mal deben preocuparse.",
+	"death_penalty":                         "La pena de muerte debería ser una opción para los delitos más graves.",
+	"hierarchy_obedience":                   "En una sociedad civilizada, siempre debe haber personas arriba a quienes obedecer y personas abajo a quienes mandar.",
+	"abstract_art":                          "El arte abstracto que no representa nada no debería considerarse arte en absoluto.",
+	"punishment_over_rehabilitation":        "En la justicia penal, el castigo debería ser más importante que la rehabilitación.",
+	"rehabilitation_waste":                  "Intentar rehabilitar a algunos delincuentes es una pérdida de tiempo.",
+	"business_over_artists":                 "El empresario y el fabricante son más importantes que el escritor y el artista.",
+	"mothers_homemakers":                    "Las madres pueden tener una carrera, pero su primer deber es ocuparse del hogar.",
+	"growth_climate":                        "Casi todos los políticos prometen crecimiento económico, pero deberíamos atender las advertencias de la ciencia climática de que el crecimiento perjudica nuestros esfuerzos por frenar el calentamiento global.",
+	"peace_with_establishment":              "Hacer las paces con el orden establecido es un aspecto importante de la madurez.",
+	"astrology":                             "La astrología explica con precisión muchas cosas.",
+	"morality_religion":                     "No se puede ser moral sin ser religioso.",
+	"charity_over_social_security":          "La caridad es mejor que la seguridad social como medio para ayudar a los verdaderamente desfavorecidos.",
+	"naturally_unlucky":                     "Algunas personas son desafortunadas por naturaleza.",
+	"school_religious_values":               "Es importante que la escuela de mi hijo inculque valores religiosos.",
+	"sex_outside_marriage":                  "El sexo fuera del matrimonio suele ser inmoral.",
+	"same_sex_adoption":                     "Una pareja del mismo sexo con una relación estable y afectuosa no debería quedar excluida de la posibilidad de adoptar.",
+	"pornography_legal":                     "La pornografía con adultos que consienten debería ser legal para la población adulta.",
+	"bedroom_privacy":                       "Lo que ocurre en un dormitorio privado entre adultos que consienten no es asunto del Estado.",
+	"homosexuality_natural":                 "Nadie puede sentirse homosexual de forma natural.",
+	"sex_openness":                          "Hoy en día la apertura sobre el sexo ha ido demasiado lejos.",
+}
@@ -0,0 +1,81 @@
+package politicalcompass
+
+// FRCopy contains French translations for general UI elements
+var FRCopy = map[string]string{
+	"start_test":      "Commencer le test",
+	"question_x_of_n": "Question {x} sur {n}",
+	"back_home":       "Retour à l'accueil",
+	"prev_question":   "Retour à la question précédente",
+	"strong_disagree": "Pas du tout d'accord",
+	"disagree":        "Pas d'accord",
+	"neutral":         "Neutre",
+	"agree":           "D'accord",
+	"strong_agree":    "Tout à fait d'accord",
+	"result":          "Résultat",
+}
+
+// FRQuestions contains French translations for all political compass questions
+var FRQuestions = map[string]string{
+	"globalisation_humanity":                "Si la mondialisation économique est inévitable, elle doit avant tout servir l'humanité plutôt que les intérêts des multinationales.",
+	"country_right_or_wrong":                "Je soutiendrais toujours mon pays, qu'il ait raison ou tort.",
+	"birth_country_pride":                   "Personne ne choisit son pays de naissance, il est donc absurde d'en être fier.",
+	"race_superiority":                      "Notre race possède de nombreuses qualités supérieures par rapport aux autres races.",
+	"enemy_of_enemy":                        "L'ennemi de mon ennemi est mon ami.",
+	"military_international_law":            "Une action militaire qui enfreint le droit international est parfois justifiée.",
+	"infotainment_fusion":                   "Il existe aujourd'hui une fusion inquiétante entre l'information et le divertissement.",
+	"class_over_nationality":                "Les gens sont finalement davantage divisés par la classe sociale que par la nationalité.",
+	"inflation_over_unemployment":           "Maîtriser l'inflation est plus important que maîtriser le chômage.",
+	"corporate_environment_regulation":      "Comme on ne peut pas compter sur les entreprises pour protéger volontairement l'environnement, elles doivent être réglementées.",
+	"ability_need":                          "« De chacun selon ses capacités, à chacun selon ses besoins » est une idée fondamentalement bonne.",
+	"free_market_free_people":               "Plus le marché est libre, plus les gens sont libres.",
+	"bottled_water":                         "Il est triste pour notre société que quelque chose d'aussi élémentaire que l'eau potable soit devenu un produit de consommation en bouteille et de marque.",
+	"land_commodity":                        "La terre ne devrait pas être une marchandise que l'on achète et que l'on vend.",
+	"money_manipulation_fortunes":           "Il est regrettable que de nombreuses fortunes soient faites par des gens qui se contentent de manipuler l'argent sans rien apporter à la société.",
+	"protectionism_trade":                   "Le protectionnisme est parfois nécessaire dans le commerce.",
+	"company_profit_responsibility":         "La seule responsabilité sociale d'une entreprise devrait être de dégager un bénéfice pour ses actionnaires.",
+	"rich_overtaxed":                        "Les riches sont trop lourdement imposés.",
+	"paid_medical_care":                     "Ceux qui en ont les moyens devraient avoir accès à des soins médicaux de meilleure qualité.",
+	"penalise_misleading_business":          "Les gouvernements devraient sanctionner les entreprises qui trompent le public.",
+	"free_market_monopoly_restrictions":     "Un véritable marché libre exige de limiter la capacité des multinationales prédatrices à créer des monopoles.",
+	"abortion_illegal":                      "L'avortement, lorsque la vie de la femme n'est pas menacée, devrait toujours être illégal.",
+	"question_authority":                    "Toute autorité devrait être remise en question.",
+	"eye_for_eye":                           "Œil pour œil, dent pour dent.",
+	"theatre_museum_subsidies":              "Les contribuables ne devraient pas avoir à soutenir les théâtres ou musées qui ne peuvent pas survivre sur une base commerciale.",
+	"school_attendance_optional":            "Les écoles ne devraient pas rendre la présence en classe obligatoire.",
+	"keep_to_own_kind":                      "Tout le monde a des droits, mais il vaut mieux pour nous tous que chaque sorte de gens reste entre soi.",
+	"spank_children":                        "Les bons parents doivent parfois donner une fessée à leurs enfants.",
+	"children_secrets":                      "Il est naturel que les enfants aient quelques secrets pour leurs parents.",
+	"marijuana_decriminalise":               "La possession de marijuana pour un usage personnel ne devrait pas être une infraction pénale.",
+	"schooling_for_jobs":                    "La fonction première de l'école devrait être de préparer la génération future à trouver un emploi.",
+	"inheritable_disabilities_reproduction": "Les personnes atteintes de handicaps héréditaires graves ne devraient pas être autorisées à se reproduire.",
+	"children_discipline":                   "La chose la plus importante que les enfants doivent apprendre est d'accepter la discipline.",
+	"no_savage_peoples":                     "Il n'y a pas de peuples sauvages et de peuples civilisés ; il n'y a que des cultures différentes.",
+	"work_refusal_support":                  "Ceux qui sont capables de travailler et refusent de le faire ne devraient pas compter sur le soutien de la société.",
+	"troubled_keep_busy":                    "Quand on est tracassé, il vaut mieux ne pas y penser et s'occuper avec des choses plus gaies.",
+	"immigrant_integration":                 "Les immigrés de première génération ne peuvent jamais être pleinement intégrés dans leur nouveau pays.",
+	"corporations_good_for_all":             "Ce qui est bon pour les entreprises les plus prospères est toujours, au bout du compte, bon pour nous tous.",
+	"broadcasting_public_funding":           "Aucun organisme de radiodiffusion, aussi indépendant soit son contenu, ne devrait recevoir de fonds publics.",
+	"counter_terrorism_liberties":           "Nos libertés civiles sont excessivement restreintes au nom de la lutte contre le terrorisme.",
+	"one_party_state":                       "Un avantage important d'un État à parti unique est qu'il évite tous les débats qui retardent le progrès dans un système politique démocratique.",
+	"surveillance_wrongdoers":               "Même si l'ère numérique facilite la surveillance officielle, seuls ceux qui font le mal ont à s'inquiéter.",
+	"death_penalty":                         "La peine de mort devrait être une option pour les crimes les plus graves.",
+	"hierarchy_obedience":                   "Dans une société civilisée, il faut toujours avoir des gens au-dessus à qui obéir et des gens en dessous à commander.",
+	"abstract_art":                          "L'art abstrait qui ne représente rien ne devrait pas du tout être considéré comme de l'art.",
+	"punishment_over_rehabilitation":        "En justice pénale, la punition devrait primer sur la réinsertion.",
+	"rehabilitation_waste":                  "Essayer de réinsérer certains criminels est une perte de temps.",
+	"business_over_artists":                 "L'entrepreneur et l'industriel sont plus importants que l'écrivain et l'artiste.",
+	"mothers_homemakers":                    "Les mères peuvent faire carrière, mais leur premier devoir est de tenir leur foyer.",
+	"growth_climate":                        "Presque tous les politiciens promettent la croissance économique, mais nous devrions écouter les avertissements de la science du climat selon lesquels la croissance nuit à nos efforts pour limiter le réchauffement climatique.",
+	"peace_with_establishment":              "Faire la paix avec l'ordre établi est un aspect important de la maturité.",
+	"astrology":                             "L'astrologie explique avec justesse beaucoup de choses.",
+	"morality_religion":                     "On ne peut pas être moral sans être religieux.",
+	"charity_over_social_security":          "La charité vaut mieux que la sécurité sociale pour aider les personnes réellement défavorisées.",
+	"naturally_unlucky":                     "Certaines personnes sont naturellement malchanceuses.",
+	"school_religious_values":               "Il est important que l'école de mon enfant transmette des valeurs religieuses.",
+	"sex_outside_marriage":                  "Les relations sexuelles hors mariage sont généralement immorales.",
+	"same_sex_adoption":                     "Un couple de même sexe vivant une relation stable et aimante ne devrait pas être exclu de la possibilité d'adopter un enfant.",
+	"pornography_legal":                     "La pornographie mettant en scène des adultes consentants devrait être légale pour la population adulte.",
+	"bedroom_privacy":                       "Ce qui se passe dans une chambre à coucher entre adultes consentants ne regarde pas l'État.",
+	"homosexuality_natural":                 "Personne ne peut se sentir naturellement homosexuel.",
+	"sex_openness":                          "De nos jours, la liberté de ton sur la sexualité est allée trop loin.",
+}
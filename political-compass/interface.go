@@ -1,7 +1,10 @@
 // Package politicalcompass provides types and data for political compass questionnaires.
 package politicalcompass
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+)
 
 // Response represents the possible responses to a political compass question
 type Response int
@@ -29,16 +32,83 @@ func (r Response) String() string {
 	}
 }
 
+// BankVersion numbers the question bank. Bump it, and register a migration for
+// stored answers, whenever questions are added, removed, reordered or reweighted.
+const BankVersion = 1
+
 // Question represents a political compass question with economic and social scoring data
 type Question struct {
 	Index    int32      // Question index/ID
 	Economic [4]float64 // Economic scoring values (array of 4 floats)
 	Social   [4]float64 // Social scoring values (array of 4 floats)
-	Text     string     // Question text
+	Text     string     // Question translation key
+	Topics   []string   // Topic tags, such as economy or criminal_justice
+}
+
+// ResponseValues places each Response on the continuous answer scale from
+// -1 (strongly disagree) to 1 (strongly agree), evenly spaced
+var ResponseValues = [4]float64{-1, -1.0 / 3.0, 1.0 / 3.0, 1}
+
+// NearestResponse returns the Response closest to a value on the [-1, 1] answer scale
+func NearestResponse(value float64) Response {
+	nearest := StronglyDisagree
+	for r := Disagree; r <= StronglyAgree; r++ {
+		if math.Abs(value-ResponseValues[r]) < math.Abs(value-ResponseValues[nearest]) {
+			nearest = r
+		}
+	}
+	return nearest
+}
+
+// Interpolate returns the weight for a value on the [-1, 1] answer scale, linearly
+// interpolating between the two neighbouring response columns. Values at a
+// column's position return that column exactly.
+func Interpolate(weights [4]float64, value float64) float64 {
+	if value <= ResponseValues[0] {
+		return weights[0]
+	}
+	for i := 1; i < len(ResponseValues); i++ {
+		if value == ResponseValues[i] {
+			return weights[i]
+		}
+		if value < ResponseValues[i] {
+			t := (value - ResponseValues[i-1]) / (ResponseValues[i] - ResponseValues[i-1])
+			return weights[i-1] + t*(weights[i]-weights[i-1])
+		}
+	}
+	return weights[len(weights)-1]
+}
+
+// Scores returns the economic and social contributions of an answer given as a
+// value on the [-1, 1] answer scale
+func (q Question) Scores(value float64) (economic, social float64) {
+	return Interpolate(q.Economic, value), Interpolate(q.Social, value)
+}
+
+// Position is a point on the compass
+type Position struct {
+	Economic float64
+	Social   float64
+}
+
+// SVGOptions controls how the compass chart is rendered
+type SVGOptions struct {
+	EconomicMargin float64   // Half-width of the economic confidence interval; 0 draws no error bar
+	SocialMargin   float64   // Half-width of the social confidence interval; 0 draws no error bar
+	Previous       *Position // Earlier position, drawn as a hollow marker with a line to the current one
+
+	// ReferencePoints are drawn as small labelled markers, with labels placed to avoid each other
+	ReferencePoints []ReferencePoint
 }
 
 // GenerateSVG generates an SVG visualization of political compass results
 func GenerateSVG(economicScore, socialScore float64) string {
+	return GenerateSVGWithOptions(economicScore, socialScore, SVGOptions{})
+}
+
+// GenerateSVGWithOptions generates the compass chart, drawing error bars through the
+// user's position for any confidence intervals given in the options
+func GenerateSVGWithOptions(economicScore, socialScore float64, opts SVGOptions) string {
 	// SVG dimensions and margins
 	width := 400
 	height := 400
@@ -68,6 +138,70 @@ func GenerateSVG(economicScore, socialScore float64) string {
 		userY = height - margin
 	}
 
+	// Error bars span the interval on each axis, clamped to the plotting area
+	scale := float64(width-2*margin) / 20.0
+	clamp := func(v, lo, hi int) int {
+		if v < lo {
+			return lo
+		}
+		if v > hi {
+			return hi
+		}
+		return v
+	}
+	errorBars := ""
+	if opts.EconomicMargin > 0 {
+		x1 := clamp(centerX+int((economicScore-opts.EconomicMargin)*scale), margin, width-margin)
+		x2 := clamp(centerX+int((economicScore+opts.EconomicMargin)*scale), margin, width-margin)
+		errorBars += fmt.Sprintf(`
+  <line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#343a40" stroke-width="1.5"/>
+  <line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#343a40" stroke-width="1.5"/>
+  <line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#343a40" stroke-width="1.5"/>`,
+			x1, userY, x2, userY, x1, userY-5, x1, userY+5, x2, userY-5, x2, userY+5)
+	}
+	if opts.SocialMargin > 0 {
+		y1 := clamp(centerY-int((socialScore+opts.SocialMargin)*scale), margin, height-margin)
+		y2 := clamp(centerY-int((socialScore-opts.SocialMargin)*scale), margin, height-margin)
+		errorBars += fmt.Sprintf(`
+  <line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#343a40" stroke-width="1.5"/>
+  <line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#343a40" stroke-width="1.5"/>
+  <line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#343a40" stroke-width="1.5"/>`,
+			userX, y1, userX, y2, userX-5, y1, userX+5, y1, userX-5, y2, userX+5, y2)
+	}
+
+	// A previous position is drawn hollow, joined to the current one by a dashed line
+	previous := ""
+	if opts.Previous != nil {
+		previousX := clamp(centerX+int(opts.Previous.Economic*scale), margin, width-margin)
+		previousY := clamp(centerY-int(opts.Previous.Social*scale), margin, height-margin)
+		previous = fmt.Sprintf(`
+  <line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#6c757d" stroke-width="2" stroke-dasharray="4 3"/>
+  <circle cx="%d" cy="%d" r="7" fill="none" stroke="#6c757d" stroke-width="2"/>
+  <text x="%d" y="%d" text-anchor="start" font-family="Arial, sans-serif" font-size="10" fill="#495057">
+    Before: (%.2f, %.2f)
+  </text>`,
+			previousX, previousY, userX, userY,
+			previousX, previousY,
+			width-margin-90, height-30,
+			opts.Previous.Economic, opts.Previous.Social)
+	}
+
+	// Reference labels keep clear of the user's marker and the position text
+	toX := func(economic float64) float64 {
+		return float64(clamp(centerX+int(economic*scale), margin, width-margin))
+	}
+	toY := func(social float64) float64 {
+		return float64(clamp(centerY-int(social*scale), margin, height-margin))
+	}
+	occupied := []labelBox{
+		{float64(userX - 10), float64(userY - 10), float64(userX + 10), float64(userY + 10)},
+		{float64(margin), float64(height - 42), float64(margin + 150), float64(height - 26)},
+	}
+	if opts.Previous != nil {
+		occupied = append(occupied, labelBox{float64(width - margin - 90), float64(height - 42), float64(width), float64(height - 26)})
+	}
+	references := referenceOverlay(opts.ReferencePoints, toX, toY, occupied, float64(width), float64(height))
+
 	svg := fmt.Sprintf(`<svg width="%d" height="%d" xmlns="http://www.w3.org/2000/svg">
   <!-- Background -->
   <rect width="%d" height="%d" fill="#f8f9fa" stroke="#dee2e6" stroke-width="1"/>
@@ -114,6 +248,12 @@ func GenerateSVG(economicScore, socialScore float64) string {
   <text x="%d" y="%d" text-anchor="middle" font-family="Arial, sans-serif" font-size="11" font-weight="bold" fill="#666">Libertarian</text>
   <text x="%d" y="%d" text-anchor="middle" font-family="Arial, sans-serif" font-size="11" font-weight="bold" fill="#666">Right</text>
   
+  <!-- Confidence intervals -->%s
+  
+  <!-- Previous position -->%s
+  
+  <!-- Reference points -->%s
+  
   <!-- User position -->
   <circle cx="%d" cy="%d" r="8" fill="#dc3545" stroke="#ffffff" stroke-width="2"/>
   <text x="%d" y="%d" text-anchor="middle" font-family="Arial, sans-serif" font-size="10" font-weight="bold" fill="#ffffff">●</text>
@@ -149,6 +289,9 @@ func GenerateSVG(economicScore, socialScore float64) string {
 		margin+(centerX-margin)/2, height-margin+25, // Lib Left label 2
 		centerX+(centerX-margin)/2, height-margin+15, // Lib Right label
 		centerX+(centerX-margin)/2, height-margin+25, // Lib Right label 2
+		errorBars,    // Confidence interval error bars
+		previous,     // Previous position marker
+		references,   // Reference point markers and labels
 		userX, userY, // User position circle
 		userX, userY+1, // User position text
 		margin, height-30, // Position text
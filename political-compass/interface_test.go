@@ -1,6 +1,8 @@
 package politicalcompass
 
 import (
+	"math"
+	"strings"
 	"testing"
 )
 
@@ -64,8 +66,13 @@ func TestQuestionStructure(t *testing.T) {
 		t.Errorf("First question index should be 0, got %d", question.Index)
 	}
 
+	expectedKey := "globalisation_humanity"
+	if question.Text != expectedKey {
+		t.Errorf("First question key mismatch: expected %s, got %s", expectedKey, question.Text)
+	}
+
 	expectedText := "If economic globalisation is inevitable, it should primarily serve humanity rather than the interests of trans-national corporations."
-	if question.Text != expectedText {
+	if ENQuestions[question.Text] != expectedText {
 		t.Errorf("First question text mismatch")
 	}
 
@@ -119,8 +126,8 @@ func TestDataIntegrity(t *testing.T) {
 	// Test that we have diverse question texts
 	uniqueTexts := make(map[string]bool)
 	for _, question := range AllQuestions {
-		if len(question.Text) < 10 {
-			t.Errorf("Question %d has suspiciously short text: %s", question.Index, question.Text)
+		if len(ENQuestions[question.Text]) < 10 {
+			t.Errorf("Question %d has suspiciously short text: %s", question.Index, ENQuestions[question.Text])
 		}
 
 		if uniqueTexts[question.Text] {
@@ -133,3 +140,123 @@ func TestDataIntegrity(t *testing.T) {
 		t.Errorf("Expected 62 unique question texts, got %d", len(uniqueTexts))
 	}
 }
+
+func TestTranslationMaps(t *testing.T) {
+	languages := map[string]struct {
+		copy      map[string]string
+		questions map[string]string
+	}{
+		"en": {ENCopy, ENQuestions},
+		"fr": {FRCopy, FRQuestions},
+		"es": {ESCopy, ESQuestions},
+		"it": {ITCopy, ITQuestions},
+		"ar": {ARCopy, ARQuestions},
+		"ru": {RUCopy, RUQuestions},
+		"zh": {ZHCopy, ZHQuestions},
+	}
+
+	copyKeys := []string{"strong_disagree", "disagree", "agree", "strong_agree"}
+
+	for lang, tables := range languages {
+		t.Run(lang, func(t *testing.T) {
+			for _, key := range copyKeys {
+				if tables.copy[key] == "" {
+					t.Errorf("Missing %s copy for key %s", lang, key)
+				}
+			}
+
+			if len(tables.questions) != len(AllQuestions) {
+				t.Errorf("Expected %d %s translations, got %d", len(AllQuestions), lang, len(tables.questions))
+			}
+
+			for _, question := range AllQuestions {
+				if tables.questions[question.Text] == "" {
+					t.Errorf("Missing %s translation for question %d (%s)", lang, question.Index, question.Text)
+				}
+			}
+		})
+	}
+}
+
+func TestInterpolate(t *testing.T) {
+	weights := [4]float64{-6, -2, 2, 6}
+
+	for r, position := range ResponseValues {
+		if got := Interpolate(weights, position); got != weights[r] {
+			t.Errorf("value %f should select column %d exactly, got %f", position, r, got)
+		}
+	}
+
+	tests := []struct {
+		value    float64
+		expected float64
+	}{
+		{0, 0},
+		{-2.0 / 3.0, -4},
+		{2.0 / 3.0, 4},
+		{-5, -6},
+		{5, 6},
+	}
+	for _, tt := range tests {
+		if got := Interpolate(weights, tt.value); math.Abs(got-tt.expected) > 1e-9 {
+			t.Errorf("Interpolate(%f) = %f, want %f", tt.value, got, tt.expected)
+		}
+	}
+
+	question := AllQuestions[0]
+	economic, social := question.Scores(ResponseValues[Agree])
+	if economic != question.Economic[Agree] || social != question.Social[Agree] {
+		t.Error("Scores at a response position should match that response's columns")
+	}
+}
+
+func TestNearestResponse(t *testing.T) {
+	tests := map[float64]Response{
+		-1:   StronglyDisagree,
+		-0.7: StronglyDisagree,
+		-0.5: Disagree,
+		-0.1: Disagree,
+		0.1:  Agree,
+		0.6:  Agree,
+		0.9:  StronglyAgree,
+		1:    StronglyAgree,
+	}
+	for value, expected := range tests {
+		if got := NearestResponse(value); got != expected {
+			t.Errorf("NearestResponse(%f) = %v, want %v", value, got, expected)
+		}
+	}
+}
+
+func TestGenerateSVGErrorBars(t *testing.T) {
+	if strings.Contains(GenerateSVG(2, -3), "<line x1=\"215\"") {
+		t.Error("SVG without margins should not draw error bars")
+	}
+
+	svg := GenerateSVGWithOptions(2, -3, SVGOptions{EconomicMargin: 1, SocialMargin: 9})
+	// Economic spans 1 to 3 (x 215-245) at the position's height (y 245)
+	if !strings.Contains(svg, `<line x1="215" y1="245" x2="245" y2="245"`) {
+		t.Error("SVG should draw the economic interval through the position")
+	}
+	// Social spans 6 to -12, clamped to the plotting area (y 110-350)
+	if !strings.Contains(svg, `<line x1="230" y1="110" x2="230" y2="350"`) {
+		t.Error("SVG should clamp the social interval to the chart")
+	}
+}
+
+func TestGenerateSVGPreviousPosition(t *testing.T) {
+	if strings.Contains(GenerateSVG(2, -3), "Before:") {
+		t.Error("SVG without a previous position should not draw one")
+	}
+
+	svg := GenerateSVGWithOptions(2, -3, SVGOptions{Previous: &Position{Economic: -2, Social: 3}})
+	if !strings.Contains(svg, `<circle cx="170" cy="155" r="7" fill="none"`) {
+		t.Error("SVG should draw the previous position as a hollow marker")
+	}
+	if !strings.Contains(svg, `<line x1="170" y1="155" x2="230" y2="245"`) {
+		t.Error("SVG should join the previous position to the current one")
+	}
+	if !strings.Contains(svg, "Before: (-2.00, 3.00)") {
+		t.Error("SVG should label the previous position")
+	}
+}
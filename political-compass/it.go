@@ -0,0 +1,81 @@
+package politicalcompass
+
+// ITCopy contains Italian translations for general UI elements
+var ITCopy = map[string]string{
+	"start_test":      "Inizia il test",
+	"question_x_of_n": "Domanda {x} di {n}",
+	"back_home":       "Torna alla home",
+	"prev_question":   "Torna alla domanda precedente",
+	"strong_disagree": "Fortemente in disaccordo",
+	"disagree":        "In disaccordo",
+	"neutral":         "Neutrale",
+	"agree":           "D'accordo",
+	"strong_agree":    "Fortemente d'accordo",
+	"result":          "Risultato",
+}
+
+// ITQuestions contains Italian translations for all political compass questions
+var ITQuestions = map[string]string{
+	"globalisation_humanity":                "Se la globalizzazione economica è inevitabile, dovrebbe servire innanzitutto l'umanità piuttosto che gli interessi delle multinazionali.",
+	"country_right_or_wrong":                "Sosterrei sempre il mio paese, che abbia ragione o torto.",
+	"birth_country_pride":                   "Nessuno sceglie il proprio paese di nascita, quindi è sciocco esserne orgogliosi.",
+	"race_superiority":                      "La nostra razza ha molte qualità superiori rispetto alle altre razze.",
+	"enemy_of_enemy":                        "Il nemico del mio nemico è mio amico.",
+	"military_international_law":            "Un'azione militare che viola il diritto internazionale è talvolta giustificata.",
+	"infotainment_fusion":                   "Oggi c'è una preoccupante fusione tra informazione e intrattenimento.",
+	"class_over_nationality":                "In fondo le persone sono divise più dalla classe sociale che dalla nazionalità.",
+	"inflation_over_unemployment":           "Controllare l'inflazione è più importante che controllare la disoccupazione.",
+	"corporate_environment_regulation":      "Poiché non ci si può fidare che le aziende proteggano volontariamente l'ambiente, esse vanno regolamentate.",
+	"ability_need":                          "«Da ciascuno secondo le sue capacità, a ciascuno secondo i suoi bisogni» è un'idea fondamentalmente buona.",
+	"free_market_free_people":               "Più il mercato è libero, più le persone sono libere.",
+	"bottled_water":                         "È un triste segno della nostra società che qualcosa di essenziale come l'acqua potabile sia diventato un prodotto di consumo imbottigliato e di marca.",
+	"land_commodity":                        "La terra non dovrebbe essere una merce da comprare e vendere.",
+	"money_manipulation_fortunes":           "È deplorevole che molte fortune personali siano fatte da persone che si limitano a manipolare denaro senza contribuire in nulla alla società.",
+	"protectionism_trade":                   "Il protezionismo è talvolta necessario nel commercio.",
+	"company_profit_responsibility":         "L'unica responsabilità sociale di un'azienda dovrebbe essere produrre profitti per i suoi azionisti.",
+	"rich_overtaxed":                        "I ricchi sono tassati troppo.",
+	"paid_medical_care":                     "Chi può permetterselo dovrebbe avere accesso a cure mediche di livello superiore.",
+	"penalise_misleading_business":          "I governi dovrebbero sanzionare le aziende che ingannano il pubblico.",
+	"free_market_monopoly_restrictions":     "Un vero libero mercato richiede limiti alla capacità delle multinazionali predatrici di creare monopoli.",
+	"abortion_illegal":                      "L'aborto, quando la vita della donna non è in pericolo, dovrebbe essere sempre illegale.",
+	"question_authority":                    "Ogni autorità dovrebbe essere messa in discussione.",
+	"eye_for_eye":                           "Occhio per occhio, dente per dente.",
+	"theatre_museum_subsidies":              "I contribuenti non dovrebbero sostenere teatri o musei che non riescono a sopravvivere su basi commerciali.",
+	"school_attendance_optional":            "Le scuole non dovrebbero rendere obbligatoria la frequenza delle lezioni.",
+	"keep_to_own_kind":                      "Tutti hanno i propri diritti, ma è meglio per tutti che ogni tipo di persona stia tra i propri simili.",
+	"spank_children":                        "I bravi genitori a volte devono sculacciare i propri figli.",
+	"children_secrets":                      "È naturale che i figli abbiano qualche segreto con i genitori.",
+	"marijuana_decriminalise":               "Il possesso di marijuana per uso personale non dovrebbe essere un reato.",
+	"schooling_for_jobs":                    "La funzione principale della scuola dovrebbe essere preparare la generazione futura a trovare lavoro.",
+	"inheritable_disabilities_reproduction": "Le persone con gravi disabilità ereditarie non dovrebbero poter avere figli.",
+	"children_discipline":                   "La cosa più importante che i bambini devono imparare è accettare la disciplina.",
+	"no_savage_peoples":                     "Non esistono popoli selvaggi e popoli civilizzati; esistono solo culture diverse.",
+	"work_refusal_support":                  "Chi è in grado di lavorare e rifiuta l'opportunità non dovrebbe aspettarsi il sostegno della società.",
+	"troubled_keep_busy":                    "Quando si è turbati, è meglio non pensarci e tenersi occupati con cose più allegre.",
+	"immigrant_integration":                 "Gli immigrati di prima generazione non potranno mai integrarsi pienamente nel loro nuovo paese.",
+	"corporations_good_for_all":             "Ciò che è buono per le aziende di maggior successo è sempre, in definitiva, buono per tutti noi.",
+	"broadcasting_public_funding":           "Nessun ente radiotelevisivo, per quanto indipendente nei contenuti, dovrebbe ricevere fondi pubblici.",
+	"counter_terrorism_liberties":           "Le nostre libertà civili vengono limitate eccessivamente in nome dell'antiterrorismo.",
+	"one_party_state":                       "Un vantaggio importante di uno stato a partito unico è che evita tutte le discussioni che rallentano il progresso in un sistema democratico.",
+	"surveillance_wrongdoers":               "Anche se l'era elettronica rende più facile la sorveglianza ufficiale, solo chi agisce male deve preoccuparsi.",
+	"death_penalty":                         "La pena di morte dovrebbe essere un'opzione per i crimini più gravi.",
+	"hierarchy_obedience":                   "In una società civile bisogna sempre avere qualcuno sopra a cui obbedire e qualcuno sotto a cui comandare.",
+	"abstract_art":                          "L'arte astratta che non rappresenta nulla non dovrebbe affatto essere considerata arte.",
+	"punishment_over_rehabilitation":        "Nella giustizia penale, la punizione dovrebbe contare più della riabilitazione.",
+	"rehabilitation_waste":                  "Cercare di riabilitare alcuni criminali è una perdita di tempo.",
+	"business_over_artists":                 "L'imprenditore e il produttore sono più importanti dello scrittore e dell'artista.",
+	"mothers_homemakers":                    "Le madri possono avere una carriera, ma il loro primo dovere è occuparsi della casa.",
+	"growth_climate":                        "Quasi tutti i politici promettono crescita economica, ma dovremmo ascoltare gli avvertimenti della scienza del clima secondo cui la crescita danneggia i nostri sforzi per frenare il riscaldamento globale.",
+	"peace_with_establishment":              "Fare pace con l'ordine costituito è un aspetto importante della maturità.",
+	"astrology":                             "L'astrologia spiega con precisione molte cose.",
+	"morality_religion":                     "Non si può essere morali senza essere religiosi.",
+	"charity_over_social_security":          "La beneficenza è meglio della previdenza sociale per aiutare chi è davvero svantaggiato.",
+	"naturally_unlucky":                     "Alcune persone sono sfortunate per natura.",
+	"school_religious_values":               "È importante che la scuola di mio figlio trasmetta valori religiosi.",
+	"sex_outside_marriage":                  "Il sesso fuori dal matrimonio è di solito immorale.",
+	"same_sex_adoption":                     "Una coppia dello stesso sesso con una relazione stabile e affettuosa non dovrebbe essere esclusa dalla possibilità di adottare.",
+	"pornography_legal":                     "La pornografia che ritrae adulti consenzienti dovrebbe essere legale per la popolazione adulta.",
+	"bedroom_privacy":                       "Ciò che accade in una camera da letto privata tra adulti consenzienti non riguarda lo Stato.",
+	"homosexuality_natural":                 "Nessuno può sentirsi omosessuale per natura.",
+	"sex_openness":                          "Oggi l'apertura riguardo al sesso si è spinta troppo oltre.",
+}
@@ -0,0 +1,29 @@
+package politicalcompass
+
+// Page is one of the themed pages the official test presents its propositions on
+type Page struct {
+	Title string
+	Start int // Index in AllQuestions of the page's first question
+	End   int // Index in AllQuestions after the page's last question
+}
+
+// Pages lists the six pages of the official test in order. AllQuestions follows
+// the official ordering, so together the pages cover it exactly.
+var Pages = []Page{
+	{"Country and the world", 0, 7},
+	{"The economy", 7, 21},
+	{"Personal social values", 21, 39},
+	{"Wider society", 39, 51},
+	{"Religion", 51, 56},
+	{"Sex", 56, 62},
+}
+
+// PageOf returns the index in Pages of the page holding a question, or -1
+func PageOf(question int) int {
+	for i, page := range Pages {
+		if question >= page.Start && question < page.End {
+			return i
+		}
+	}
+	return -1
+}
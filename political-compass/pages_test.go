@@ -0,0 +1,27 @@
+package politicalcompass
+
+import "testing"
+
+func TestPagesCoverAllQuestions(t *testing.T) {
+	if len(Pages) != 6 {
+		t.Fatalf("expected the six official pages, got %d", len(Pages))
+	}
+	next := 0
+	for i, page := range Pages {
+		if page.Title == "" || page.Start != next || page.End <= page.Start {
+			t.Errorf("page %d must follow on from the previous page, got %+v", i+1, page)
+		}
+		next = page.End
+	}
+	if next != len(AllQuestions) {
+		t.Errorf("expected the pages to end at question %d, got %d", len(AllQuestions), next)
+	}
+}
+
+func TestPageOf(t *testing.T) {
+	for question, expected := range map[int]int{0: 0, 6: 0, 7: 1, 38: 2, 39: 3, 55: 4, 61: 5, 62: -1, -1: -1} {
+		if got := PageOf(question); got != expected {
+			t.Errorf("PageOf(%d) = %d, expected %d", question, got, expected)
+		}
+	}
+}
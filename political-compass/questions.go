@@ -2,66 +2,66 @@ package politicalcompass
 
 // AllQuestions contains all political compass questions with their scoring data
 var AllQuestions = []Question{
-	{0, [4]float64{7, 5, 0, -2}, [4]float64{0, 0, 0, 0}, "If economic globalisation is inevitable, it should primarily serve humanity rather than the interests of trans-national corporations."},
-	{1, [4]float64{0, 0, 0, 0}, [4]float64{-8, -6, 0, 2}, "I'd always support my country, whether it was right or wrong."},
-	{2, [4]float64{0, 0, 0, 0}, [4]float64{7, 5, 0, -2}, "No one chooses their country of birth, so it's foolish to be proud of it."},
-	{3, [4]float64{0, 0, 0, 0}, [4]float64{-7, -5, 0, 2}, "Our race has many superior qualities, compared with other races."},
-	{4, [4]float64{0, 0, 0, 0}, [4]float64{-7, -5, 0, 2}, "The enemy of my enemy is my friend."},
-	{5, [4]float64{0, 0, 0, 0}, [4]float64{-6, -4, 0, 2}, "Military action that defies international law is sometimes justified."},
-	{6, [4]float64{0, 0, 0, 0}, [4]float64{7, 5, 0, -2}, "There is now a worrying fusion of information and entertainment."},
-	{7, [4]float64{7, 5, 0, -2}, [4]float64{0, 0, 0, 0}, "People are ultimately divided more by class than by nationality."},
-	{8, [4]float64{-7, -5, 0, 2}, [4]float64{0, 0, 0, 0}, "Controlling inflation is more important than controlling unemployment."},
-	{9, [4]float64{6, 4, 0, -2}, [4]float64{0, 0, 0, 0}, "Because corporations cannot be trusted to voluntarily protect the environment, they require regulation."},
-	{10, [4]float64{7, 5, 0, -2}, [4]float64{0, 0, 0, 0}, "\"from each according to his ability, to each according to his need\" is a fundamentally good idea."},
-	{11, [4]float64{-8, -6, 0, 2}, [4]float64{0, 0, 0, 0}, "The freer the market, the freer the people."},
-	{12, [4]float64{8, 6, 0, -2}, [4]float64{0, 0, 0, 0}, "It's a sad reflection on our society that something as basic as drinking water is now a bottled, branded consumer product."},
-	{13, [4]float64{8, 6, 0, -1}, [4]float64{0, 0, 0, 0}, "Land shouldn't be a commodity to be bought and sold."},
-	{14, [4]float64{7, 5, 0, -3}, [4]float64{0, 0, 0, 0}, "It is regrettable that many personal fortunes are made by people who simply manipulate money and contribute nothing to their society."},
-	{15, [4]float64{8, 6, 0, -1}, [4]float64{0, 0, 0, 0}, "Protectionism is sometimes necessary in trade."},
-	{16, [4]float64{-7, -5, 0, 2}, [4]float64{0, 0, 0, 0}, "The only social responsibility of a company should be to deliver a profit to its shareholders."},
-	{17, [4]float64{-7, -5, 0, 1}, [4]float64{0, 0, 0, 0}, "The rich are too highly taxed."},
-	{18, [4]float64{-6, -4, 0, 2}, [4]float64{0, 0, 0, 0}, "Those with the ability to pay should have access to higher standards of medical care."},
-	{19, [4]float64{6, 4, 0, -1}, [4]float64{0, 0, 0, 0}, "Governments should penalise businesses that mislead the public."},
-	{20, [4]float64{0, 0, 0, 0}, [4]float64{0, 0, 0, 0}, "A genuine free market requires restrictions on the ability of predator multinationals to create monopolies."},
-	{21, [4]float64{0, 0, 0, 0}, [4]float64{-6, -4, 0, 2}, "Abortion, when the woman's life is not threatened, should always be illegal."},
-	{22, [4]float64{0, 0, 0, 0}, [4]float64{7, 6, 0, -2}, "All authority should be questioned."},
-	{23, [4]float64{0, 0, 0, 0}, [4]float64{-5, -4, 0, 2}, "An eye for an eye and a tooth for a tooth."},
-	{24, [4]float64{-8, -6, 0, 1}, [4]float64{0, 0, 0, 0}, "Taxpayers should not be expected to prop up any theatres or museums that cannot survive on a commercial basis."},
-	{25, [4]float64{0, 0, 0, 0}, [4]float64{8, 4, 0, -2}, "Schools should not make classroom attendance compulsory."},
-	{26, [4]float64{0, 0, 0, 0}, [4]float64{-7, -5, 0, 2}, "All people have their rights, but it is better for all of us that different sorts of people should keep to their own kind."},
-	{27, [4]float64{0, 0, 0, 0}, [4]float64{-7, -5, 0, 3}, "Good parents sometimes have to spank their children."},
-	{28, [4]float64{0, 0, 0, 0}, [4]float64{6, 4, 0, -3}, "It's natural for children to keep some secrets from their parents."},
-	{29, [4]float64{0, 0, 0, 0}, [4]float64{6, 3, 0, -2}, "Possessing marijuana for personal use should not be a criminal offence."},
-	{30, [4]float64{0, 0, 0, 0}, [4]float64{-7, -5, 0, 3}, "The prime function of schooling should be to equip the future generation to find jobs."},
-	{31, [4]float64{0, 0, 0, 0}, [4]float64{-9, -7, 0, 2}, "People with serious inheritable disabilities should not be allowed to reproduce."},
-	{32, [4]float64{0, 0, 0, 0}, [4]float64{-8, -6, 0, 2}, "The most important thing for children to learn is to accept discipline."},
-	{33, [4]float64{0, 0, 0, 0}, [4]float64{7, 6, 0, -2}, "There are no savage and civilised peoples; there are only different cultures."},
-	{34, [4]float64{0, 0, 0, 0}, [4]float64{-7, -5, 0, 2}, "Those who are able to work, and refuse the opportunity, should not expect society's support."},
-	{35, [4]float64{0, 0, 0, 0}, [4]float64{-6, -4, 0, 2}, "When you are troubled, it's better not to think about it, but to keep busy with more cheerful things."},
-	{36, [4]float64{0, 0, 0, 0}, [4]float64{-7, -4, 0, 2}, "First-generation immigrants can never be fully integrated within their new country."},
-	{37, [4]float64{-10, -8, 0, 1}, [4]float64{0, 0, 0, 0}, "What's good for the most successful corporations is always, ultimately, good for all of us."},
-	{38, [4]float64{-5, -4, 0, 1}, [4]float64{0, 0, 0, 0}, "No broadcasting institution, however independent its content, should receive public funding."},
-	{39, [4]float64{0, 0, 0, 0}, [4]float64{7, 5, 0, -3}, "Our civil liberties are being excessively curbed in the name of counter-terrorism."},
-	{40, [4]float64{0, 0, 0, 0}, [4]float64{-9, -6, 0, 2}, "A significant advantage of a one-party state is that it avoids all the arguments that delay progress in a democratic political system."},
-	{41, [4]float64{0, 0, 0, 0}, [4]float64{-8, -6, 0, 2}, "Although the electronic age makes official surveillance easier, only wrongdoers need to be worried."},
-	{42, [4]float64{0, 0, 0, 0}, [4]float64{-8, -6, 0, 2}, "The death penalty should be an option for the most serious crimes."},
-	{43, [4]float64{0, 0, 0, 0}, [4]float64{-6, -4, 0, 2}, "In a civilised society, one must always have people above to be obeyed and people below to be commanded."},
-	{44, [4]float64{0, 0, 0, 0}, [4]float64{-8, -6, 0, 2}, "Abstract art that doesn't represent anything shouldn't be considered art at all."},
-	{45, [4]float64{0, 0, 0, 0}, [4]float64{-7, -5, 0, 2}, "In criminal justice, punishment should be more important than rehabilitation."},
-	{46, [4]float64{0, 0, 0, 0}, [4]float64{-8, -6, 0, 2}, "It is a waste of time to try to rehabilitate some criminals."},
-	{47, [4]float64{0, 0, 0, 0}, [4]float64{-5, -3, 0, 2}, "The businessperson and the manufacturer are more important than the writer and the artist."},
-	{48, [4]float64{0, 0, 0, 0}, [4]float64{-7, -5, 0, 2}, "Mothers may have careers, but their first duty is to be homemakers."},
-	{49, [4]float64{0, 0, 0, 0}, [4]float64{7, 5, 0, -2}, "Almost all politicians promise economic growth, but we should heed the warnings of climate science that growth is detrimental to our efforts to curb global warming."},
-	{50, [4]float64{0, 0, 0, 0}, [4]float64{-6, -4, 0, 2}, "Making peace with the establishment is an important aspect of maturity."},
-	{51, [4]float64{0, 0, 0, 0}, [4]float64{-7, -5, 0, 2}, "Astrology accurately explains many things."},
-	{52, [4]float64{0, 0, 0, 0}, [4]float64{-6, -4, 0, 2}, "You cannot be moral without being religious."},
-	{53, [4]float64{-9, -8, 0, 1}, [4]float64{0, 0, 0, 0}, "Charity is better than social security as a means of helping the genuinely disadvantaged."},
-	{54, [4]float64{0, 0, 0, 0}, [4]float64{-7, -5, 0, 2}, "Some people are naturally unlucky."},
-	{55, [4]float64{0, 0, 0, 0}, [4]float64{-6, -4, 0, 2}, "It is important that my child's school instills religious values."},
-	{56, [4]float64{0, 0, 0, 0}, [4]float64{-7, -6, 0, 2}, "Sex outside marriage is usually immoral."},
-	{57, [4]float64{0, 0, 0, 0}, [4]float64{7, 6, 0, -2}, "A same sex couple in a stable, loving relationship should not be excluded from the possibility of child adoption."},
-	{58, [4]float64{0, 0, 0, 0}, [4]float64{7, 5, 0, -2}, "Pornography, depicting consenting adults, should be legal for the adult population."},
-	{59, [4]float64{0, 0, 0, 0}, [4]float64{8, 6, 0, -2}, "What goes on in a private bedroom between consenting adults is no business of the state."},
-	{60, [4]float64{0, 0, 0, 0}, [4]float64{-8, -6, 0, 2}, "No one can feel naturally homosexual."},
-	{61, [4]float64{0, 0, 0, 0}, [4]float64{-6, -4, 0, 2}, "These days openness about sex has gone too far."},
+	{0, [4]float64{7, 5, 0, -2}, [4]float64{0, 0, 0, 0}, "globalisation_humanity", []string{"economy", "foreign_policy"}},
+	{1, [4]float64{0, 0, 0, 0}, [4]float64{-8, -6, 0, 2}, "country_right_or_wrong", []string{"nationalism"}},
+	{2, [4]float64{0, 0, 0, 0}, [4]float64{7, 5, 0, -2}, "birth_country_pride", []string{"nationalism"}},
+	{3, [4]float64{0, 0, 0, 0}, [4]float64{-7, -5, 0, 2}, "race_superiority", []string{"race"}},
+	{4, [4]float64{0, 0, 0, 0}, [4]float64{-7, -5, 0, 2}, "enemy_of_enemy", []string{"foreign_policy"}},
+	{5, [4]float64{0, 0, 0, 0}, [4]float64{-6, -4, 0, 2}, "military_international_law", []string{"foreign_policy"}},
+	{6, [4]float64{0, 0, 0, 0}, [4]float64{7, 5, 0, -2}, "infotainment_fusion", []string{"culture"}},
+	{7, [4]float64{7, 5, 0, -2}, [4]float64{0, 0, 0, 0}, "class_over_nationality", []string{"economy"}},
+	{8, [4]float64{-7, -5, 0, 2}, [4]float64{0, 0, 0, 0}, "inflation_over_unemployment", []string{"economy"}},
+	{9, [4]float64{6, 4, 0, -2}, [4]float64{0, 0, 0, 0}, "corporate_environment_regulation", []string{"economy", "environment"}},
+	{10, [4]float64{7, 5, 0, -2}, [4]float64{0, 0, 0, 0}, "ability_need", []string{"economy", "welfare"}},
+	{11, [4]float64{-8, -6, 0, 2}, [4]float64{0, 0, 0, 0}, "free_market_free_people", []string{"economy"}},
+	{12, [4]float64{8, 6, 0, -2}, [4]float64{0, 0, 0, 0}, "bottled_water", []string{"economy"}},
+	{13, [4]float64{8, 6, 0, -1}, [4]float64{0, 0, 0, 0}, "land_commodity", []string{"economy"}},
+	{14, [4]float64{7, 5, 0, -3}, [4]float64{0, 0, 0, 0}, "money_manipulation_fortunes", []string{"economy"}},
+	{15, [4]float64{8, 6, 0, -1}, [4]float64{0, 0, 0, 0}, "protectionism_trade", []string{"economy", "foreign_policy"}},
+	{16, [4]float64{-7, -5, 0, 2}, [4]float64{0, 0, 0, 0}, "company_profit_responsibility", []string{"economy"}},
+	{17, [4]float64{-7, -5, 0, 1}, [4]float64{0, 0, 0, 0}, "rich_overtaxed", []string{"economy"}},
+	{18, [4]float64{-6, -4, 0, 2}, [4]float64{0, 0, 0, 0}, "paid_medical_care", []string{"welfare"}},
+	{19, [4]float64{6, 4, 0, -1}, [4]float64{0, 0, 0, 0}, "penalise_misleading_business", []string{"economy"}},
+	{20, [4]float64{0, 0, 0, 0}, [4]float64{0, 0, 0, 0}, "free_market_monopoly_restrictions", []string{"economy"}},
+	{21, [4]float64{0, 0, 0, 0}, [4]float64{-6, -4, 0, 2}, "abortion_illegal", []string{"gender", "civil_liberties"}},
+	{22, [4]float64{0, 0, 0, 0}, [4]float64{7, 6, 0, -2}, "question_authority", []string{"government"}},
+	{23, [4]float64{0, 0, 0, 0}, [4]float64{-5, -4, 0, 2}, "eye_for_eye", []string{"criminal_justice"}},
+	{24, [4]float64{-8, -6, 0, 1}, [4]float64{0, 0, 0, 0}, "theatre_museum_subsidies", []string{"culture", "economy"}},
+	{25, [4]float64{0, 0, 0, 0}, [4]float64{8, 4, 0, -2}, "school_attendance_optional", []string{"education"}},
+	{26, [4]float64{0, 0, 0, 0}, [4]float64{-7, -5, 0, 2}, "keep_to_own_kind", []string{"race"}},
+	{27, [4]float64{0, 0, 0, 0}, [4]float64{-7, -5, 0, 3}, "spank_children", []string{"family"}},
+	{28, [4]float64{0, 0, 0, 0}, [4]float64{6, 4, 0, -3}, "children_secrets", []string{"family"}},
+	{29, [4]float64{0, 0, 0, 0}, [4]float64{6, 3, 0, -2}, "marijuana_decriminalise", []string{"criminal_justice", "civil_liberties"}},
+	{30, [4]float64{0, 0, 0, 0}, [4]float64{-7, -5, 0, 3}, "schooling_for_jobs", []string{"education"}},
+	{31, [4]float64{0, 0, 0, 0}, [4]float64{-9, -7, 0, 2}, "inheritable_disabilities_reproduction", []string{"family", "civil_liberties"}},
+	{32, [4]float64{0, 0, 0, 0}, [4]float64{-8, -6, 0, 2}, "children_discipline", []string{"family", "education"}},
+	{33, [4]float64{0, 0, 0, 0}, [4]float64{7, 6, 0, -2}, "no_savage_peoples", []string{"race", "culture"}},
+	{34, [4]float64{0, 0, 0, 0}, [4]float64{-7, -5, 0, 2}, "work_refusal_support", []string{"welfare"}},
+	{35, [4]float64{0, 0, 0, 0}, [4]float64{-6, -4, 0, 2}, "troubled_keep_busy", []string{"culture"}},
+	{36, [4]float64{0, 0, 0, 0}, [4]float64{-7, -4, 0, 2}, "immigrant_integration", []string{"immigration"}},
+	{37, [4]float64{-10, -8, 0, 1}, [4]float64{0, 0, 0, 0}, "corporations_good_for_all", []string{"economy"}},
+	{38, [4]float64{-5, -4, 0, 1}, [4]float64{0, 0, 0, 0}, "broadcasting_public_funding", []string{"culture", "economy"}},
+	{39, [4]float64{0, 0, 0, 0}, [4]float64{7, 5, 0, -3}, "counter_terrorism_liberties", []string{"civil_liberties"}},
+	{40, [4]float64{0, 0, 0, 0}, [4]float64{-9, -6, 0, 2}, "one_party_state", []string{"government"}},
+	{41, [4]float64{0, 0, 0, 0}, [4]float64{-8, -6, 0, 2}, "surveillance_wrongdoers", []string{"civil_liberties", "technology"}},
+	{42, [4]float64{0, 0, 0, 0}, [4]float64{-8, -6, 0, 2}, "death_penalty", []string{"criminal_justice"}},
+	{43, [4]float64{0, 0, 0, 0}, [4]float64{-6, -4, 0, 2}, "hierarchy_obedience", []string{"government"}},
+	{44, [4]float64{0, 0, 0, 0}, [4]float64{-8, -6, 0, 2}, "abstract_art", []string{"culture"}},
+	{45, [4]float64{0, 0, 0, 0}, [4]float64{-7, -5, 0, 2}, "punishment_over_rehabilitation", []string{"criminal_justice"}},
+	{46, [4]float64{0, 0, 0, 0}, [4]float64{-8, -6, 0, 2}, "rehabilitation_waste", []string{"criminal_justice"}},
+	{47, [4]float64{0, 0, 0, 0}, [4]float64{-5, -3, 0, 2}, "business_over_artists", []string{"culture", "economy"}},
+	{48, [4]float64{0, 0, 0, 0}, [4]float64{-7, -5, 0, 2}, "mothers_homemakers", []string{"gender", "family"}},
+	{49, [4]float64{0, 0, 0, 0}, [4]float64{7, 5, 0, -2}, "growth_climate", []string{"environment", "economy"}},
+	{50, [4]float64{0, 0, 0, 0}, [4]float64{-6, -4, 0, 2}, "peace_with_establishment", []string{"government"}},
+	{51, [4]float64{0, 0, 0, 0}, [4]float64{-7, -5, 0, 2}, "astrology", []string{"religion"}},
+	{52, [4]float64{0, 0, 0, 0}, [4]float64{-6, -4, 0, 2}, "morality_religion", []string{"religion"}},
+	{53, [4]float64{-9, -8, 0, 1}, [4]float64{0, 0, 0, 0}, "charity_over_social_security", []string{"welfare"}},
+	{54, [4]float64{0, 0, 0, 0}, [4]float64{-7, -5, 0, 2}, "naturally_unlucky", []string{"religion"}},
+	{55, [4]float64{0, 0, 0, 0}, [4]float64{-6, -4, 0, 2}, "school_religious_values", []string{"religion", "education"}},
+	{56, [4]float64{0, 0, 0, 0}, [4]float64{-7, -6, 0, 2}, "sex_outside_marriage", []string{"sexuality", "religion"}},
+	{57, [4]float64{0, 0, 0, 0}, [4]float64{7, 6, 0, -2}, "same_sex_adoption", []string{"sexuality", "family"}},
+	{58, [4]float64{0, 0, 0, 0}, [4]float64{7, 5, 0, -2}, "pornography_legal", []string{"sexuality", "civil_liberties"}},
+	{59, [4]float64{0, 0, 0, 0}, [4]float64{8, 6, 0, -2}, "bedroom_privacy", []string{"sexuality", "civil_liberties"}},
+	{60, [4]float64{0, 0, 0, 0}, [4]float64{-8, -6, 0, 2}, "homosexuality_natural", []string{"sexuality"}},
+	{61, [4]float64{0, 0, 0, 0}, [4]float64{-6, -4, 0, 2}, "sex_openness", []string{"sexuality"}},
 }
@@ -0,0 +1,232 @@
+package politicalcompass
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ReferencePoint is a well-known figure or party placed on the compass, using the
+// chart's axes: positive economic is right, positive social is authoritarian
+type ReferencePoint struct {
+	Name     string  `json:"name"`
+	Economic float64 `json:"economic"`
+	Social   float64 `json:"social"`
+	Color    string  `json:"color,omitempty"`
+	Category string  `json:"category,omitempty"`
+}
+
+// Distance returns the distance from the point to a position on the compass
+func (p ReferencePoint) Distance(economic, social float64) float64 {
+	return math.Hypot(p.Economic-economic, p.Social-social)
+}
+
+// DefaultReferenceColor is used for loaded points that give no colour
+const DefaultReferenceColor = "#495057"
+
+// ReferencePoints are approximate placements of well-known figures and party
+// families, after the charts published on politicalcompass.org. They are meant
+// for context only; load a file for current or local data.
+var ReferencePoints = []ReferencePoint{
+	{Name: "Joseph Stalin", Economic: -9, Social: 8.5, Color: "#b71c1c", Category: "figure"},
+	{Name: "Margaret Thatcher", Economic: 6.5, Social: 6, Color: "#1565c0", Category: "figure"},
+	{Name: "Milton Friedman", Economic: 7, Social: -4.5, Color: "#f9a825", Category: "figure"},
+	{Name: "Friedrich Hayek", Economic: 7.5, Social: -5.5, Color: "#ef6c00", Category: "figure"},
+	{Name: "Mahatma Gandhi", Economic: -6.5, Social: -5.5, Color: "#2e7d32", Category: "figure"},
+	{Name: "Nelson Mandela", Economic: -4.5, Social: -3, Color: "#6a1b9a", Category: "figure"},
+	{Name: "Dalai Lama", Economic: -3.5, Social: -4, Color: "#8d6e63", Category: "figure"},
+	{Name: "Communist parties", Economic: -8, Social: 5, Color: "#c62828", Category: "party"},
+	{Name: "Green parties", Economic: -6, Social: -5, Color: "#43a047", Category: "party"},
+	{Name: "Social democratic parties", Economic: -1.5, Social: 2.5, Color: "#e53935", Category: "party"},
+	{Name: "Liberal parties", Economic: 3.5, Social: 2, Color: "#fbc02d", Category: "party"},
+	{Name: "Conservative parties", Economic: 7, Social: 6, Color: "#1e88e5", Category: "party"},
+	{Name: "Libertarian parties", Economic: 8.5, Social: -5, Color: "#ff9800", Category: "party"},
+	{Name: "Nationalist parties", Economic: 6, Social: 8.5, Color: "#5d4037", Category: "party"},
+}
+
+// referenceColorPattern matches the #rgb and #rrggbb colours accepted in reference files
+var referenceColorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// LoadReferencePoints reads a JSON array of reference points, checking that each
+// has a name, lies on the compass and has a valid colour
+func LoadReferencePoints(r io.Reader) ([]ReferencePoint, error) {
+	var points []ReferencePoint
+	if err := json.NewDecoder(r).Decode(&points); err != nil {
+		return nil, fmt.Errorf("invalid reference points: %v", err)
+	}
+	seen := map[string]bool{}
+	for i := range points {
+		p := &points[i]
+		p.Name = strings.TrimSpace(p.Name)
+		if p.Name == "" {
+			return nil, fmt.Errorf("invalid reference point %d: name is required", i)
+		}
+		if seen[strings.ToLower(p.Name)] {
+			return nil, fmt.Errorf("invalid reference point %q: listed twice", p.Name)
+		}
+		seen[strings.ToLower(p.Name)] = true
+		if math.Abs(p.Economic) > 10 || math.Abs(p.Social) > 10 || math.IsNaN(p.Economic) || math.IsNaN(p.Social) {
+			return nil, fmt.Errorf("invalid reference point %q: economic and social must be between -10 and 10", p.Name)
+		}
+		if p.Color == "" {
+			p.Color = DefaultReferenceColor
+		}
+		if !referenceColorPattern.MatchString(p.Color) {
+			return nil, fmt.Errorf("invalid reference point %q: color must be #rgb or #rrggbb", p.Name)
+		}
+	}
+	return points, nil
+}
+
+// MergeReferencePoints adds extra points to a base set; an extra point replaces a
+// base point of the same name (case-insensitively)
+func MergeReferencePoints(base, extra []ReferencePoint) []ReferencePoint {
+	replaced := map[string]ReferencePoint{}
+	for _, p := range extra {
+		replaced[strings.ToLower(p.Name)] = p
+	}
+	var merged []ReferencePoint
+	for _, p := range base {
+		if replacement, ok := replaced[strings.ToLower(p.Name)]; ok {
+			merged = append(merged, replacement)
+			delete(replaced, strings.ToLower(p.Name))
+			continue
+		}
+		merged = append(merged, p)
+	}
+	for _, p := range extra {
+		if _, ok := replaced[strings.ToLower(p.Name)]; ok {
+			merged = append(merged, p)
+		}
+	}
+	return merged
+}
+
+// NearestReferencePoints returns up to n points closest to a position, nearest first
+func NearestReferencePoints(points []ReferencePoint, economic, social float64, n int) []ReferencePoint {
+	sorted := append([]ReferencePoint(nil), points...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Distance(economic, social) < sorted[j].Distance(economic, social)
+	})
+	if n < len(sorted) {
+		sorted = sorted[:n]
+	}
+	return sorted
+}
+
+// labelBox is the area taken by a label or marker on the chart
+type labelBox struct {
+	x1, y1, x2, y2 float64
+}
+
+// overlap returns the area shared by two boxes
+func (b labelBox) overlap(o labelBox) float64 {
+	w := math.Min(b.x2, o.x2) - math.Max(b.x1, o.x1)
+	h := math.Min(b.y2, o.y2) - math.Max(b.y1, o.y1)
+	if w <= 0 || h <= 0 {
+		return 0
+	}
+	return w * h
+}
+
+// Reference labels are 9px Arial; the width is estimated per character
+const (
+	referenceFontSize  = 9.0
+	referenceCharWidth = 5.0
+	referenceMarkerR   = 4.0
+)
+
+// referenceLabel is a placed label: its anchor point and text alignment
+type referenceLabel struct {
+	x, y   float64
+	anchor string
+}
+
+// placeReferenceLabels picks a label position for each marker, trying the spots
+// around it in turn (right, left, above, below, the diagonals, then further out)
+// and keeping the first that overlaps no marker or earlier label and stays on the
+// chart. When every spot collides, the least overlapping one is used.
+func placeReferenceLabels(markers [][2]float64, names []string, occupied []labelBox, width, height float64) []referenceLabel {
+	for _, m := range markers {
+		occupied = append(occupied, labelBox{m[0] - referenceMarkerR, m[1] - referenceMarkerR, m[0] + referenceMarkerR, m[1] + referenceMarkerR})
+	}
+
+	labels := make([]referenceLabel, len(markers))
+	for i, m := range markers {
+		w := referenceCharWidth * float64(len([]rune(names[i])))
+		h := referenceFontSize + 1
+		gap := referenceMarkerR + 2
+		x, y := m[0], m[1]
+		// Candidate baselines: text sits from baseline-fontSize to baseline+1
+		candidates := []referenceLabel{
+			{x + gap, y + 3, "start"}, {x - gap, y + 3, "end"},
+			{x, y - gap - 1, "middle"}, {x, y + gap + h - 1, "middle"},
+			{x + gap, y - gap, "start"}, {x - gap, y - gap, "end"},
+			{x + gap, y + gap + h - 1, "start"}, {x - gap, y + gap + h - 1, "end"},
+			{x + gap, y + 3 - h - gap, "start"}, {x - gap, y + 3 - h - gap, "end"},
+			{x + gap, y + 3 + h + gap, "start"}, {x - gap, y + 3 + h + gap, "end"},
+			{x, y - gap - h - 2, "middle"}, {x, y + gap + 2*h + 1, "middle"},
+		}
+
+		best, bestOverlap := 0, math.Inf(1)
+		var bestBox labelBox
+		for c, candidate := range candidates {
+			b := labelBox{candidate.x, candidate.y - referenceFontSize, candidate.x + w, candidate.y + 1}
+			switch candidate.anchor {
+			case "end":
+				b.x1, b.x2 = b.x1-w, b.x2-w
+			case "middle":
+				b.x1, b.x2 = b.x1-w/2, b.x2-w/2
+			}
+			total := 0.0
+			if b.x1 < 0 || b.y1 < 0 || b.x2 > width || b.y2 > height {
+				total += w * h
+			}
+			for _, o := range occupied {
+				total += b.overlap(o)
+			}
+			if total < bestOverlap {
+				best, bestOverlap, bestBox = c, total, b
+			}
+			if total == 0 {
+				break
+			}
+		}
+		labels[i] = candidates[best]
+		occupied = append(occupied, bestBox)
+	}
+	return labels
+}
+
+// referenceOverlay draws the reference points and their labels
+func referenceOverlay(points []ReferencePoint, toX, toY func(float64) float64, occupied []labelBox, width, height float64) string {
+	if len(points) == 0 {
+		return ""
+	}
+	markers := make([][2]float64, len(points))
+	names := make([]string, len(points))
+	for i, p := range points {
+		markers[i] = [2]float64{toX(p.Economic), toY(p.Social)}
+		names[i] = p.Name
+	}
+	labels := placeReferenceLabels(markers, names, occupied, width, height)
+
+	var b strings.Builder
+	for i, p := range points {
+		color := p.Color
+		if !referenceColorPattern.MatchString(color) {
+			color = DefaultReferenceColor
+		}
+		fmt.Fprintf(&b, `
+  <circle cx="%.1f" cy="%.1f" r="%g" fill="%s" stroke="#ffffff" stroke-width="1"/>
+  <text x="%.1f" y="%.1f" text-anchor="%s" font-family="Arial, sans-serif" font-size="%g" fill="#343a40">%s</text>`,
+			markers[i][0], markers[i][1], referenceMarkerR, color,
+			labels[i].x, labels[i].y, labels[i].anchor, referenceFontSize, html.EscapeString(p.Name))
+	}
+	return b.String()
+}
@@ -0,0 +1,146 @@
+package politicalcompass
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReferencePointsValid(t *testing.T) {
+	names := map[string]bool{}
+	for _, p := range ReferencePoints {
+		if p.Name == "" || names[p.Name] {
+			t.Errorf("reference point names must be unique and non-empty, got %q", p.Name)
+		}
+		names[p.Name] = true
+		if p.Economic < -10 || p.Economic > 10 || p.Social < -10 || p.Social > 10 {
+			t.Errorf("%s lies off the compass", p.Name)
+		}
+		if !referenceColorPattern.MatchString(p.Color) || (p.Category != "figure" && p.Category != "party") {
+			t.Errorf("%s has an invalid colour or category", p.Name)
+		}
+	}
+}
+
+func TestLoadReferencePoints(t *testing.T) {
+	points, err := LoadReferencePoints(strings.NewReader(`[
+		{"name": " Local Party ", "economic": -2, "social": 1.5, "category": "party"},
+		{"name": "Mahatma Gandhi", "economic": -6, "social": -6, "color": "#0a0"}
+	]`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if points[0].Name != "Local Party" || points[0].Color != DefaultReferenceColor {
+		t.Errorf("expected a trimmed name and the default colour, got %+v", points[0])
+	}
+
+	merged := MergeReferencePoints(ReferencePoints, points)
+	if len(merged) != len(ReferencePoints)+1 || merged[len(merged)-1].Name != "Local Party" {
+		t.Errorf("expected the new point to be appended, got %d points", len(merged))
+	}
+	for _, p := range merged {
+		if p.Name == "Mahatma Gandhi" && p.Economic != -6 {
+			t.Errorf("expected the loaded point to replace the built-in one, got %+v", p)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		data     string
+		expected string
+	}{
+		{"not json", `{`, "invalid reference points"},
+		{"no name", `[{"economic": 1, "social": 1}]`, "name is required"},
+		{"listed twice", `[{"name": "X", "economic": 1, "social": 0}, {"name": " x ", "economic": 2, "social": 0}]`, "listed twice"},
+		{"off the compass", `[{"name": "X", "economic": 11, "social": 0}]`, "between -10 and 10"},
+		{"bad colour", `[{"name": "X", "economic": 1, "social": 0, "color": "red\" onload=\"x"}]`, "color must be"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := LoadReferencePoints(strings.NewReader(tt.data)); err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("expected error containing %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestNearestReferencePoints(t *testing.T) {
+	nearest := NearestReferencePoints(ReferencePoints, 7, 6, 3)
+	if len(nearest) != 3 || nearest[0].Name != "Conservative parties" || nearest[1].Name != "Margaret Thatcher" {
+		t.Errorf("unexpected nearest points %+v", nearest)
+	}
+	if len(NearestReferencePoints(ReferencePoints[:2], 0, 0, 5)) != 2 {
+		t.Error("expected every point when asking for more than there are")
+	}
+}
+
+// overlappingLabels returns the names of placed labels that overlap an earlier label
+func overlappingLabels(labels []referenceLabel, names []string) []string {
+	var boxes []labelBox
+	var overlapping []string
+	for i, label := range labels {
+		w := referenceCharWidth * float64(len(names[i]))
+		x1 := label.x
+		switch label.anchor {
+		case "end":
+			x1 -= w
+		case "middle":
+			x1 -= w / 2
+		}
+		box := labelBox{x1, label.y - referenceFontSize, x1 + w, label.y}
+		for _, other := range boxes {
+			if box.overlap(other) > 0 {
+				overlapping = append(overlapping, names[i])
+				break
+			}
+		}
+		boxes = append(boxes, box)
+	}
+	return overlapping
+}
+
+func TestPlaceReferenceLabelsAvoidsCollisions(t *testing.T) {
+	// Three markers close together, as for Thatcher and conservative parties
+	markers := [][2]float64{{200, 200}, {204, 200}, {200, 206}}
+	names := []string{"First point", "Second point", "Third point"}
+	labels := placeReferenceLabels(markers, names, nil, 400, 400)
+	if overlapping := overlappingLabels(labels, names); len(overlapping) > 0 {
+		t.Errorf("labels %v overlap: %+v", overlapping, labels)
+	}
+
+	// Every built-in point fits on the chart at once
+	markers, names = nil, nil
+	for _, p := range ReferencePoints {
+		markers = append(markers, [2]float64{200 + p.Economic*15, 200 - p.Social*15})
+		names = append(names, p.Name)
+	}
+	labels = placeReferenceLabels(markers, names, nil, 400, 400)
+	if overlapping := overlappingLabels(labels, names); len(overlapping) > 0 {
+		t.Errorf("built-in labels %v overlap: %+v", overlapping, labels)
+	}
+
+	// A label by the right edge is moved to the left of its marker
+	edge := placeReferenceLabels([][2]float64{{395, 100}}, []string{"Edge"}, nil, 400, 400)
+	if edge[0].anchor == "start" {
+		t.Errorf("expected the label to stay on the chart, got %+v", edge[0])
+	}
+}
+
+func TestGenerateSVGReferencePoints(t *testing.T) {
+	if strings.Contains(GenerateSVG(1, 1), "font-size=\"9\"") {
+		t.Error("SVG without reference points should not draw any")
+	}
+	points := []ReferencePoint{
+		{Name: "A & B", Economic: 5, Social: 5, Color: "#123456"},
+		{Name: "Off", Economic: -10, Social: -10, Color: "not a colour"},
+	}
+	svg := GenerateSVGWithOptions(1, 1, SVGOptions{ReferencePoints: points})
+	if !strings.Contains(svg, `<circle cx="275.0" cy="125.0" r="4" fill="#123456"`) {
+		t.Error("SVG should place the reference point on the chart")
+	}
+	if !strings.Contains(svg, ">A &amp; B</text>") {
+		t.Error("SVG should escape reference point names")
+	}
+	if !strings.Contains(svg, `fill="`+DefaultReferenceColor+`"`) || strings.Contains(svg, "not a colour") {
+		t.Error("SVG should replace invalid colours")
+	}
+}
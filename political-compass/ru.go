@@ -0,0 +1,81 @@
+package politicalcompass
+
+// RUCopy contains Russian translations for general UI elements
+var RUCopy = map[string]string{
+	"start_test":      "Начать тест",
+	"question_x_of_n": "Вопрос {x} из {n}",
+	"back_home":       "Вернуться на главную",
+	"prev_question":   "Вернуться к предыдущему вопросу",
+	"strong_disagree": "Полностью не согласен",
+	"disagree":        "Не согласен",
+	"neutral":         "Нейтрально",
+	"agree":           "Согласен",
+	"strong_agree":    "Полностью согласен",
+	"result":          "Результат",
+}
+
+// RUQuestions contains Russian translations for all political compass questions
+var RUQuestions = map[string]string{
+	"globalisation_humanity":                "Если экономическая глобализация неизбежна, она должна прежде всего служить человечеству, а не интересам транснациональных корпораций.",
+	"country_right_or_wrong":                "Я всегда поддерживал бы свою страну, права она или нет.",
+	"birth_country_pride":                   "Никто не выбирает страну своего рождения, поэтому глупо ею гордиться.",
+	"race_superiority":                      "Наша раса обладает многими превосходными качествами по сравнению с другими расами.",
+	"enemy_of_enemy":                        "Враг моего врага — мой друг.",
+	"military_international_law":            "Военные действия в нарушение международного права иногда оправданы.",
+	"infotainment_fusion":                   "Сейчас происходит тревожное слияние информации и развлечений.",
+	"class_over_nationality":                "В конечном счёте людей разделяет скорее класс, чем национальность.",
+	"inflation_over_unemployment":           "Сдерживать инфляцию важнее, чем сдерживать безработицу.",
+	"corporate_environment_regulation":      "Поскольку нельзя рассчитывать, что корпорации добровольно будут защищать окружающую среду, их нужно регулировать.",
+	"ability_need":                          "«От каждого по способностям, каждому по потребностям» — в своей основе хорошая идея.",
+	"free_market_free_people":               "Чем свободнее рынок, тем свободнее люди.",
+	"bottled_water":                         "Печально, что в нашем обществе нечто столь базовое, как питьевая вода, превратилось в бутилированный брендовый товар.",
+	"land_commodity":                        "Земля не должна быть товаром, который покупают и продают.",
+	"money_manipulation_fortunes":           "Прискорбно, что многие личные состояния сколачивают люди, которые лишь манипулируют деньгами и ничего не дают обществу.",
+	"protectionism_trade":                   "Протекционизм в торговле иногда необходим.",
+	"company_profit_responsibility":         "Единственной социальной обязанностью компании должно быть получение прибыли для акционеров.",
+	"rich_overtaxed":                        "Богатые платят слишком высокие налоги.",
+	"paid_medical_care":                     "Те, кто может заплатить, должны иметь доступ к более качественной медицинской помощи.",
+	"penalise_misleading_business":          "Правительства должны наказывать компании, вводящие общество в заблуждение.",
+	"free_market_monopoly_restrictions":     "Подлинно свободный рынок требует ограничить возможности хищных транснациональных компаний создавать монополии.",
+	"abortion_illegal":                      "Аборт, если жизни женщины ничто не угрожает, всегда должен быть незаконным.",
+	"question_authority":                    "Любую власть следует подвергать сомнению.",
+	"eye_for_eye":                           "Око за око, зуб за зуб.",
+	"theatre_museum_subsidies":              "Налогоплательщики не должны содержать театры или музеи, которые не могут выжить на коммерческой основе.",
+	"school_attendance_optional":            "Школы не должны делать посещение уроков обязательным.",
+	"keep_to_own_kind":                      "У всех людей есть права, но для всех лучше, чтобы разные люди держались среди себе подобных.",
+	"spank_children":                        "Хорошим родителям иногда приходится шлёпать своих детей.",
+	"children_secrets":                      "Для детей естественно иметь секреты от родителей.",
+	"marijuana_decriminalise":               "Хранение марихуаны для личного употребления не должно быть уголовным преступлением.",
+	"schooling_for_jobs":                    "Главная задача школы — подготовить будущее поколение к поиску работы.",
+	"inheritable_disabilities_reproduction": "Людям с тяжёлыми наследственными заболеваниями не следует разрешать иметь детей.",
+	"children_discipline":                   "Самое важное, чему должны научиться дети, — это принимать дисциплину.",
+	"no_savage_peoples":                     "Нет диких и цивилизованных народов — есть только разные культуры.",
+	"work_refusal_support":                  "Те, кто может работать, но отказывается, не должны рассчитывать на поддержку общества.",
+	"troubled_keep_busy":                    "Когда вас что-то тревожит, лучше не думать об этом, а занять себя чем-нибудь повеселее.",
+	"immigrant_integration":                 "Иммигранты первого поколения никогда не смогут полностью интегрироваться в новой стране.",
+	"corporations_good_for_all":             "То, что хорошо для самых успешных корпораций, в конечном счёте всегда хорошо для всех нас.",
+	"broadcasting_public_funding":           "Ни одна телерадиокомпания, каким бы независимым ни было её содержание, не должна получать государственное финансирование.",
+	"counter_terrorism_liberties":           "Наши гражданские свободы чрезмерно ограничиваются во имя борьбы с терроризмом.",
+	"one_party_state":                       "Важное преимущество однопартийного государства в том, что оно избегает споров, тормозящих прогресс в демократической системе.",
+	"surveillance_wrongdoers":               "Хотя электронная эпоха облегчает государственную слежку, беспокоиться нужно только нарушителям.",
+	"death_penalty":                         "Смертная казнь должна применяться за самые тяжкие преступления.",
+	"hierarchy_obedience":                   "В цивилизованном обществе всегда должны быть те, кому подчиняются, и те, кем командуют.",
+	"abstract_art":                          "Абстрактное искусство, которое ничего не изображает, вообще не следует считать искусством.",
+	"punishment_over_rehabilitation":        "В уголовном правосудии наказание должно быть важнее исправления.",
+	"rehabilitation_waste":                  "Пытаться исправить некоторых преступников — пустая трата времени.",
+	"business_over_artists":                 "Предприниматель и промышленник важнее писателя и художника.",
+	"mothers_homemakers":                    "Матери могут делать карьеру, но их первейшая обязанность — вести домашнее хозяйство.",
+	"growth_climate":                        "Почти все политики обещают экономический рост, но нам следует прислушаться к предупреждениям климатологов о том, что рост мешает борьбе с глобальным потеплением.",
+	"peace_with_establishment":              "Примирение с истеблишментом — важный признак зрелости.",
+	"astrology":                             "Астрология точно объясняет многие вещи.",
+	"morality_religion":                     "Нельзя быть нравственным, не будучи религиозным.",
+	"charity_over_social_security":          "Благотворительность лучше социального обеспечения помогает по-настоящему обездоленным.",
+	"naturally_unlucky":                     "Некоторым людям от природы не везёт.",
+	"school_religious_values":               "Важно, чтобы школа моего ребёнка прививала религиозные ценности.",
+	"sex_outside_marriage":                  "Секс вне брака обычно аморален.",
+	"same_sex_adoption":                     "Однополую пару в стабильных любящих отношениях не следует лишать возможности усыновить ребёнка.",
+	"pornography_legal":                     "Порнография с участием совершеннолетних по взаимному согласию должна быть легальной для взрослых.",
+	"bedroom_privacy":                       "То, что происходит в спальне между взрослыми по взаимному согласию, государства не касается.",
+	"homosexuality_natural":                 "Никто не может чувствовать себя гомосексуалом от природы.",
+	"sex_openness":                          "В наши дни открытость в вопросах секса зашла слишком далеко.",
+}
@@ -0,0 +1,81 @@
+package politicalcompass
+
+// ZHCopy contains Chinese translations for general UI elements
+var ZHCopy = map[string]string{
+	"start_test":      "开始测试",
+	"question_x_of_n": "第 {x} 题，共 {n} 题",
+	"back_home":       "返回首页",
+	"prev_question":   "返回上一题",
+	"strong_disagree": "非常不同意",
+	"disagree":        "不同意",
+	"neutral":         "中立",
+	"agree":           "同意",
+	"strong_agree":    "非常同意",
+	"result":          "结果",
+}
+
+// ZHQuestions contains Chinese translations for all political compass questions
+var ZHQuestions = map[string]string{
+	"globalisation_humanity":                "如果经济全球化不可避免，它首先应当服务于全人类，而不是跨国公司的利益。",
+	"country_right_or_wrong":                "无论对错，我都会支持我的国家。",
+	"birth_country_pride":                   "没有人能选择自己的出生国，所以为此感到骄傲是愚蠢的。",
+	"race_superiority":                      "与其他种族相比，我们的种族有许多优越的品质。",
+	"enemy_of_enemy":                        "敌人的敌人就是朋友。",
+	"military_international_law":            "违反国际法的军事行动有时是正当的。",
+	"infotainment_fusion":                   "如今信息与娱乐的融合令人担忧。",
+	"class_over_nationality":                "归根结底，人们因阶级而产生的分歧大于因国籍产生的分歧。",
+	"inflation_over_unemployment":           "控制通货膨胀比控制失业更重要。",
+	"corporate_environment_regulation":      "由于不能指望企业自愿保护环境，因此需要对它们进行监管。",
+	"ability_need":                          "“各尽所能，按需分配”从根本上说是个好主意。",
+	"free_market_free_people":               "市场越自由，人民就越自由。",
+	"bottled_water":                         "像饮用水这样基本的东西如今竟成了瓶装的品牌消费品，这是我们社会的悲哀。",
+	"land_commodity":                        "土地不应该是可以买卖的商品。",
+	"money_manipulation_fortunes":           "令人遗憾的是，许多个人财富是由那些只会操纵金钱、对社会毫无贡献的人积累的。",
+	"protectionism_trade":                   "在贸易中，保护主义有时是必要的。",
+	"company_profit_responsibility":         "企业唯一的社会责任应该是为股东创造利润。",
+	"rich_overtaxed":                        "富人的税负太重了。",
+	"paid_medical_care":                     "有支付能力的人应当享有更高水平的医疗服务。",
+	"penalise_misleading_business":          "政府应当惩罚误导公众的企业。",
+	"free_market_monopoly_restrictions":     "真正的自由市场需要限制掠夺性跨国公司形成垄断的能力。",
+	"abortion_illegal":                      "在妇女生命不受威胁的情况下，堕胎应一律被视为非法。",
+	"question_authority":                    "一切权威都应受到质疑。",
+	"eye_for_eye":                           "以眼还眼，以牙还牙。",
+	"theatre_museum_subsidies":              "不应指望纳税人去扶持那些无法靠商业运营生存的剧院或博物馆。",
+	"school_attendance_optional":            "学校不应强制要求学生到课。",
+	"keep_to_own_kind":                      "人人都有自己的权利，但不同类型的人最好各自与同类相处，这对大家都好。",
+	"spank_children":                        "好父母有时也不得不打孩子屁股。",
+	"children_secrets":                      "孩子对父母保守一些秘密是很自然的。",
+	"marijuana_decriminalise":               "持有供个人使用的大麻不应构成刑事犯罪。",
+	"schooling_for_jobs":                    "学校教育的首要功能应是让下一代具备就业能力。",
+	"inheritable_disabilities_reproduction": "患有严重遗传性残疾的人不应被允许生育。",
+	"children_discipline":                   "孩子们要学的最重要的一课是接受纪律。",
+	"no_savage_peoples":                     "没有野蛮民族和文明民族之分，只有不同的文化。",
+	"work_refusal_support":                  "有工作能力却拒绝工作机会的人，不应指望得到社会的支持。",
+	"troubled_keep_busy":                    "遇到烦恼时，最好不去想它，而是忙些更开心的事情。",
+	"immigrant_integration":                 "第一代移民永远无法完全融入他们的新国家。",
+	"corporations_good_for_all":             "对最成功的企业有利的事情，最终总是对我们所有人都有利。",
+	"broadcasting_public_funding":           "任何广播机构，无论其内容多么独立，都不应获得公共资金。",
+	"counter_terrorism_liberties":           "以反恐为名，我们的公民自由正受到过度限制。",
+	"one_party_state":                       "一党制国家的一大优势是避免了民主政治体制中拖延进步的种种争论。",
+	"surveillance_wrongdoers":               "尽管电子时代使官方监控变得更容易，但只有做坏事的人才需要担心。",
+	"death_penalty":                         "对于最严重的罪行，死刑应当是一种选择。",
+	"hierarchy_obedience":                   "在文明社会中，总要有上级让人服从，也要有下级供人指挥。",
+	"abstract_art":                          "不表现任何事物的抽象艺术根本不应被视为艺术。",
+	"punishment_over_rehabilitation":        "在刑事司法中，惩罚应比改造更重要。",
+	"rehabilitation_waste":                  "试图改造某些罪犯是浪费时间。",
+	"business_over_artists":                 "商人和制造商比作家和艺术家更重要。",
+	"mothers_homemakers":                    "母亲可以有自己的事业，但她们的首要职责是操持家务。",
+	"growth_climate":                        "几乎所有政治家都承诺经济增长，但我们应当重视气候科学的警告：增长不利于我们遏制全球变暖的努力。",
+	"peace_with_establishment":              "与既有体制和解是成熟的重要表现。",
+	"astrology":                             "占星术能准确解释许多事情。",
+	"morality_religion":                     "不信宗教就不可能有道德。",
+	"charity_over_social_security":          "作为帮助真正弱势群体的手段，慈善比社会保障更好。",
+	"naturally_unlucky":                     "有些人天生运气不好。",
+	"school_religious_values":               "我孩子的学校灌输宗教价值观是很重要的。",
+	"sex_outside_marriage":                  "婚外性行为通常是不道德的。",
+	"same_sex_adoption":                     "处于稳定、相爱关系中的同性伴侣不应被排除在领养子女的可能性之外。",
+	"pornography_legal":                     "描绘自愿成年人的色情作品对成年人应当是合法的。",
+	"bedroom_privacy":                       "自愿成年人在私人卧室里发生的事与国家无关。",
+	"homosexuality_natural":                 "没有人会天生觉得自己是同性恋。",
+	"sex_openness":                          "如今对性的开放程度已经过头了。",
+}
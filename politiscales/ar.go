@@ -14,6 +14,96 @@ var ARCopy = map[string]string{
 	"result":          "النتيجة",
 }
 
+// ARResultsCopy contains Arabic translations for the results chart
+var ARResultsCopy = map[string]string{
+	"results_title":                    "نتائج PolitiScales",
+	"political_identity":               "الهوية السياسية",
+	"additional_characteristics":       "خصائص إضافية",
+	"political_moderate":               "معتدل سياسيًا",
+	"axis_constructivism":              "البنائية",
+	"axis_essentialism":                "الجوهرانية",
+	"axis_rehabilitative_justice":      "العدالة التأهيلية",
+	"axis_punitive_justice":            "العدالة العقابية",
+	"axis_progressive":                 "التقدمية",
+	"axis_conservative":                "المحافظة",
+	"axis_internationalism":            "الأممية",
+	"axis_nationalism":                 "القومية",
+	"axis_communism":                   "الشيوعية",
+	"axis_capitalism":                  "الرأسمالية",
+	"axis_regulation":                  "التنظيم",
+	"axis_laissez_faire":               "عدم التدخل",
+	"axis_ecology":                     "البيئة",
+	"axis_production":                  "الإنتاج",
+	"axis_revolution":                  "الثورة",
+	"axis_reform":                      "الإصلاح",
+	"axis_anarchism":                   "اللاسلطوية",
+	"axis_pragmatism":                  "البراغماتية",
+	"axis_feminism":                    "النسوية",
+	"axis_complotism":                  "نظرية المؤامرة",
+	"axis_veganism":                    "النباتية",
+	"axis_monarchism":                  "الملكية",
+	"axis_religion":                    "الدين",
+	"badge_anarchism":                  "لاسلطوي",
+	"badge_pragmatism":                 "براغماتي",
+	"badge_feminism":                   "نسوي",
+	"badge_complotism":                 "مؤمن بالمؤامرة",
+	"badge_veganism":                   "نباتي صرف",
+	"badge_monarchism":                 "ملكي",
+	"badge_religion":                   "مبشّر",
+	"slogan_constructivism":            "باني المجتمع",
+	"slogan_essentialism":              "النظام الطبيعي",
+	"slogan_rehabilitative_justice":    "العدالة التصالحية",
+	"slogan_punitive_justice":          "القانون والنظام",
+	"slogan_progressive":               "التفكير المستقبلي",
+	"slogan_conservative":              "القيم التقليدية",
+	"slogan_internationalism":          "مواطن عالمي",
+	"slogan_nationalism":               "الأمة أولًا",
+	"slogan_communism":                 "عمال متحدون",
+	"slogan_capitalism":                "أسواق حرة",
+	"slogan_regulation":                "اقتصاد موجَّه",
+	"slogan_laissez_faire":             "حرية السوق",
+	"slogan_ecology":                   "مستقبل أخضر",
+	"slogan_production":                "التقدم أولًا",
+	"slogan_revolution":                "تغيير جذري",
+	"slogan_reform":                    "تقدم تدريجي",
+	"slogan_anarchism":                 "لا آلهة ولا أسياد",
+	"slogan_pragmatism":                "حلول عملية",
+	"slogan_feminism":                  "المساواة بين الجنسين",
+	"slogan_complotism":                "شكّك في كل شيء",
+	"slogan_veganism":                  "حقوق الحيوان",
+	"slogan_monarchism":                "التقاليد الملكية",
+	"slogan_religion":                  "مؤمن مخلص",
+	"identity_format":                  "{noun} {adjective}",
+	"adjective_constructivism":         "بنائي",
+	"adjective_essentialism":           "جوهراني",
+	"adjective_rehabilitative_justice": "إنساني",
+	"adjective_punitive_justice":       "سلطوي",
+	"adjective_progressive":            "تقدمي",
+	"adjective_conservative":           "محافظ",
+	"adjective_internationalism":       "أممي",
+	"adjective_nationalism":            "قومي",
+	"adjective_communism":              "جماعي",
+	"adjective_capitalism":             "رأسمالي",
+	"adjective_regulation":             "تدخلي",
+	"adjective_laissez_faire":          "ليبرالي",
+	"adjective_ecology":                "بيئي",
+	"adjective_production":             "إنتاجي",
+	"adjective_revolution":             "ثوري",
+	"adjective_reform":                 "إصلاحي",
+	"adjective_anarchism":              "لاسلطوي",
+	"adjective_pragmatism":             "براغماتي",
+	"adjective_feminism":               "نسوي",
+	"adjective_complotism":             "مؤامراتي",
+	"adjective_veganism":               "نباتي",
+	"adjective_monarchism":             "ملكي",
+	"adjective_religion":               "متدين",
+	"noun_communism":                   "اشتراكي",
+	"noun_capitalism":                  "رأسمالي",
+	"noun_regulation":                  "ديمقراطي اجتماعي",
+	"noun_laissez_faire":               "تحرري",
+	"noun_moderate":                    "معتدل",
+}
+
 // ARQuestions contains Arabic translations for all politiscales questions
 var ARQuestions = map[string]string{
 	"constructivism_becoming_woman":               "“المرأة لا تولد امرأة، بل تُصبح كذلك.”",
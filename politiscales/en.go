@@ -14,6 +14,96 @@ var ENCopy = map[string]string{
 	"result":          "Result",
 }
 
+// ENResultsCopy contains English translations for the results chart
+var ENResultsCopy = map[string]string{
+	"results_title":                    "PolitiScales Results",
+	"political_identity":               "Political Identity",
+	"additional_characteristics":       "Additional Characteristics",
+	"political_moderate":               "Political Moderate",
+	"axis_constructivism":              "Constructivism",
+	"axis_essentialism":                "Essentialism",
+	"axis_rehabilitative_justice":      "Rehabilitative Justice",
+	"axis_punitive_justice":            "Punitive Justice",
+	"axis_progressive":                 "Progressive",
+	"axis_conservative":                "Conservative",
+	"axis_internationalism":            "Internationalism",
+	"axis_nationalism":                 "Nationalism",
+	"axis_communism":                   "Communism",
+	"axis_capitalism":                  "Capitalism",
+	"axis_regulation":                  "Regulation",
+	"axis_laissez_faire":               "Laissez-faire",
+	"axis_ecology":                     "Ecology",
+	"axis_production":                  "Production",
+	"axis_revolution":                  "Revolution",
+	"axis_reform":                      "Reform",
+	"axis_anarchism":                   "Anarchism",
+	"axis_pragmatism":                  "Pragmatism",
+	"axis_feminism":                    "Feminism",
+	"axis_complotism":                  "Conspiracism",
+	"axis_veganism":                    "Veganism",
+	"axis_monarchism":                  "Monarchism",
+	"axis_religion":                    "Religion",
+	"badge_anarchism":                  "Anarchist",
+	"badge_pragmatism":                 "Pragmatist",
+	"badge_feminism":                   "Feminist",
+	"badge_complotism":                 "Conspiracist",
+	"badge_veganism":                   "Vegan",
+	"badge_monarchism":                 "Monarchist",
+	"badge_religion":                   "Missionary",
+	"slogan_constructivism":            "Social Constructor",
+	"slogan_essentialism":              "Natural Order",
+	"slogan_rehabilitative_justice":    "Restorative Justice",
+	"slogan_punitive_justice":          "Law and Order",
+	"slogan_progressive":               "Forward Thinking",
+	"slogan_conservative":              "Traditional Values",
+	"slogan_internationalism":          "Global Citizen",
+	"slogan_nationalism":               "Nation First",
+	"slogan_communism":                 "Workers United",
+	"slogan_capitalism":                "Free Markets",
+	"slogan_regulation":                "Guided Economy",
+	"slogan_laissez_faire":             "Market Freedom",
+	"slogan_ecology":                   "Green Future",
+	"slogan_production":                "Progress First",
+	"slogan_revolution":                "Radical Change",
+	"slogan_reform":                    "Gradual Progress",
+	"slogan_anarchism":                 "No Gods No Masters",
+	"slogan_pragmatism":                "Practical Solutions",
+	"slogan_feminism":                  "Gender Equality",
+	"slogan_complotism":                "Question Everything",
+	"slogan_veganism":                  "Animal Rights",
+	"slogan_monarchism":                "Royal Tradition",
+	"slogan_religion":                  "Faithful Believer",
+	"identity_format":                  "{adjective} {noun}",
+	"adjective_constructivism":         "Constructivist",
+	"adjective_essentialism":           "Essentialist",
+	"adjective_rehabilitative_justice": "Humanist",
+	"adjective_punitive_justice":       "Authoritarian",
+	"adjective_progressive":            "Progressive",
+	"adjective_conservative":           "Conservative",
+	"adjective_internationalism":       "Internationalist",
+	"adjective_nationalism":            "Nationalist",
+	"adjective_communism":              "Collectivist",
+	"adjective_capitalism":             "Capitalist",
+	"adjective_regulation":             "Interventionist",
+	"adjective_laissez_faire":          "Free-Market",
+	"adjective_ecology":                "Ecological",
+	"adjective_production":             "Productivist",
+	"adjective_revolution":             "Revolutionary",
+	"adjective_reform":                 "Reformist",
+	"adjective_anarchism":              "Anarchist",
+	"adjective_pragmatism":             "Pragmatic",
+	"adjective_feminism":               "Feminist",
+	"adjective_complotism":             "Conspiracist",
+	"adjective_veganism":               "Vegan",
+	"adjective_monarchism":             "Monarchist",
+	"adjective_religion":               "Religious",
+	"noun_communism":                   "Socialist",
+	"noun_capitalism":                  "Capitalist",
+	"noun_regulation":                  "Social Democrat",
+	"noun_laissez_faire":               "Libertarian",
+	"noun_moderate":                    "Moderate",
+}
+
 // ENQuestions contains English translations for all politiscales questions
 var ENQuestions = map[string]string{
 	"constructivism_becoming_woman":               "\"One is not born, but rather becomes, a woman.\"",
@@ -14,6 +14,96 @@ var ESCopy = map[string]string{
 	"result":          "Resultado",
 }
 
+// ESResultsCopy contains Spanish translations for the results chart
+var ESResultsCopy = map[string]string{
+	"results_title":                    "Resultados de PolitiScales",
+	"political_identity":               "Identidad política",
+	"additional_characteristics":       "Características adicionales",
+	"political_moderate":               "Moderado político",
+	"axis_constructivism":              "Constructivismo",
+	"axis_essentialism":                "Esencialismo",
+	"axis_rehabilitative_justice":      "Justicia rehabilitadora",
+	"axis_punitive_justice":            "Justicia punitiva",
+	"axis_progressive":                 "Progresismo",
+	"axis_conservative":                "Conservadurismo",
+	"axis_internationalism":            "Internacionalismo",
+	"axis_nationalism":                 "Nacionalismo",
+	"axis_communism":                   "Comunismo",
+	"axis_capitalism":                  "Capitalismo",
+	"axis_regulation":                  "Regulación",
+	"axis_laissez_faire":               "Laissez-faire",
+	"axis_ecology":                     "Ecología",
+	"axis_production":                  "Producción",
+	"axis_revolution":                  "Revolución",
+	"axis_reform":                      "Reforma",
+	"axis_anarchism":                   "Anarquismo",
+	"axis_pragmatism":                  "Pragmatismo",
+	"axis_feminism":                    "Feminismo",
+	"axis_complotism":                  "Conspiracionismo",
+	"axis_veganism":                    "Veganismo",
+	"axis_monarchism":                  "Monarquismo",
+	"axis_religion":                    "Religión",
+	"badge_anarchism":                  "Anarquista",
+	"badge_pragmatism":                 "Pragmático",
+	"badge_feminism":                   "Feminista",
+	"badge_complotism":                 "Conspiracionista",
+	"badge_veganism":                   "Vegano",
+	"badge_monarchism":                 "Monárquico",
+	"badge_religion":                   "Misionero",
+	"slogan_constructivism":            "Constructor social",
+	"slogan_essentialism":              "Orden natural",
+	"slogan_rehabilitative_justice":    "Justicia restaurativa",
+	"slogan_punitive_justice":          "Ley y orden",
+	"slogan_progressive":               "Mirada al futuro",
+	"slogan_conservative":              "Valores tradicionales",
+	"slogan_internationalism":          "Ciudadano del mundo",
+	"slogan_nationalism":               "La nación primero",
+	"slogan_communism":                 "Trabajadores unidos",
+	"slogan_capitalism":                "Mercados libres",
+	"slogan_regulation":                "Economía dirigida",
+	"slogan_laissez_faire":             "Libertad de mercado",
+	"slogan_ecology":                   "Futuro verde",
+	"slogan_production":                "El progreso primero",
+	"slogan_revolution":                "Cambio radical",
+	"slogan_reform":                    "Progreso gradual",
+	"slogan_anarchism":                 "Ni dios ni amo",
+	"slogan_pragmatism":                "Soluciones prácticas",
+	"slogan_feminism":                  "Igualdad de género",
+	"slogan_complotism":                "Cuestionarlo todo",
+	"slogan_veganism":                  "Derechos de los animales",
+	"slogan_monarchism":                "Tradición real",
+	"slogan_religion":                  "Creyente fiel",
+	"identity_format":                  "{noun} {adjective}",
+	"adjective_constructivism":         "constructivista",
+	"adjective_essentialism":           "esencialista",
+	"adjective_rehabilitative_justice": "humanista",
+	"adjective_punitive_justice":       "autoritario",
+	"adjective_progressive":            "progresista",
+	"adjective_conservative":           "conservador",
+	"adjective_internationalism":       "internacionalista",
+	"adjective_nationalism":            "nacionalista",
+	"adjective_communism":              "colectivista",
+	"adjective_capitalism":             "capitalista",
+	"adjective_regulation":             "intervencionista",
+	"adjective_laissez_faire":          "librecambista",
+	"adjective_ecology":                "ecologista",
+	"adjective_production":             "productivista",
+	"adjective_revolution":             "revolucionario",
+	"adjective_reform":                 "reformista",
+	"adjective_anarchism":              "anarquista",
+	"adjective_pragmatism":             "pragmático",
+	"adjective_feminism":               "feminista",
+	"adjective_complotism":             "conspiracionista",
+	"adjective_veganism":               "vegano",
+	"adjective_monarchism":             "monárquico",
+	"adjective_religion":               "religioso",
+	"noun_communism":                   "Socialista",
+	"noun_capitalism":                  "Capitalista",
+	"noun_regulation":                  "Socialdemócrata",
+	"noun_laissez_faire":               "Libertario",
+	"noun_moderate":                    "Moderado",
+}
+
 // ESQuestions is a map of Spanish Politiscales questions.
 var ESQuestions = map[string]string{
 	"constructivism_becoming_woman":               "\"Una no nace mujer, más bien, se llega a serlo.\"",
@@ -0,0 +1,156 @@
+package politiscales
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+)
+
+// Flag dimensions in pixels
+const (
+	FlagWidth  = 300
+	FlagHeight = 200
+)
+
+// badgeSymbols are the white line symbols drawn on badges and flags, centred on
+// the origin and fitting a circle of radius 10
+var badgeSymbols = map[string]string{
+	// Circled A
+	"anarchism": `<circle r="8"/><path d="M-4.5 5.5 L0 -5.5 L4.5 5.5 M-6.5 1.5 H6.5"/>`,
+	// Balance scale
+	"pragmatism": `<path d="M0 -7 V7 M-4 7 H4 M-7 -4 H7 M-7 -4 L-9 1 H-5 Z M7 -4 L5 1 H9 Z"/>`,
+	// Venus symbol
+	"feminism": `<circle cy="-3" r="5"/><path d="M0 2 V9 M-3 6 H3"/>`,
+	// Eye in a triangle
+	"complotism": `<path d="M0 -8 L8.5 6.5 H-8.5 Z"/><circle cy="1.5" r="2"/>`,
+	// Leaf
+	"veganism": `<path d="M-7 7 C-7 -3 0 -7 7 -7 C7 1 2 7 -7 7 Z M-7 7 L2 -2"/>`,
+	// Crown
+	"monarchism": `<path d="M-8 5 V-5 L-4 0 L0 -7 L4 0 L8 -5 V5 Z"/>`,
+	// Radiant halo
+	"religion": `<circle r="3"/><path d="M0 -9 V-6 M0 6 V9 M-9 0 H-6 M6 0 H9 M-6 -6 L-4 -4 M4 4 L6 6 M-6 6 L-4 4 M4 -4 L6 -6"/>`,
+}
+
+// badgeSymbol draws an axis' symbol at a position, scaled from its radius-10 size
+func badgeSymbol(name string, x, y, scale float64) string {
+	symbol, ok := badgeSymbols[name]
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf(`<g transform="translate(%g %g) scale(%.4g)" fill="none" stroke="#ffffff" stroke-width="%.4g" stroke-linecap="round" stroke-linejoin="round">%s</g>`,
+		x, y, scale, 2/scale, symbol)
+}
+
+// EarnedBadges returns the unpaired axes whose score reaches their threshold,
+// highest score first
+func EarnedBadges(results map[string]float64) []Axis {
+	var badges []Axis
+	for _, axis := range Axes {
+		if axis.Pair == "" && results[axis.Name] > 0 && results[axis.Name] >= axis.Threshold*100 {
+			badges = append(badges, axis)
+		}
+	}
+	sort.SliceStable(badges, func(i, j int) bool {
+		return results[badges[i].Name] > results[badges[j].Name]
+	})
+	return badges
+}
+
+// BadgeSVG draws an axis' badge: its symbol on a disc of the axis colour
+func BadgeSVG(axis Axis, cx, cy, radius int) string {
+	color := axis.Color
+	if color == "" {
+		color = "#666666"
+	}
+	return fmt.Sprintf(`<circle cx="%d" cy="%d" r="%d" fill="%s" stroke="#333" stroke-width="1"/>%s`,
+		cx, cy, radius, color, badgeSymbol(axis.Name, float64(cx), float64(cy), float64(radius)/12))
+}
+
+// FlagAxes returns the leading side of each pair that scores at least
+// IdentityThreshold, strongest first; their colours make up the flag
+func FlagAxes(results map[string]float64) []Axis {
+	leading := map[string]Axis{}
+	for _, axis := range Axes {
+		if axis.Pair == "" || results[axis.Name] < IdentityThreshold {
+			continue
+		}
+		if other, ok := leading[axis.Pair]; !ok || results[axis.Name] > results[other.Name] {
+			leading[axis.Pair] = axis
+		}
+	}
+	var axes []Axis
+	for _, axis := range Axes {
+		if leading[axis.Pair].Name == axis.Name {
+			axes = append(axes, axis)
+		}
+	}
+	sort.SliceStable(axes, func(i, j int) bool {
+		return results[axes[i].Name] > results[axes[j].Name]
+	})
+	return axes
+}
+
+// GenerateFlagSVG composes a flag from a result: the strongest axis colours the
+// field, the second a horizontal band and the third a triangle at the hoist,
+// which carries the symbol of the strongest badge. A result with no strong axis
+// gets a plain grey flag.
+func GenerateFlagSVG(results map[string]float64) string {
+	axes := FlagAxes(results)
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg width="%d" height="%d" viewBox="0 0 %d %d" xmlns="http://www.w3.org/2000/svg">`,
+		FlagWidth, FlagHeight, FlagWidth, FlagHeight)
+
+	field := "#d0d0d0"
+	if len(axes) > 0 {
+		field = axes[0].Color
+	}
+	fmt.Fprintf(&b, "\n  <!-- Field -->\n  <rect width=\"%d\" height=\"%d\" fill=\"%s\"/>", FlagWidth, FlagHeight, field)
+	if len(axes) > 1 {
+		fmt.Fprintf(&b, "\n  <!-- Band -->\n  <rect y=\"%d\" width=\"%d\" height=\"%d\" fill=\"%s\"/>",
+			FlagHeight/3, FlagWidth, FlagHeight/3, axes[1].Color)
+	}
+
+	// The emblem sits in the triangle when there is one, otherwise in the centre
+	emblemX := float64(FlagWidth) / 2
+	if len(axes) > 2 {
+		fmt.Fprintf(&b, "\n  <!-- Hoist -->\n  <path d=\"M0 0 L%d %d L0 %d Z\" fill=\"%s\"/>",
+			FlagWidth*2/5, FlagHeight/2, FlagHeight, axes[2].Color)
+		emblemX = float64(FlagWidth) * 2 / 15
+	}
+	if badges := EarnedBadges(results); len(badges) > 0 {
+		fmt.Fprintf(&b, "\n  <!-- Emblem: %s -->\n  %s", badges[0].Name, badgeSymbol(badges[0].Name, emblemX, float64(FlagHeight)/2, 3.5))
+	}
+
+	fmt.Fprintf(&b, "\n  <rect width=\"%d\" height=\"%d\" fill=\"none\" stroke=\"#333\" stroke-width=\"2\"/>\n</svg>", FlagWidth, FlagHeight)
+	return b.String()
+}
+
+// GenerateBadgesSVG draws a row of the earned badges with their localised labels,
+// or returns "" when none is earned
+func GenerateBadgesSVG(results map[string]float64, language string) string {
+	badges := EarnedBadges(results)
+	if len(badges) == 0 {
+		return ""
+	}
+	width := 110 * len(badges)
+	direction := ""
+	if IsRTL(language) {
+		direction = ` direction="rtl"`
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg width="%d" height="110" xmlns="http://www.w3.org/2000/svg"%s>`, width, direction)
+	fmt.Fprintf(&b, "\n  <rect width=\"%d\" height=\"110\" fill=\"#f8f9fa\"/>", width)
+	for i, axis := range badges {
+		cx := 55 + 110*i
+		if IsRTL(language) {
+			cx = width - cx
+		}
+		label := ResultsText(language, "badge_"+axis.Name, axis.Label)
+		fmt.Fprintf(&b, "\n  %s\n  <text x=\"%d\" y=\"95\" text-anchor=\"middle\" font-family=\"Arial, sans-serif\" font-size=\"12\" fill=\"#333\">%s (%.0f%%)</text>",
+			BadgeSVG(axis, cx, 45, 30), cx, html.EscapeString(label), results[axis.Name])
+	}
+	b.WriteString("\n</svg>")
+	return b.String()
+}
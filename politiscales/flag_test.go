@@ -0,0 +1,82 @@
+package politiscales
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBadgeSymbolsCoverUnpairedAxes(t *testing.T) {
+	for _, axis := range Axes {
+		if _, ok := badgeSymbols[axis.Name]; ok != (axis.Pair == "") {
+			t.Errorf("%s: expected a badge symbol exactly for unpaired axes", axis.Name)
+		}
+	}
+}
+
+func TestEarnedBadges(t *testing.T) {
+	badges := EarnedBadges(map[string]float64{"monarchism": 60, "religion": 80, "anarchism": 85, "veganism": 0, "ecology": 90})
+	var names []string
+	for _, badge := range badges {
+		names = append(names, badge.Name)
+	}
+	if strings.Join(names, ",") != "religion,monarchism" {
+		t.Errorf("expected earned badges by score, got %v", names)
+	}
+}
+
+func TestFlagAxes(t *testing.T) {
+	axes := FlagAxes(map[string]float64{
+		"communism": 40, "capitalism": 45,
+		"ecology": 70, "production": 10,
+		"nationalism": 25,
+		"anarchism":   99,
+	})
+	if len(axes) != 2 || axes[0].Name != "ecology" || axes[1].Name != "capitalism" {
+		t.Errorf("expected the leading strong sides, strongest first, got %+v", axes)
+	}
+}
+
+func TestGenerateFlagSVG(t *testing.T) {
+	flag := GenerateFlagSVG(map[string]float64{
+		"ecology": 80, "communism": 60, "internationalism": 50, "progressive": 40,
+		"feminism": 95,
+	})
+	for _, expected := range []string{
+		`<rect width="300" height="200" fill="#a0e90d"/>`,
+		`<rect y="66" width="300" height="66" fill="#cc0000"/>`,
+		`<path d="M0 0 L120 100 L0 200 Z" fill="#3e6ffd"/>`,
+		"<!-- Emblem: feminism -->",
+		`translate(40 100)`,
+	} {
+		if !strings.Contains(flag, expected) {
+			t.Errorf("expected %q in flag: %s", expected, flag)
+		}
+	}
+	if strings.Contains(flag, "#850083") {
+		t.Error("only the three strongest axes should colour the flag")
+	}
+
+	plain := GenerateFlagSVG(map[string]float64{"ecology": 20})
+	if !strings.Contains(plain, `fill="#d0d0d0"`) || strings.Contains(plain, "Band") || strings.Contains(plain, "Emblem") {
+		t.Errorf("expected a plain grey flag, got: %s", plain)
+	}
+}
+
+func TestGenerateBadgesSVG(t *testing.T) {
+	if GenerateBadgesSVG(map[string]float64{"anarchism": 50}, "en") != "" {
+		t.Error("expected no badges SVG when none is earned")
+	}
+	svg := GenerateBadgesSVG(map[string]float64{"anarchism": 95, "veganism": 60}, "fr")
+	if strings.Count(svg, `r="30"`) != 2 || !strings.Contains(svg, "Anarchiste (95%)") {
+		t.Errorf("expected two localised badges, got: %s", svg)
+	}
+	if !strings.Contains(GenerateBadgesSVG(map[string]float64{"anarchism": 95}, "ar"), `direction="rtl"`) {
+		t.Error("expected a right-to-left badges SVG in Arabic")
+	}
+
+	// The results chart draws the same badges
+	results := GeneratePolitiscalesResultsSVG(map[string]float64{"monarchism": 70})
+	if !strings.Contains(results, `<circle cx="150" cy="`) || !strings.Contains(results, `scale(0.8333)`) {
+		t.Errorf("expected the results chart to use badge symbols, got: %s", results)
+	}
+}
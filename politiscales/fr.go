@@ -14,6 +14,96 @@ var FRCopy = map[string]string{
 	"result":          "Résultat",
 }
 
+// FRResultsCopy contains French translations for the results chart
+var FRResultsCopy = map[string]string{
+	"results_title":                    "Résultats PolitiScales",
+	"political_identity":               "Identité politique",
+	"additional_characteristics":       "Caractéristiques supplémentaires",
+	"political_moderate":               "Modéré politique",
+	"axis_constructivism":              "Constructivisme",
+	"axis_essentialism":                "Essentialisme",
+	"axis_rehabilitative_justice":      "Justice réhabilitative",
+	"axis_punitive_justice":            "Justice punitive",
+	"axis_progressive":                 "Progressisme",
+	"axis_conservative":                "Conservatisme",
+	"axis_internationalism":            "Internationalisme",
+	"axis_nationalism":                 "Nationalisme",
+	"axis_communism":                   "Communisme",
+	"axis_capitalism":                  "Capitalisme",
+	"axis_regulation":                  "Régulation",
+	"axis_laissez_faire":               "Laissez-faire",
+	"axis_ecology":                     "Écologie",
+	"axis_production":                  "Production",
+	"axis_revolution":                  "Révolution",
+	"axis_reform":                      "Réforme",
+	"axis_anarchism":                   "Anarchisme",
+	"axis_pragmatism":                  "Pragmatisme",
+	"axis_feminism":                    "Féminisme",
+	"axis_complotism":                  "Complotisme",
+	"axis_veganism":                    "Véganisme",
+	"axis_monarchism":                  "Monarchisme",
+	"axis_religion":                    "Religion",
+	"badge_anarchism":                  "Anarchiste",
+	"badge_pragmatism":                 "Pragmatique",
+	"badge_feminism":                   "Féministe",
+	"badge_complotism":                 "Complotiste",
+	"badge_veganism":                   "Végan",
+	"badge_monarchism":                 "Monarchiste",
+	"badge_religion":                   "Missionnaire",
+	"slogan_constructivism":            "Constructeur social",
+	"slogan_essentialism":              "Ordre naturel",
+	"slogan_rehabilitative_justice":    "Justice réparatrice",
+	"slogan_punitive_justice":          "Loi et ordre",
+	"slogan_progressive":               "Tourné vers l'avenir",
+	"slogan_conservative":              "Valeurs traditionnelles",
+	"slogan_internationalism":          "Citoyen du monde",
+	"slogan_nationalism":               "La nation d'abord",
+	"slogan_communism":                 "Travailleurs unis",
+	"slogan_capitalism":                "Marchés libres",
+	"slogan_regulation":                "Économie encadrée",
+	"slogan_laissez_faire":             "Liberté du marché",
+	"slogan_ecology":                   "Avenir vert",
+	"slogan_production":                "Le progrès d'abord",
+	"slogan_revolution":                "Changement radical",
+	"slogan_reform":                    "Progrès graduel",
+	"slogan_anarchism":                 "Ni dieu ni maître",
+	"slogan_pragmatism":                "Solutions pratiques",
+	"slogan_feminism":                  "Égalité des genres",
+	"slogan_complotism":                "Tout remettre en question",
+	"slogan_veganism":                  "Droits des animaux",
+	"slogan_monarchism":                "Tradition royale",
+	"slogan_religion":                  "Croyant fidèle",
+	"identity_format":                  "{noun} {adjective}",
+	"adjective_constructivism":         "constructiviste",
+	"adjective_essentialism":           "essentialiste",
+	"adjective_rehabilitative_justice": "humaniste",
+	"adjective_punitive_justice":       "autoritaire",
+	"adjective_progressive":            "progressiste",
+	"adjective_conservative":           "conservateur",
+	"adjective_internationalism":       "internationaliste",
+	"adjective_nationalism":            "nationaliste",
+	"adjective_communism":              "collectiviste",
+	"adjective_capitalism":             "capitaliste",
+	"adjective_regulation":             "interventionniste",
+	"adjective_laissez_faire":          "libre-échangiste",
+	"adjective_ecology":                "écologiste",
+	"adjective_production":             "productiviste",
+	"adjective_revolution":             "révolutionnaire",
+	"adjective_reform":                 "réformiste",
+	"adjective_anarchism":              "anarchiste",
+	"adjective_pragmatism":             "pragmatique",
+	"adjective_feminism":               "féministe",
+	"adjective_complotism":             "complotiste",
+	"adjective_veganism":               "végane",
+	"adjective_monarchism":             "monarchiste",
+	"adjective_religion":               "religieux",
+	"noun_communism":                   "Socialiste",
+	"noun_capitalism":                  "Capitaliste",
+	"noun_regulation":                  "Social-démocrate",
+	"noun_laissez_faire":               "Libertarien",
+	"noun_moderate":                    "Modéré",
+}
+
 // FRQuestions is a map of French Politiscales questions.
 var FRQuestions = map[string]string{
 	"constructivism_becoming_woman":               "« On ne naît pas femme, on le devient. »",
@@ -14,6 +14,96 @@ var ITCopy = map[string]string{
 	"result":          "Risultato",
 }
 
+// ITResultsCopy contains Italian translations for the results chart
+var ITResultsCopy = map[string]string{
+	"results_title":                    "Risultati PolitiScales",
+	"political_identity":               "Identità politica",
+	"additional_characteristics":       "Caratteristiche aggiuntive",
+	"political_moderate":               "Moderato politico",
+	"axis_constructivism":              "Costruttivismo",
+	"axis_essentialism":                "Essenzialismo",
+	"axis_rehabilitative_justice":      "Giustizia riabilitativa",
+	"axis_punitive_justice":            "Giustizia punitiva",
+	"axis_progressive":                 "Progressismo",
+	"axis_conservative":                "Conservatorismo",
+	"axis_internationalism":            "Internazionalismo",
+	"axis_nationalism":                 "Nazionalismo",
+	"axis_communism":                   "Comunismo",
+	"axis_capitalism":                  "Capitalismo",
+	"axis_regulation":                  "Regolamentazione",
+	"axis_laissez_faire":               "Laissez-faire",
+	"axis_ecology":                     "Ecologia",
+	"axis_production":                  "Produzione",
+	"axis_revolution":                  "Rivoluzione",
+	"axis_reform":                      "Riforma",
+	"axis_anarchism":                   "Anarchismo",
+	"axis_pragmatism":                  "Pragmatismo",
+	"axis_feminism":                    "Femminismo",
+	"axis_complotism":                  "Complottismo",
+	"axis_veganism":                    "Veganismo",
+	"axis_monarchism":                  "Monarchismo",
+	"axis_religion":                    "Religione",
+	"badge_anarchism":                  "Anarchico",
+	"badge_pragmatism":                 "Pragmatico",
+	"badge_feminism":                   "Femminista",
+	"badge_complotism":                 "Complottista",
+	"badge_veganism":                   "Vegano",
+	"badge_monarchism":                 "Monarchico",
+	"badge_religion":                   "Missionario",
+	"slogan_constructivism":            "Costruttore sociale",
+	"slogan_essentialism":              "Ordine naturale",
+	"slogan_rehabilitative_justice":    "Giustizia riparativa",
+	"slogan_punitive_justice":          "Legge e ordine",
+	"slogan_progressive":               "Sguardo al futuro",
+	"slogan_conservative":              "Valori tradizionali",
+	"slogan_internationalism":          "Cittadino del mondo",
+	"slogan_nationalism":               "Prima la nazione",
+	"slogan_communism":                 "Lavoratori uniti",
+	"slogan_capitalism":                "Mercati liberi",
+	"slogan_regulation":                "Economia guidata",
+	"slogan_laissez_faire":             "Libertà di mercato",
+	"slogan_ecology":                   "Futuro verde",
+	"slogan_production":                "Prima il progresso",
+	"slogan_revolution":                "Cambiamento radicale",
+	"slogan_reform":                    "Progresso graduale",
+	"slogan_anarchism":                 "Né dio né padrone",
+	"slogan_pragmatism":                "Soluzioni pratiche",
+	"slogan_feminism":                  "Parità di genere",
+	"slogan_complotism":                "Mettere tutto in dubbio",
+	"slogan_veganism":                  "Diritti degli animali",
+	"slogan_monarchism":                "Tradizione reale",
+	"slogan_religion":                  "Credente fedele",
+	"identity_format":                  "{noun} {adjective}",
+	"adjective_constructivism":         "costruttivista",
+	"adjective_essentialism":           "essenzialista",
+	"adjective_rehabilitative_justice": "umanista",
+	"adjective_punitive_justice":       "autoritario",
+	"adjective_progressive":            "progressista",
+	"adjective_conservative":           "conservatore",
+	"adjective_internationalism":       "internazionalista",
+	"adjective_nationalism":            "nazionalista",
+	"adjective_communism":              "collettivista",
+	"adjective_capitalism":             "capitalista",
+	"adjective_regulation":             "interventista",
+	"adjective_laissez_faire":          "liberista",
+	"adjective_ecology":                "ecologista",
+	"adjective_production":             "produttivista",
+	"adjective_revolution":             "rivoluzionario",
+	"adjective_reform":                 "riformista",
+	"adjective_anarchism":              "anarchico",
+	"adjective_pragmatism":             "pragmatico",
+	"adjective_feminism":               "femminista",
+	"adjective_complotism":             "complottista",
+	"adjective_veganism":               "vegano",
+	"adjective_monarchism":             "monarchico",
+	"adjective_religion":               "religioso",
+	"noun_communism":                   "Socialista",
+	"noun_capitalism":                  "Capitalista",
+	"noun_regulation":                  "Socialdemocratico",
+	"noun_laissez_faire":               "Libertario",
+	"noun_moderate":                    "Moderato",
+}
+
 // ITQuestions is a map of Italian Politiscales questions.
 var ITQuestions = map[string]string{
 	"constructivism_becoming_woman":               "\"Non si nasce donna, lo si diventa.\"",
@@ -1,6 +1,13 @@
 package politiscales
 
-import "fmt"
+import (
+	"fmt"
+	"html"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
 
 // Response values for question answers
 // These correspond to the button onclick values in the UI
@@ -12,12 +19,17 @@ const (
 	StronglyDisagree = -1.0       // Strongly Disagree response value
 )
 
+// BankVersion numbers the question bank. Bump it, and register a migration for
+// stored answers, whenever questions are added, removed, reordered or reweighted.
+const BankVersion = 1
+
 // Question represents a political compass question with economic and social scoring data
 type Question struct {
 	Index      int32 // Question index/ID
 	YesWeights []Weight
 	NoWeights  []Weight
-	Text       string // Question text
+	Text       string   // Question text
+	Topics     []string // Topic tags, such as economy or criminal_justice
 }
 
 // Weight represents the effect of a response on a specific political axis
@@ -33,6 +45,8 @@ type Axis struct {
 	Label     string
 	Threshold float64
 	Slogan    string
+	Adjective string // Describes the political identity when the axis is strong
+	Noun      string // Names the political identity; only economic axes have one
 }
 
 // Axes contains all the political axes with their properties
@@ -45,6 +59,7 @@ var Axes = []Axis{
 		Label:     "equality",
 		Threshold: 0.0,
 		Slogan:    "Social Constructor",
+		Adjective: "Constructivist",
 	},
 	{
 		Name:      "essentialism",
@@ -53,6 +68,7 @@ var Axes = []Axis{
 		Label:     "",
 		Threshold: 0.0,
 		Slogan:    "Natural Order",
+		Adjective: "Essentialist",
 	},
 	{
 		Name:      "rehabilitative_justice",
@@ -61,6 +77,7 @@ var Axes = []Axis{
 		Label:     "justice",
 		Threshold: 0.0,
 		Slogan:    "Restorative Justice",
+		Adjective: "Humanist",
 	},
 	{
 		Name:      "punitive_justice",
@@ -69,6 +86,7 @@ var Axes = []Axis{
 		Label:     "order",
 		Threshold: 0.0,
 		Slogan:    "Law and Order",
+		Adjective: "Authoritarian",
 	},
 	{
 		Name:      "progressive",
@@ -77,6 +95,7 @@ var Axes = []Axis{
 		Label:     "",
 		Threshold: 0.0,
 		Slogan:    "Forward Thinking",
+		Adjective: "Progressive",
 	},
 	{
 		Name:      "conservative",
@@ -85,6 +104,7 @@ var Axes = []Axis{
 		Label:     "family",
 		Threshold: 0.0,
 		Slogan:    "Traditional Values",
+		Adjective: "Conservative",
 	},
 	{
 		Name:      "internationalism",
@@ -93,6 +113,7 @@ var Axes = []Axis{
 		Label:     "humanity",
 		Threshold: 0.0,
 		Slogan:    "Global Citizen",
+		Adjective: "Internationalist",
 	},
 	{
 		Name:      "nationalism",
@@ -101,6 +122,7 @@ var Axes = []Axis{
 		Label:     "fatherland",
 		Threshold: 0.0,
 		Slogan:    "Nation First",
+		Adjective: "Nationalist",
 	},
 	{
 		Name:      "communism",
@@ -109,6 +131,8 @@ var Axes = []Axis{
 		Label:     "socialism",
 		Threshold: 0.0,
 		Slogan:    "Workers United",
+		Adjective: "Collectivist",
+		Noun:      "Socialist",
 	},
 	{
 		Name:      "capitalism",
@@ -117,6 +141,8 @@ var Axes = []Axis{
 		Label:     "work",
 		Threshold: 0.0,
 		Slogan:    "Free Markets",
+		Adjective: "Capitalist",
+		Noun:      "Capitalist",
 	},
 	{
 		Name:      "regulation",
@@ -125,6 +151,8 @@ var Axes = []Axis{
 		Label:     "",
 		Threshold: 0.0,
 		Slogan:    "Guided Economy",
+		Adjective: "Interventionist",
+		Noun:      "Social Democrat",
 	},
 	{
 		Name:      "laissez_faire",
@@ -133,6 +161,8 @@ var Axes = []Axis{
 		Label:     "liberty",
 		Threshold: 0.0,
 		Slogan:    "Market Freedom",
+		Adjective: "Free-Market",
+		Noun:      "Libertarian",
 	},
 	{
 		Name:      "ecology",
@@ -141,6 +171,7 @@ var Axes = []Axis{
 		Label:     "ecology",
 		Threshold: 0.0,
 		Slogan:    "Green Future",
+		Adjective: "Ecological",
 	},
 	{
 		Name:      "production",
@@ -149,6 +180,7 @@ var Axes = []Axis{
 		Label:     "",
 		Threshold: 0.0,
 		Slogan:    "Progress First",
+		Adjective: "Productivist",
 	},
 	{
 		Name:      "revolution",
@@ -157,6 +189,7 @@ var Axes = []Axis{
 		Label:     "revolution",
 		Threshold: 0.0,
 		Slogan:    "Radical Change",
+		Adjective: "Revolutionary",
 	},
 	{
 		Name:      "reform",
@@ -165,6 +198,7 @@ var Axes = []Axis{
 		Label:     "",
 		Threshold: 0.0,
 		Slogan:    "Gradual Progress",
+		Adjective: "Reformist",
 	},
 
 	// Unpaired axes
@@ -175,6 +209,7 @@ var Axes = []Axis{
 		Label:     "Anarchist",
 		Threshold: 0.9,
 		Slogan:    "No Gods No Masters",
+		Adjective: "Anarchist",
 	},
 	{
 		Name:      "pragmatism",
@@ -183,6 +218,7 @@ var Axes = []Axis{
 		Label:     "Pragmatist",
 		Threshold: 0.5,
 		Slogan:    "Practical Solutions",
+		Adjective: "Pragmatic",
 	},
 	{
 		Name:      "feminism",
@@ -191,6 +227,7 @@ var Axes = []Axis{
 		Label:     "Feminist",
 		Threshold: 0.9,
 		Slogan:    "Gender Equality",
+		Adjective: "Feminist",
 	},
 	{
 		Name:      "complotism",
@@ -199,6 +236,7 @@ var Axes = []Axis{
 		Label:     "Conspiracist",
 		Threshold: 0.9,
 		Slogan:    "Question Everything",
+		Adjective: "Conspiracist",
 	},
 	{
 		Name:      "veganism",
@@ -207,6 +245,7 @@ var Axes = []Axis{
 		Label:     "Vegan",
 		Threshold: 0.5,
 		Slogan:    "Animal Rights",
+		Adjective: "Vegan",
 	},
 	{
 		Name:      "monarchism",
@@ -215,6 +254,7 @@ var Axes = []Axis{
 		Label:     "Monarchist",
 		Threshold: 0.5,
 		Slogan:    "Royal Tradition",
+		Adjective: "Monarchist",
 	},
 	{
 		Name:      "religion",
@@ -223,11 +263,150 @@ var Axes = []Axis{
 		Label:     "Missionary",
 		Threshold: 0.5,
 		Slogan:    "Faithful Believer",
+		Adjective: "Religious",
 	},
 }
 
+// ResultsCopy contains the results chart translations for every language, keyed by language code
+var ResultsCopy = map[string]map[string]string{
+	"en": ENResultsCopy,
+	"fr": FRResultsCopy,
+	"es": ESResultsCopy,
+	"it": ITResultsCopy,
+	"ar": ARResultsCopy,
+	"ru": RUResultsCopy,
+	"zh": ZHResultsCopy,
+}
+
+// SVGOptions controls how the results chart is rendered
+type SVGOptions struct {
+	Language string             // Language code for axis names, slogans and headings (defaults to English)
+	Margins  map[string]float64 // Confidence interval half-widths in percentage points, keyed by axis name; 0 draws no error bar
+	Previous map[string]float64 // Earlier results, drawn as dashed markers at the old bar edges
+}
+
+// IsRTL reports whether the language is written right-to-left
+func IsRTL(language string) bool {
+	return language == "ar"
+}
+
+// ResultsText returns a results chart string in the given language, falling back
+// to English and then to the supplied default
+func ResultsText(language, key, fallback string) string {
+	if text, ok := ResultsCopy[language][key]; ok {
+		return text
+	}
+	if text, ok := ResultsCopy["en"][key]; ok {
+		return text
+	}
+	return fallback
+}
+
+// IdentityThreshold is the score, in percent, an axis needs to name the political identity
+const IdentityThreshold = 30.0
+
+// IdentityAxes picks the axes that name a political identity, as on the original
+// results page: the noun comes from the strongest economic axis, and the adjective
+// from the strongest axis of another pair or an earned badge. Either is "" when
+// no axis reaches IdentityThreshold.
+func IdentityAxes(results map[string]float64) (adjective, noun string) {
+	strongest := func(eligible func(Axis) bool) Axis {
+		var best Axis
+		for _, axis := range Axes {
+			score := results[axis.Name]
+			if eligible(axis) && score >= IdentityThreshold && score > results[best.Name] {
+				best = axis
+			}
+		}
+		return best
+	}
+
+	nounAxis := strongest(func(axis Axis) bool { return axis.Noun != "" })
+	adjectiveAxis := strongest(func(axis Axis) bool {
+		switch {
+		case axis.Adjective == "" || axis.Name == nounAxis.Name:
+			return false
+		case axis.Pair == "":
+			return results[axis.Name] >= axis.Threshold*100
+		default:
+			return axis.Pair != nounAxis.Pair
+		}
+	})
+	return adjectiveAxis.Name, nounAxis.Name
+}
+
+// IdentityName names the political identity of a result in the given language,
+// e.g. "Ecological Socialist". Without a strong economic axis the noun is
+// "Moderate", and without any strong axis the result is a "Political Moderate".
+func IdentityName(results map[string]float64, language string) string {
+	adjectiveAxis, nounAxis := IdentityAxes(results)
+	if adjectiveAxis == "" && nounAxis == "" {
+		return ResultsText(language, "political_moderate", "Political Moderate")
+	}
+
+	noun := ResultsText(language, "noun_moderate", "Moderate")
+	adjective := ""
+	for _, axis := range Axes {
+		if axis.Name == nounAxis {
+			noun = ResultsText(language, "noun_"+axis.Name, axis.Noun)
+		}
+		if axis.Name == adjectiveAxis {
+			adjective = ResultsText(language, "adjective_"+axis.Name, axis.Adjective)
+		}
+	}
+	if adjective == "" {
+		// Some languages write the noun in lower case after its adjective
+		first, size := utf8.DecodeRuneInString(noun)
+		return string(unicode.ToUpper(first)) + noun[size:]
+	}
+	format := ResultsText(language, "identity_format", "{adjective} {noun}")
+	return strings.NewReplacer("{adjective}", adjective, "{noun}", noun).Replace(format)
+}
+
 // Generate SVG results display matching the original PolitiScales format
 func GeneratePolitiscalesResultsSVG(results map[string]float64) string {
+	return GeneratePolitiscalesResultsSVGWithOptions(results, SVGOptions{})
+}
+
+// GeneratePolitiscalesResultsSVGWithOptions generates the results chart in the requested language.
+// Right-to-left languages get direction="rtl" and a mirrored layout; text-anchor values
+// follow the SVG direction, so mirroring the x coordinates also mirrors the label anchors.
+func GeneratePolitiscalesResultsSVGWithOptions(results map[string]float64, opts SVGOptions) string {
+	language := opts.Language
+	rtl := IsRTL(language)
+
+	// Mirror horizontal positions for right-to-left layouts
+	mirrorX := func(x int) int {
+		if rtl {
+			return 800 - x
+		}
+		return x
+	}
+	mirrorRectX := func(x, width int) int {
+		if rtl {
+			return 800 - x - width
+		}
+		return x
+	}
+
+	// errorBar draws a capped horizontal interval from x1 to x2 centred vertically on y
+	errorBar := func(x1, x2, y, capHeight int) string {
+		x1, x2 = mirrorX(x1), mirrorX(x2)
+		return fmt.Sprintf(`
+  <line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#333" stroke-width="2"/>
+  <line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#333" stroke-width="2"/>
+  <line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#333" stroke-width="2"/>`,
+			x1, y, x2, y, x1, y-capHeight, x1, y+capHeight, x2, y-capHeight, x2, y+capHeight)
+	}
+	// interval returns the clamped bounds of an axis' confidence interval, in percent
+	interval := func(axis string, score float64) (lower, upper float64, ok bool) {
+		margin := opts.Margins[axis]
+		if margin <= 0 {
+			return 0, 0, false
+		}
+		return math.Max(0, score-margin), math.Min(100, score+margin), true
+	}
+
 	// Define the axis pairs in display order using data from politiscales module
 	// But maintain specific display order and labels for consistency
 	axisPairs := []struct {
@@ -245,45 +424,8 @@ func GeneratePolitiscalesResultsSVG(results map[string]float64) string {
 		{"revolution", "reform", "Revolution", "Reform", "#eb1a66", "#0ee4c8"},
 	}
 
-	// Count qualifying badges to calculate required height
-	var qualifyingBadges []struct {
-		name  string
-		label string
-		score float64
-		color string
-	}
-
-	for _, axis := range Axes {
-		if axis.Pair == "" { // Unpaired axes only
-			score := results[axis.Name]
-			threshold := axis.Threshold * 100
-			if score >= threshold && score > 0 {
-				label := axis.Label
-				if label == "" {
-					label = axis.Name // Fallback to axis name if no label
-				}
-				color := axis.Color
-				if color == "" {
-					color = "#666666" // Default color if none specified
-				}
-				qualifyingBadges = append(qualifyingBadges, struct {
-					name  string
-					label string
-					score float64
-					color string
-				}{axis.Name, label, score, color})
-			}
-		}
-	}
-
-	// Sort badges by score descending
-	for i := 0; i < len(qualifyingBadges)-1; i++ {
-		for j := i + 1; j < len(qualifyingBadges); j++ {
-			if qualifyingBadges[j].score > qualifyingBadges[i].score {
-				qualifyingBadges[i], qualifyingBadges[j] = qualifyingBadges[j], qualifyingBadges[i]
-			}
-		}
-	}
+	// Earned badges decide the required height
+	qualifyingBadges := EarnedBadges(results)
 
 	// Calculate required height: base + axes + spacing + badges section
 	baseHeight := 600
@@ -293,7 +435,12 @@ func GeneratePolitiscalesResultsSVG(results map[string]float64) string {
 	}
 	totalHeight := baseHeight + badgesHeight
 
-	svg := fmt.Sprintf(`<svg width="800" height="%d" xmlns="http://www.w3.org/2000/svg">
+	direction := ""
+	if rtl {
+		direction = ` direction="rtl"`
+	}
+
+	svg := fmt.Sprintf(`<svg width="800" height="%d" xmlns="http://www.w3.org/2000/svg"%s>
   <defs>
     <style>
       .axis-label { font-family: Arial, sans-serif; font-size: 14px; font-weight: bold; }
@@ -306,12 +453,15 @@ func GeneratePolitiscalesResultsSVG(results map[string]float64) string {
   <rect width="800" height="%d" fill="#f8f9fa"/>
   
   <!-- Title -->
-  <text x="400" y="40" class="title" fill="#333">PolitiScales Results</text>`, totalHeight, totalHeight)
+  <text x="400" y="40" class="title" fill="#333">%s</text>`, totalHeight, direction, totalHeight,
+		html.EscapeString(ResultsText(language, "results_title", "PolitiScales Results")))
 
 	y := 80
 	for _, pair := range axisPairs {
 		leftScore := results[pair.leftAxis]
 		rightScore := results[pair.rightAxis]
+		leftLabel := html.EscapeString(ResultsText(language, "axis_"+pair.leftAxis, pair.leftLabel))
+		rightLabel := html.EscapeString(ResultsText(language, "axis_"+pair.rightAxis, pair.rightLabel))
 
 		// Calculate neutral space
 		total := leftScore + rightScore
@@ -330,9 +480,9 @@ func GeneratePolitiscalesResultsSVG(results map[string]float64) string {
 		// Axis labels
 		svg += fmt.Sprintf(`
   <!-- %s vs %s -->
-  <text x="100" y="%d" class="axis-label" fill="#333" text-anchor="end">%s</text>
-  <text x="700" y="%d" class="axis-label" fill="#333">%s</text>`,
-			pair.leftLabel, pair.rightLabel, y+15, pair.leftLabel, y+15, pair.rightLabel)
+  <text x="%d" y="%d" class="axis-label" fill="#333" text-anchor="end">%s</text>
+  <text x="%d" y="%d" class="axis-label" fill="#333">%s</text>`,
+			pair.leftAxis, pair.rightAxis, mirrorX(100), y+15, leftLabel, mirrorX(700), y+15, rightLabel)
 
 		// Progress bar
 		barY := y + 20
@@ -343,8 +493,8 @@ func GeneratePolitiscalesResultsSVG(results map[string]float64) string {
 			svg += fmt.Sprintf(`
   <rect x="%d" y="%d" width="%d" height="30" fill="%s"/>
   <text x="%d" y="%d" class="percentage-text">%.0f%%</text>`,
-				currentX, barY, leftWidth, pair.leftColor,
-				currentX+leftWidth/2, barY+20, leftScore)
+				mirrorRectX(currentX, leftWidth), barY, leftWidth, pair.leftColor,
+				mirrorX(currentX+leftWidth/2), barY+20, leftScore)
 		}
 		currentX += leftWidth
 
@@ -353,8 +503,8 @@ func GeneratePolitiscalesResultsSVG(results map[string]float64) string {
 			svg += fmt.Sprintf(`
   <rect x="%d" y="%d" width="%d" height="30" fill="#e0e0e0"/>
   <text x="%d" y="%d" class="percentage-text" fill="#666">%.0f%%</text>`,
-				currentX, barY, neutralWidth,
-				currentX+neutralWidth/2, barY+20, neutral)
+				mirrorRectX(currentX, neutralWidth), barY, neutralWidth,
+				mirrorX(currentX+neutralWidth/2), barY+20, neutral)
 		}
 		currentX += neutralWidth
 
@@ -363,100 +513,71 @@ func GeneratePolitiscalesResultsSVG(results map[string]float64) string {
 			svg += fmt.Sprintf(`
   <rect x="%d" y="%d" width="%d" height="30" fill="%s"/>
   <text x="%d" y="%d" class="percentage-text">%.0f%%</text>`,
-				currentX, barY, rightWidth, pair.rightColor,
-				currentX+rightWidth/2, barY+20, rightScore)
-		}
-
-		y += 65
-	}
-
-	// Add slogan section
-	y += 20
-
-	// Generate slogan based on top characteristics using data from politiscales module
-	type characteristic struct {
-		name  string
-		value float64
-	}
-
-	var characteristics []characteristic
-	for name, value := range results {
-		if value > 0 {
-			characteristics = append(characteristics, characteristic{name, value})
+				mirrorRectX(currentX, rightWidth), barY, rightWidth, pair.rightColor,
+				mirrorX(currentX+rightWidth/2), barY+20, rightScore)
 		}
-	}
 
-	// Sort by value descending
-	for i := 0; i < len(characteristics)-1; i++ {
-		for j := i + 1; j < len(characteristics); j++ {
-			if characteristics[j].value > characteristics[i].value {
-				characteristics[i], characteristics[j] = characteristics[j], characteristics[i]
+		// Earlier results are marked where each side's bar used to end
+		if opts.Previous != nil {
+			for _, x := range []int{100 + int(opts.Previous[pair.leftAxis]*6), 700 - int(opts.Previous[pair.rightAxis]*6)} {
+				svg += fmt.Sprintf(`
+  <line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#333" stroke-width="2" stroke-dasharray="4 3"/>`,
+					mirrorX(x), barY-4, mirrorX(x), barY+34)
 			}
 		}
-	}
-
-	// Create slogans map from module data
-	slogans := make(map[string]string)
-	for _, axis := range Axes {
-		if axis.Slogan != "" {
-			slogans[axis.Name] = axis.Slogan
-		}
-	}
 
-	sloganParts := []string{}
-	for i, char := range characteristics {
-		if i >= 3 {
-			break
+		// Error bars sit on the inner edge of each side's bar
+		if lower, upper, ok := interval(pair.leftAxis, leftScore); ok {
+			svg += errorBar(100+int(lower*6), 100+int(upper*6), barY+15, 8)
 		}
-		if sloganText, exists := slogans[char.name]; exists && char.value >= 50 {
-			sloganParts = append(sloganParts, sloganText)
+		if lower, upper, ok := interval(pair.rightAxis, rightScore); ok {
+			svg += errorBar(700-int(upper*6), 700-int(lower*6), barY+15, 8)
 		}
-	}
 
-	// If no high-scoring characteristics, try with lower threshold
-	if len(sloganParts) == 0 {
-		for i, char := range characteristics {
-			if i >= 3 {
-				break
-			}
-			if sloganText, exists := slogans[char.name]; exists && char.value >= 30 {
-				sloganParts = append(sloganParts, sloganText)
-			}
-		}
+		y += 65
 	}
 
-	var slogan string
-	if len(sloganParts) > 0 {
-		for i, part := range sloganParts {
-			if i > 0 {
-				slogan += " · "
-			}
-			slogan += part
-		}
-	} else {
-		slogan = "Political Moderate"
-	}
+	// Add political identity section
+	y += 20
 
 	svg += fmt.Sprintf(`
-  <text x="400" y="%d" class="title" fill="#333" font-size="18">Political Identity</text>
+  <text x="400" y="%d" class="title" fill="#333" font-size="18">%s</text>
   <text x="400" y="%d" class="axis-label" fill="#666" font-size="14" text-anchor="middle">%s</text>`,
-		y, y+25, slogan)
+		y, html.EscapeString(ResultsText(language, "political_identity", "Political Identity")), y+25, html.EscapeString(IdentityName(results, language)))
 
 	// Add bonus characteristics section
 	y += 50
 	svg += fmt.Sprintf(`
-  <text x="400" y="%d" class="title" fill="#333" font-size="18">Additional Characteristics</text>`, y)
+  <text x="400" y="%d" class="title" fill="#333" font-size="18">%s</text>`,
+		y, html.EscapeString(ResultsText(language, "additional_characteristics", "Additional Characteristics")))
 
 	// Use the badges we already calculated
 	bonusY := y + 40
 
 	displayedBonus := 0
 	for _, badge := range qualifyingBadges {
+		label := badge.Label
+		if label == "" {
+			label = badge.Name // Fallback to axis name if no label
+		}
+		label = html.EscapeString(ResultsText(language, "badge_"+badge.Name, label))
+		score := results[badge.Name]
 		svg += fmt.Sprintf(`
-  <circle cx="150" cy="%d" r="8" fill="%s"/>
-  <text x="170" y="%d" class="axis-label" fill="#333">%s (%.1f%%)</text>`,
-			bonusY+displayedBonus*25, badge.color,
-			bonusY+displayedBonus*25+5, badge.label, badge.score)
+  %s
+  <text x="%d" y="%d" class="axis-label" fill="#333">%s (%.1f%%)</text>`,
+			BadgeSVG(badge, mirrorX(150), bonusY+displayedBonus*25, 10),
+			mirrorX(170), bonusY+displayedBonus*25+5, label, score)
+
+		// Badges with an interval get a small gauge so single-question axes show their spread
+		if lower, upper, ok := interval(badge.Name, score); ok {
+			gaugeY := bonusY + displayedBonus*25
+			svg += fmt.Sprintf(`
+  <rect x="%d" y="%d" width="100" height="10" fill="#e0e0e0"/>
+  <rect x="%d" y="%d" width="%d" height="10" fill="%s"/>`,
+				mirrorRectX(560, 100), gaugeY-5,
+				mirrorRectX(560, int(score)), gaugeY-5, int(score), badge.Color)
+			svg += errorBar(560+int(lower), 560+int(upper), gaugeY, 6)
+		}
 		displayedBonus++
 	}
 
@@ -422,3 +422,165 @@ func min(a, b int) int {
 	}
 	return b
 }
+
+func TestResultsCopyMaps(t *testing.T) {
+	for lang, table := range ResultsCopy {
+		for _, key := range []string{"results_title", "political_identity", "additional_characteristics", "political_moderate", "identity_format", "noun_moderate"} {
+			if table[key] == "" {
+				t.Errorf("Missing %s results copy for key %s", lang, key)
+			}
+		}
+		for _, axis := range Axes {
+			if table["axis_"+axis.Name] == "" {
+				t.Errorf("Missing %s axis name for %s", lang, axis.Name)
+			}
+			if table["slogan_"+axis.Name] == "" {
+				t.Errorf("Missing %s slogan for %s", lang, axis.Name)
+			}
+			if table["adjective_"+axis.Name] == "" || (axis.Noun != "" && table["noun_"+axis.Name] == "") {
+				t.Errorf("Missing %s identity words for %s", lang, axis.Name)
+			}
+			if axis.Pair == "" && table["badge_"+axis.Name] == "" {
+				t.Errorf("Missing %s badge label for %s", lang, axis.Name)
+			}
+		}
+	}
+
+	if got := ResultsText("xx", "axis_ecology", "fallback"); got != "Ecology" {
+		t.Errorf("Unknown language should fall back to English, got %s", got)
+	}
+	if got := ResultsText("fr", "missing_key", "fallback"); got != "fallback" {
+		t.Errorf("Unknown key should use the fallback, got %s", got)
+	}
+}
+
+func TestIdentityName(t *testing.T) {
+	tests := []struct {
+		name     string
+		results  map[string]float64
+		language string
+		expected string
+	}{
+		{"adjective and noun", map[string]float64{"communism": 60, "ecology": 70, "regulation": 40}, "en", "Ecological Socialist"},
+		{"noun from the strongest economic axis", map[string]float64{"communism": 40, "laissez_faire": 55, "nationalism": 45}, "en", "Nationalist Libertarian"},
+		{"adjective skips the noun's pair", map[string]float64{"capitalism": 50, "communism": 45}, "en", "Capitalist"},
+		{"unearned badge is ignored", map[string]float64{"capitalism": 50, "anarchism": 80}, "en", "Capitalist"},
+		{"earned badge", map[string]float64{"capitalism": 50, "anarchism": 95, "reform": 60}, "en", "Anarchist Capitalist"},
+		{"no economic axis", map[string]float64{"conservative": 45, "communism": 20}, "en", "Conservative Moderate"},
+		{"no strong axis", map[string]float64{"communism": 29, "ecology": 10}, "en", "Political Moderate"},
+		{"noun first in French", map[string]float64{"communism": 60, "ecology": 70}, "fr", "Socialiste écologiste"},
+		{"capitalised lone noun", map[string]float64{"communism": 60}, "ru", "Социалист"},
+		{"no space in Chinese", map[string]float64{"communism": 60, "ecology": 70}, "zh", "生态社会主义者"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IdentityName(tt.results, tt.language); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestLocalizedResultsSVG(t *testing.T) {
+	results := map[string]float64{
+		"communism": 70, "capitalism": 10,
+		"ecology": 60, "production": 20,
+		"anarchism": 95,
+	}
+
+	svg := GeneratePolitiscalesResultsSVGWithOptions(results, SVGOptions{Language: "fr"})
+	for _, expected := range []string{"Résultats PolitiScales", "Identité politique", "Caractéristiques supplémentaires", "Communisme", "Socialiste anarchiste", "Anarchiste"} {
+		if !strings.Contains(svg, expected) {
+			t.Errorf("French SVG should contain %q", expected)
+		}
+	}
+	if strings.Contains(svg, "Political Identity") || strings.Contains(svg, `direction="rtl"`) {
+		t.Error("French SVG should not contain English headings or RTL direction")
+	}
+
+	// The default renderer stays English and left-to-right
+	english := GeneratePolitiscalesResultsSVG(results)
+	if english != GeneratePolitiscalesResultsSVGWithOptions(results, SVGOptions{Language: "en"}) {
+		t.Error("Default SVG should match the English rendering")
+	}
+	if !strings.Contains(english, `<text x="100" y="95" class="axis-label" fill="#333" text-anchor="end">Constructivism</text>`) {
+		t.Error("English SVG should place the first axis label on the left")
+	}
+}
+
+func TestRTLResultsSVG(t *testing.T) {
+	results := map[string]float64{"constructivism": 50, "essentialism": 20, "monarchism": 80}
+
+	svg := GeneratePolitiscalesResultsSVGWithOptions(results, SVGOptions{Language: "ar"})
+	if !strings.Contains(svg, `direction="rtl"`) {
+		t.Error("Arabic SVG should declare direction=\"rtl\"")
+	}
+	if !strings.Contains(svg, "الهوية السياسية") {
+		t.Error("Arabic SVG should contain the translated heading")
+	}
+
+	// Labels are mirrored: the first axis of each pair sits on the right
+	if !strings.Contains(svg, `<text x="700" y="95" class="axis-label" fill="#333" text-anchor="end">البنائية</text>`) {
+		t.Error("Arabic SVG should mirror the first axis label to the right")
+	}
+	if !strings.Contains(svg, `<text x="100" y="95" class="axis-label" fill="#333">الجوهرانية</text>`) {
+		t.Error("Arabic SVG should mirror the second axis label to the left")
+	}
+
+	// The constructivism bar (50% of 600px) starts at the right edge of the bar area
+	if !strings.Contains(svg, `<rect x="400" y="100" width="300" height="30" fill="#a425b6"/>`) {
+		t.Error("Arabic SVG should draw the first axis bar from the right")
+	}
+
+	if !strings.Contains(svg, `<circle cx="650"`) {
+		t.Error("Arabic SVG should mirror the badge markers")
+	}
+
+	if !IsRTL("ar") || IsRTL("fr") {
+		t.Error("IsRTL should only report Arabic as right-to-left")
+	}
+}
+
+func TestResultsSVGErrorBars(t *testing.T) {
+	results := map[string]float64{"constructivism": 50, "essentialism": 20, "monarchism": 80}
+
+	plain := GeneratePolitiscalesResultsSVG(results)
+	if strings.Contains(plain, "<line") {
+		t.Error("SVG without margins should not draw error bars")
+	}
+
+	opts := SVGOptions{Margins: map[string]float64{"constructivism": 10, "essentialism": 30, "monarchism": 40}}
+	svg := GeneratePolitiscalesResultsSVGWithOptions(results, opts)
+
+	// Constructivism spans 40-60% from the left edge, essentialism 0-50% from the right
+	if !strings.Contains(svg, `<line x1="340" y1="115" x2="460" y2="115"`) {
+		t.Error("SVG should draw the constructivism interval across its bar edge")
+	}
+	if !strings.Contains(svg, `<line x1="400" y1="115" x2="700" y2="115"`) {
+		t.Error("SVG should clamp the essentialism interval at 0%")
+	}
+	// Badges get a gauge with their own interval
+	if !strings.Contains(svg, `<line x1="600" y1="`) || !strings.Contains(svg, `x2="660" y2="`) {
+		t.Error("SVG should draw the monarchism interval on its badge gauge")
+	}
+
+	// Right-to-left layouts mirror the error bars too
+	opts.Language = "ar"
+	if rtl := GeneratePolitiscalesResultsSVGWithOptions(results, opts); !strings.Contains(rtl, `<line x1="460" y1="115" x2="340" y2="115"`) {
+		t.Error("Arabic SVG should mirror the error bars")
+	}
+}
+
+func TestResultsSVGPrevious(t *testing.T) {
+	results := map[string]float64{"constructivism": 50, "essentialism": 20}
+	previous := map[string]float64{"constructivism": 30, "essentialism": 10}
+	svg := GeneratePolitiscalesResultsSVGWithOptions(results, SVGOptions{Previous: previous})
+
+	// Constructivism used to end at 30% from the left, essentialism at 10% from the right
+	if !strings.Contains(svg, `<line x1="280" y1="96" x2="280" y2="134"`) {
+		t.Error("SVG should mark the previous constructivism edge")
+	}
+	if !strings.Contains(svg, `<line x1="640" y1="96" x2="640" y2="134"`) {
+		t.Error("SVG should mark the previous essentialism edge")
+	}
+}
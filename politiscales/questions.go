@@ -3,8 +3,9 @@ package politiscales
 // Questions contains all the politiscales questions with their weights
 var Questions = []Question{
 	{
-		Index: 0,
-		Text:  "constructivism_becoming_woman",
+		Index:  0,
+		Text:   "constructivism_becoming_woman",
+		Topics: []string{"gender"},
 		YesWeights: []Weight{
 			{Axis: "constructivism", Value: 3},
 			{Axis: "feminism", Value: 3},
@@ -14,8 +15,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 1,
-		Text:  "constructivism_racism_presence",
+		Index:  1,
+		Text:   "constructivism_racism_presence",
+		Topics: []string{"race"},
 		YesWeights: []Weight{
 			{Axis: "constructivism", Value: 3},
 		},
@@ -24,8 +26,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 2,
-		Text:  "constructivism_science_society",
+		Index:  2,
+		Text:   "constructivism_science_society",
+		Topics: []string{"culture"},
 		YesWeights: []Weight{
 			{Axis: "constructivism", Value: 3},
 		},
@@ -34,8 +37,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 3,
-		Text:  "constructivism_gender_categories",
+		Index:  3,
+		Text:   "constructivism_gender_categories",
+		Topics: []string{"gender"},
 		YesWeights: []Weight{
 			{Axis: "constructivism", Value: 3},
 			{Axis: "feminism", Value: 3},
@@ -45,8 +49,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 4,
-		Text:  "constructivism_criminality_nature",
+		Index:  4,
+		Text:   "constructivism_criminality_nature",
+		Topics: []string{"criminal_justice"},
 		YesWeights: []Weight{
 			{Axis: "constructivism", Value: 3},
 		},
@@ -55,8 +60,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 5,
-		Text:  "constructivism_sexual_orientation",
+		Index:  5,
+		Text:   "constructivism_sexual_orientation",
+		Topics: []string{"sexuality"},
 		YesWeights: []Weight{
 			{Axis: "constructivism", Value: 3},
 		},
@@ -65,8 +71,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 6,
-		Text:  "constructivism_ethnic_differences",
+		Index:  6,
+		Text:   "constructivism_ethnic_differences",
+		Topics: []string{"race"},
 		YesWeights: []Weight{
 			{Axis: "constructivism", Value: 3},
 		},
@@ -75,8 +82,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 7,
-		Text:  "essentialism_gender_biology",
+		Index:  7,
+		Text:   "essentialism_gender_biology",
+		Topics: []string{"gender"},
 		YesWeights: []Weight{
 			{Axis: "essentialism", Value: 3},
 		},
@@ -86,8 +94,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 8,
-		Text:  "essentialism_hormones_character",
+		Index:  8,
+		Text:   "essentialism_hormones_character",
+		Topics: []string{"gender"},
 		YesWeights: []Weight{
 			{Axis: "essentialism", Value: 3},
 		},
@@ -97,8 +106,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 9,
-		Text:  "essentialism_sexual_aggression",
+		Index:  9,
+		Text:   "essentialism_sexual_aggression",
+		Topics: []string{"gender", "criminal_justice"},
 		YesWeights: []Weight{
 			{Axis: "essentialism", Value: 3},
 		},
@@ -108,8 +118,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 10,
-		Text:  "essentialism_transgender_identity",
+		Index:  10,
+		Text:   "essentialism_transgender_identity",
+		Topics: []string{"gender"},
 		YesWeights: []Weight{
 			{Axis: "essentialism", Value: 3},
 		},
@@ -118,8 +129,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 11,
-		Text:  "essentialism_national_traits",
+		Index:  11,
+		Text:   "essentialism_national_traits",
+		Topics: []string{"nationalism", "culture"},
 		YesWeights: []Weight{
 			{Axis: "essentialism", Value: 3},
 		},
@@ -128,8 +140,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 12,
-		Text:  "essentialism_human_heterosexuality",
+		Index:  12,
+		Text:   "essentialism_human_heterosexuality",
+		Topics: []string{"sexuality"},
 		YesWeights: []Weight{
 			{Axis: "essentialism", Value: 3},
 		},
@@ -138,8 +151,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 13,
-		Text:  "essentialism_human_egoism",
+		Index:  13,
+		Text:   "essentialism_human_egoism",
+		Topics: []string{"culture"},
 		YesWeights: []Weight{
 			{Axis: "essentialism", Value: 3},
 		},
@@ -148,8 +162,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 14,
-		Text:  "internationalism_border_removal",
+		Index:  14,
+		Text:   "internationalism_border_removal",
+		Topics: []string{"immigration", "foreign_policy"},
 		YesWeights: []Weight{
 			{Axis: "internationalism", Value: 3},
 		},
@@ -158,8 +173,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 15,
-		Text:  "internationalism_ideals_country",
+		Index:  15,
+		Text:   "internationalism_ideals_country",
+		Topics: []string{"nationalism"},
 		YesWeights: []Weight{
 			{Axis: "internationalism", Value: 3},
 		},
@@ -168,8 +184,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 16,
-		Text:  "internationalism_country_reparation",
+		Index:  16,
+		Text:   "internationalism_country_reparation",
+		Topics: []string{"foreign_policy"},
 		YesWeights: []Weight{
 			{Axis: "internationalism", Value: 3},
 		},
@@ -178,8 +195,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 17,
-		Text:  "internationalism_free_trade_similarity",
+		Index:  17,
+		Text:   "internationalism_free_trade_similarity",
+		Topics: []string{"economy", "foreign_policy"},
 		YesWeights: []Weight{
 			{Axis: "internationalism", Value: 3},
 		},
@@ -188,8 +206,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 18,
-		Text:  "internationalism_sport_chauvinism",
+		Index:  18,
+		Text:   "internationalism_sport_chauvinism",
+		Topics: []string{"nationalism", "culture"},
 		YesWeights: []Weight{
 			{Axis: "internationalism", Value: 3},
 		},
@@ -198,8 +217,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 19,
-		Text:  "internationalism_global_concern",
+		Index:  19,
+		Text:   "internationalism_global_concern",
+		Topics: []string{"foreign_policy"},
 		YesWeights: []Weight{
 			{Axis: "internationalism", Value: 3},
 		},
@@ -208,8 +228,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 20,
-		Text:  "internationalism_foreign_political_rights",
+		Index:  20,
+		Text:   "internationalism_foreign_political_rights",
+		Topics: []string{"immigration", "government"},
 		YesWeights: []Weight{
 			{Axis: "internationalism", Value: 3},
 		},
@@ -218,8 +239,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 21,
-		Text:  "nationalism_citizen_priority",
+		Index:  21,
+		Text:   "nationalism_citizen_priority",
+		Topics: []string{"immigration", "nationalism"},
 		YesWeights: []Weight{
 			{Axis: "nationalism", Value: 3},
 		},
@@ -228,8 +250,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 22,
-		Text:  "nationalism_country_values",
+		Index:  22,
+		Text:   "nationalism_country_values",
+		Topics: []string{"nationalism"},
 		YesWeights: []Weight{
 			{Axis: "nationalism", Value: 3},
 		},
@@ -238,8 +261,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 23,
-		Text:  "nationalism_multiculturalism_danger",
+		Index:  23,
+		Text:   "nationalism_multiculturalism_danger",
+		Topics: []string{"immigration", "culture"},
 		YesWeights: []Weight{
 			{Axis: "nationalism", Value: 3},
 		},
@@ -248,8 +272,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 24,
-		Text:  "nationalism_good_citizen_patriot",
+		Index:  24,
+		Text:   "nationalism_good_citizen_patriot",
+		Topics: []string{"nationalism"},
 		YesWeights: []Weight{
 			{Axis: "nationalism", Value: 3},
 		},
@@ -258,8 +283,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 25,
-		Text:  "nationalism_military_intervention",
+		Index:  25,
+		Text:   "nationalism_military_intervention",
+		Topics: []string{"foreign_policy", "economy"},
 		YesWeights: []Weight{
 			{Axis: "nationalism", Value: 3},
 		},
@@ -268,8 +294,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 26,
-		Text:  "nationalism_history_national_belonging",
+		Index:  26,
+		Text:   "nationalism_history_national_belonging",
+		Topics: []string{"nationalism", "education"},
 		YesWeights: []Weight{
 			{Axis: "nationalism", Value: 3},
 		},
@@ -278,8 +305,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 27,
-		Text:  "nationalism_country_research_access",
+		Index:  27,
+		Text:   "nationalism_country_research_access",
+		Topics: []string{"nationalism", "technology"},
 		YesWeights: []Weight{
 			{Axis: "nationalism", Value: 3},
 		},
@@ -288,8 +316,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 28,
-		Text:  "communism_wealth_ownership",
+		Index:  28,
+		Text:   "communism_wealth_ownership",
+		Topics: []string{"economy"},
 		YesWeights: []Weight{
 			{Axis: "communism", Value: 3},
 		},
@@ -298,8 +327,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 29,
-		Text:  "communism_private_labor_theft",
+		Index:  29,
+		Text:   "communism_private_labor_theft",
+		Topics: []string{"economy"},
 		YesWeights: []Weight{
 			{Axis: "communism", Value: 3},
 		},
@@ -308,8 +338,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 30,
-		Text:  "communism_public_health",
+		Index:  30,
+		Text:   "communism_public_health",
+		Topics: []string{"welfare"},
 		YesWeights: []Weight{
 			{Axis: "communism", Value: 3},
 		},
@@ -318,8 +349,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 31,
-		Text:  "communism_public_energy_infrastructure",
+		Index:  31,
+		Text:   "communism_public_energy_infrastructure",
+		Topics: []string{"economy"},
 		YesWeights: []Weight{
 			{Axis: "communism", Value: 3},
 		},
@@ -328,8 +360,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 32,
-		Text:  "communism_patents_nonexistence",
+		Index:  32,
+		Text:   "communism_patents_nonexistence",
+		Topics: []string{"economy", "technology"},
 		YesWeights: []Weight{
 			{Axis: "communism", Value: 3},
 		},
@@ -338,8 +371,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 33,
-		Text:  "communism_production_rationing",
+		Index:  33,
+		Text:   "communism_production_rationing",
+		Topics: []string{"economy"},
 		YesWeights: []Weight{
 			{Axis: "communism", Value: 3},
 		},
@@ -348,8 +382,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 34,
-		Text:  "communism_labor_market_exploitation",
+		Index:  34,
+		Text:   "communism_labor_market_exploitation",
+		Topics: []string{"economy"},
 		YesWeights: []Weight{
 			{Axis: "communism", Value: 3},
 		},
@@ -358,8 +393,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 35,
-		Text:  "capitalism_profit_economy",
+		Index:  35,
+		Text:   "capitalism_profit_economy",
+		Topics: []string{"economy"},
 		YesWeights: []Weight{
 			{Axis: "capitalism", Value: 3},
 		},
@@ -368,8 +404,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 36,
-		Text:  "capitalism_merit_wealth_difference",
+		Index:  36,
+		Text:   "capitalism_merit_wealth_difference",
+		Topics: []string{"economy"},
 		YesWeights: []Weight{
 			{Axis: "capitalism", Value: 3},
 		},
@@ -378,8 +415,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 37,
-		Text:  "capitalism_private_schools_universities",
+		Index:  37,
+		Text:   "capitalism_private_schools_universities",
+		Topics: []string{"education", "economy"},
 		YesWeights: []Weight{
 			{Axis: "capitalism", Value: 3},
 		},
@@ -388,8 +426,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 38,
-		Text:  "capitalism_relocation_production",
+		Index:  38,
+		Text:   "capitalism_relocation_production",
+		Topics: []string{"economy"},
 		YesWeights: []Weight{
 			{Axis: "capitalism", Value: 3},
 		},
@@ -398,8 +437,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 39,
-		Text:  "capitalism_rich_poor_acceptance",
+		Index:  39,
+		Text:   "capitalism_rich_poor_acceptance",
+		Topics: []string{"economy"},
 		YesWeights: []Weight{
 			{Axis: "capitalism", Value: 3},
 		},
@@ -408,8 +448,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 40,
-		Text:  "capitalism_private_industry_sectors",
+		Index:  40,
+		Text:   "capitalism_private_industry_sectors",
+		Topics: []string{"economy"},
 		YesWeights: []Weight{
 			{Axis: "capitalism", Value: 3},
 		},
@@ -418,8 +459,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 41,
-		Text:  "capitalism_private_banks",
+		Index:  41,
+		Text:   "capitalism_private_banks",
+		Topics: []string{"economy"},
 		YesWeights: []Weight{
 			{Axis: "capitalism", Value: 3},
 		},
@@ -428,8 +470,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 42,
-		Text:  "regulation_income_tax_redistribution",
+		Index:  42,
+		Text:   "regulation_income_tax_redistribution",
+		Topics: []string{"economy", "welfare"},
 		YesWeights: []Weight{
 			{Axis: "regulation", Value: 3},
 		},
@@ -438,8 +481,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 43,
-		Text:  "regulation_retirement_age",
+		Index:  43,
+		Text:   "regulation_retirement_age",
+		Topics: []string{"welfare"},
 		YesWeights: []Weight{
 			{Axis: "regulation", Value: 3},
 		},
@@ -448,8 +492,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 44,
-		Text:  "regulation_unjustified_dismissals",
+		Index:  44,
+		Text:   "regulation_unjustified_dismissals",
+		Topics: []string{"economy"},
 		YesWeights: []Weight{
 			{Axis: "regulation", Value: 3},
 		},
@@ -458,8 +503,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 45,
-		Text:  "regulation_wage_control",
+		Index:  45,
+		Text:   "regulation_wage_control",
+		Topics: []string{"economy", "welfare"},
 		YesWeights: []Weight{
 			{Axis: "regulation", Value: 3},
 		},
@@ -468,8 +514,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 46,
-		Text:  "regulation_monopoly_prevention",
+		Index:  46,
+		Text:   "regulation_monopoly_prevention",
+		Topics: []string{"economy"},
 		YesWeights: []Weight{
 			{Axis: "regulation", Value: 3},
 		},
@@ -478,8 +525,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 47,
-		Text:  "regulation_public_loans",
+		Index:  47,
+		Text:   "regulation_public_loans",
+		Topics: []string{"economy"},
 		YesWeights: []Weight{
 			{Axis: "regulation", Value: 3},
 		},
@@ -488,8 +536,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 48,
-		Text:  "regulation_sector_subsidies",
+		Index:  48,
+		Text:   "regulation_sector_subsidies",
+		Topics: []string{"economy"},
 		YesWeights: []Weight{
 			{Axis: "regulation", Value: 3},
 		},
@@ -498,8 +547,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 49,
-		Text:  "laissez_faire_market_optimality",
+		Index:  49,
+		Text:   "laissez_faire_market_optimality",
+		Topics: []string{"economy"},
 		YesWeights: []Weight{
 			{Axis: "laissez_faire", Value: 3},
 		},
@@ -508,8 +558,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 50,
-		Text:  "laissez_faire_contract_freedom",
+		Index:  50,
+		Text:   "laissez_faire_contract_freedom",
+		Topics: []string{"economy"},
 		YesWeights: []Weight{
 			{Axis: "laissez_faire", Value: 3},
 		},
@@ -518,8 +569,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 51,
-		Text:  "laissez_faire_labor_regulations",
+		Index:  51,
+		Text:   "laissez_faire_labor_regulations",
+		Topics: []string{"economy"},
 		YesWeights: []Weight{
 			{Axis: "laissez_faire", Value: 3},
 		},
@@ -528,8 +580,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 52,
-		Text:  "laissez_faire_working_hours",
+		Index:  52,
+		Text:   "laissez_faire_working_hours",
+		Topics: []string{"economy"},
 		YesWeights: []Weight{
 			{Axis: "laissez_faire", Value: 3},
 		},
@@ -538,8 +591,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 53,
-		Text:  "laissez_faire_environmental_standards",
+		Index:  53,
+		Text:   "laissez_faire_environmental_standards",
+		Topics: []string{"environment", "economy"},
 		YesWeights: []Weight{
 			{Axis: "laissez_faire", Value: 3},
 		},
@@ -548,8 +602,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 54,
-		Text:  "laissez_faire_social_assistance",
+		Index:  54,
+		Text:   "laissez_faire_social_assistance",
+		Topics: []string{"welfare"},
 		YesWeights: []Weight{
 			{Axis: "laissez_faire", Value: 3},
 		},
@@ -558,8 +613,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 55,
-		Text:  "laissez_faire_public_enterprises",
+		Index:  55,
+		Text:   "laissez_faire_public_enterprises",
+		Topics: []string{"economy"},
 		YesWeights: []Weight{
 			{Axis: "laissez_faire", Value: 3},
 		},
@@ -568,8 +624,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 56,
-		Text:  "progressive_tradition_questioning",
+		Index:  56,
+		Text:   "progressive_tradition_questioning",
+		Topics: []string{"culture"},
 		YesWeights: []Weight{
 			{Axis: "progressive", Value: 3},
 		},
@@ -578,8 +635,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 57,
-		Text:  "progressive_official_languages",
+		Index:  57,
+		Text:   "progressive_official_languages",
+		Topics: []string{"culture", "nationalism"},
 		YesWeights: []Weight{
 			{Axis: "progressive", Value: 3},
 		},
@@ -588,8 +646,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 58,
-		Text:  "progressive_marriage_abolition",
+		Index:  58,
+		Text:   "progressive_marriage_abolition",
+		Topics: []string{"family"},
 		YesWeights: []Weight{
 			{Axis: "progressive", Value: 3},
 		},
@@ -598,8 +657,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 59,
-		Text:  "progressive_foreign_culture_enrichment",
+		Index:  59,
+		Text:   "progressive_foreign_culture_enrichment",
+		Topics: []string{"immigration", "culture"},
 		YesWeights: []Weight{
 			{Axis: "progressive", Value: 3},
 		},
@@ -608,8 +668,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 60,
-		Text:  "progressive_religion_influence",
+		Index:  60,
+		Text:   "progressive_religion_influence",
+		Topics: []string{"religion"},
 		YesWeights: []Weight{
 			{Axis: "progressive", Value: 3},
 		},
@@ -618,8 +679,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 61,
-		Text:  "progressive_language_definition",
+		Index:  61,
+		Text:   "progressive_language_definition",
+		Topics: []string{"culture"},
 		YesWeights: []Weight{
 			{Axis: "progressive", Value: 3},
 		},
@@ -628,8 +690,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 62,
-		Text:  "progressive_euthanasia_legalization",
+		Index:  62,
+		Text:   "progressive_euthanasia_legalization",
+		Topics: []string{"civil_liberties"},
 		YesWeights: []Weight{
 			{Axis: "progressive", Value: 3},
 		},
@@ -638,8 +701,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 63,
-		Text:  "conservative_homosexual_equality",
+		Index:  63,
+		Text:   "conservative_homosexual_equality",
+		Topics: []string{"sexuality", "family"},
 		YesWeights: []Weight{
 			{Axis: "progressive", Value: 3},
 		},
@@ -648,8 +712,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 64,
-		Text:  "conservative_death_penalty_justification",
+		Index:  64,
+		Text:   "conservative_death_penalty_justification",
+		Topics: []string{"criminal_justice"},
 		YesWeights: []Weight{
 			{Axis: "conservative", Value: 3},
 		},
@@ -658,8 +723,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 65,
-		Text:  "conservative_technological_change",
+		Index:  65,
+		Text:   "conservative_technological_change",
+		Topics: []string{"technology"},
 		YesWeights: []Weight{
 			{Axis: "conservative", Value: 3},
 		},
@@ -668,8 +734,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 66,
-		Text:  "conservative_school_curriculum",
+		Index:  66,
+		Text:   "conservative_school_curriculum",
+		Topics: []string{"education", "culture"},
 		YesWeights: []Weight{
 			{Axis: "conservative", Value: 3},
 		},
@@ -678,8 +745,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 67,
-		Text:  "conservative_abortion_restriction",
+		Index:  67,
+		Text:   "conservative_abortion_restriction",
+		Topics: []string{"gender", "civil_liberties"},
 		YesWeights: []Weight{
 			{Axis: "conservative", Value: 3},
 		},
@@ -688,8 +756,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 68,
-		Text:  "conservative_couple_child_production",
+		Index:  68,
+		Text:   "conservative_couple_child_production",
+		Topics: []string{"family"},
 		YesWeights: []Weight{
 			{Axis: "conservative", Value: 3},
 		},
@@ -698,8 +767,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 69,
-		Text:  "conservative_abstinence_preference",
+		Index:  69,
+		Text:   "conservative_abstinence_preference",
+		Topics: []string{"sexuality", "religion"},
 		YesWeights: []Weight{
 			{Axis: "conservative", Value: 3},
 		},
@@ -708,8 +778,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 70,
-		Text:  "ecology_species_extinction",
+		Index:  70,
+		Text:   "ecology_species_extinction",
+		Topics: []string{"environment"},
 		YesWeights: []Weight{
 			{Axis: "ecology", Value: 3},
 		},
@@ -718,8 +789,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 71,
-		Text:  "ecology_gmo_restriction",
+		Index:  71,
+		Text:   "ecology_gmo_restriction",
+		Topics: []string{"environment", "technology"},
 		YesWeights: []Weight{
 			{Axis: "ecology", Value: 3},
 		},
@@ -728,8 +800,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 72,
-		Text:  "ecology_climate_change_combat",
+		Index:  72,
+		Text:   "ecology_climate_change_combat",
+		Topics: []string{"environment"},
 		YesWeights: []Weight{
 			{Axis: "ecology", Value: 3},
 		},
@@ -738,8 +811,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 73,
-		Text:  "ecology_consumption_change",
+		Index:  73,
+		Text:   "ecology_consumption_change",
+		Topics: []string{"environment"},
 		YesWeights: []Weight{
 			{Axis: "ecology", Value: 3},
 		},
@@ -748,8 +822,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 74,
-		Text:  "ecology_biodiversity_agriculture",
+		Index:  74,
+		Text:   "ecology_biodiversity_agriculture",
+		Topics: []string{"environment"},
 		YesWeights: []Weight{
 			{Axis: "ecology", Value: 3},
 		},
@@ -758,8 +833,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 75,
-		Text:  "ecology_ecosystem_preservation",
+		Index:  75,
+		Text:   "ecology_ecosystem_preservation",
+		Topics: []string{"environment", "economy"},
 		YesWeights: []Weight{
 			{Axis: "ecology", Value: 3},
 		},
@@ -768,8 +844,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 76,
-		Text:  "ecology_waste_reduction_production",
+		Index:  76,
+		Text:   "ecology_waste_reduction_production",
+		Topics: []string{"environment", "economy"},
 		YesWeights: []Weight{
 			{Axis: "ecology", Value: 3},
 		},
@@ -778,8 +855,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 77,
-		Text:  "production_space_colonization",
+		Index:  77,
+		Text:   "production_space_colonization",
+		Topics: []string{"technology"},
 		YesWeights: []Weight{
 			{Axis: "production", Value: 3},
 		},
@@ -788,8 +866,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 78,
-		Text:  "production_ecosystem_transformation",
+		Index:  78,
+		Text:   "production_ecosystem_transformation",
+		Topics: []string{"environment"},
 		YesWeights: []Weight{
 			{Axis: "production", Value: 3},
 		},
@@ -798,8 +877,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 79,
-		Text:  "production_research_investment",
+		Index:  79,
+		Text:   "production_research_investment",
+		Topics: []string{"technology", "economy"},
 		YesWeights: []Weight{
 			{Axis: "production", Value: 3},
 		},
@@ -808,8 +888,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 80,
-		Text:  "production_transhumanism_benefit",
+		Index:  80,
+		Text:   "production_transhumanism_benefit",
+		Topics: []string{"technology"},
 		YesWeights: []Weight{
 			{Axis: "production", Value: 3},
 		},
@@ -818,8 +899,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 81,
-		Text:  "production_nuclear_energy",
+		Index:  81,
+		Text:   "production_nuclear_energy",
+		Topics: []string{"technology", "environment"},
 		YesWeights: []Weight{
 			{Axis: "production", Value: 3},
 		},
@@ -828,8 +910,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 82,
-		Text:  "production_fossil_energy_exploitation",
+		Index:  82,
+		Text:   "production_fossil_energy_exploitation",
+		Topics: []string{"environment", "economy"},
 		YesWeights: []Weight{
 			{Axis: "production", Value: 3},
 		},
@@ -838,8 +921,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 83,
-		Text:  "production_economic_growth",
+		Index:  83,
+		Text:   "production_economic_growth",
+		Topics: []string{"economy"},
 		YesWeights: []Weight{
 			{Axis: "production", Value: 3},
 		},
@@ -848,8 +932,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 84,
-		Text:  "rehabilitative_justice_prison_abolition",
+		Index:  84,
+		Text:   "rehabilitative_justice_prison_abolition",
+		Topics: []string{"criminal_justice"},
 		YesWeights: []Weight{
 			{Axis: "rehabilitative_justice", Value: 3},
 		},
@@ -858,8 +943,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 85,
-		Text:  "rehabilitative_justice_minimum_penalty",
+		Index:  85,
+		Text:   "rehabilitative_justice_minimum_penalty",
+		Topics: []string{"criminal_justice"},
 		YesWeights: []Weight{
 			{Axis: "rehabilitative_justice", Value: 3},
 		},
@@ -868,8 +954,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 86,
-		Text:  "rehabilitative_justice_reinsertion_support",
+		Index:  86,
+		Text:   "rehabilitative_justice_reinsertion_support",
+		Topics: []string{"criminal_justice"},
 		YesWeights: []Weight{
 			{Axis: "rehabilitative_justice", Value: 3},
 		},
@@ -878,8 +965,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 87,
-		Text:  "rehabilitative_justice_contextual_penalties",
+		Index:  87,
+		Text:   "rehabilitative_justice_contextual_penalties",
+		Topics: []string{"criminal_justice"},
 		YesWeights: []Weight{
 			{Axis: "rehabilitative_justice", Value: 3},
 		},
@@ -888,8 +976,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 88,
-		Text:  "rehabilitative_justice_detainee_conditions",
+		Index:  88,
+		Text:   "rehabilitative_justice_detainee_conditions",
+		Topics: []string{"criminal_justice"},
 		YesWeights: []Weight{
 			{Axis: "rehabilitative_justice", Value: 3},
 		},
@@ -898,8 +987,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 89,
-		Text:  "rehabilitative_justice_data_profiling",
+		Index:  89,
+		Text:   "rehabilitative_justice_data_profiling",
+		Topics: []string{"civil_liberties", "technology"},
 		YesWeights: []Weight{
 			{Axis: "rehabilitative_justice", Value: 3},
 		},
@@ -908,8 +998,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 90,
-		Text:  "rehabilitative_justice_internet_anonymity",
+		Index:  90,
+		Text:   "rehabilitative_justice_internet_anonymity",
+		Topics: []string{"civil_liberties", "technology"},
 		YesWeights: []Weight{
 			{Axis: "rehabilitative_justice", Value: 3},
 		},
@@ -918,8 +1009,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 91,
-		Text:  "punitive_justice_punishment_goal",
+		Index:  91,
+		Text:   "punitive_justice_punishment_goal",
+		Topics: []string{"criminal_justice"},
 		YesWeights: []Weight{
 			{Axis: "punitive_justice", Value: 3},
 		},
@@ -928,8 +1020,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 92,
-		Text:  "punitive_justice_police_armed",
+		Index:  92,
+		Text:   "punitive_justice_police_armed",
+		Topics: []string{"criminal_justice"},
 		YesWeights: []Weight{
 			{Axis: "punitive_justice", Value: 3},
 		},
@@ -938,8 +1031,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 93,
-		Text:  "punitive_justice_terrorism_protection",
+		Index:  93,
+		Text:   "punitive_justice_terrorism_protection",
+		Topics: []string{"civil_liberties"},
 		YesWeights: []Weight{
 			{Axis: "punitive_justice", Value: 3},
 		},
@@ -948,8 +1042,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 94,
-		Text:  "punitive_justice_order_authority",
+		Index:  94,
+		Text:   "punitive_justice_order_authority",
+		Topics: []string{"government"},
 		YesWeights: []Weight{
 			{Axis: "punitive_justice", Value: 3},
 		},
@@ -958,8 +1053,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 95,
-		Text:  "punitive_justice_heavy_penalties_efficacy",
+		Index:  95,
+		Text:   "punitive_justice_heavy_penalties_efficacy",
+		Topics: []string{"criminal_justice"},
 		YesWeights: []Weight{
 			{Axis: "punitive_justice", Value: 3},
 		},
@@ -968,8 +1064,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 96,
-		Text:  "punitive_justice_preventive_arrest",
+		Index:  96,
+		Text:   "punitive_justice_preventive_arrest",
+		Topics: []string{"criminal_justice", "civil_liberties"},
 		YesWeights: []Weight{
 			{Axis: "punitive_justice", Value: 3},
 		},
@@ -978,8 +1075,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 97,
-		Text:  "revolution_general_strike_rights",
+		Index:  97,
+		Text:   "revolution_general_strike_rights",
+		Topics: []string{"activism"},
 		YesWeights: []Weight{
 			{Axis: "revolution", Value: 3},
 		},
@@ -988,8 +1086,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 98,
-		Text:  "revolution_armed_struggle_necessity",
+		Index:  98,
+		Text:   "revolution_armed_struggle_necessity",
+		Topics: []string{"activism"},
 		YesWeights: []Weight{
 			{Axis: "revolution", Value: 3},
 		},
@@ -998,8 +1097,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 99,
-		Text:  "revolution_insurrection_necessity",
+		Index:  99,
+		Text:   "revolution_insurrection_necessity",
+		Topics: []string{"activism"},
 		YesWeights: []Weight{
 			{Axis: "revolution", Value: 3},
 		},
@@ -1008,8 +1108,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 100,
-		Text:  "revolution_political_institutions",
+		Index:  100,
+		Text:   "revolution_political_institutions",
+		Topics: []string{"activism"},
 		YesWeights: []Weight{
 			{Axis: "revolution", Value: 3},
 		},
@@ -1018,8 +1119,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 101,
-		Text:  "revolution_election_challenge",
+		Index:  101,
+		Text:   "revolution_election_challenge",
+		Topics: []string{"activism"},
 		YesWeights: []Weight{
 			{Axis: "revolution", Value: 3},
 		},
@@ -1028,8 +1130,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 102,
-		Text:  "revolution_hacktivism_political",
+		Index:  102,
+		Text:   "revolution_hacktivism_political",
+		Topics: []string{"activism", "technology"},
 		YesWeights: []Weight{
 			{Axis: "revolution", Value: 3},
 		},
@@ -1038,8 +1141,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 103,
-		Text:  "revolution_sabotage_legitimacy",
+		Index:  103,
+		Text:   "revolution_sabotage_legitimacy",
+		Topics: []string{"activism"},
 		YesWeights: []Weight{
 			{Axis: "revolution", Value: 3},
 		},
@@ -1048,8 +1152,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 104,
-		Text:  "reform_lawful_militation",
+		Index:  104,
+		Text:   "reform_lawful_militation",
+		Topics: []string{"activism"},
 		YesWeights: []Weight{
 			{Axis: "reform", Value: 3},
 		},
@@ -1058,8 +1163,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 105,
-		Text:  "reform_revolution_outcome",
+		Index:  105,
+		Text:   "reform_revolution_outcome",
+		Topics: []string{"activism"},
 		YesWeights: []Weight{
 			{Axis: "reform", Value: 3},
 		},
@@ -1068,8 +1174,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 106,
-		Text:  "reform_radical_change_impact",
+		Index:  106,
+		Text:   "reform_radical_change_impact",
+		Topics: []string{"activism"},
 		YesWeights: []Weight{
 			{Axis: "reform", Value: 3},
 		},
@@ -1078,8 +1185,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 107,
-		Text:  "reform_violence_solution",
+		Index:  107,
+		Text:   "reform_violence_solution",
+		Topics: []string{"activism"},
 		YesWeights: []Weight{
 			{Axis: "reform", Value: 3},
 		},
@@ -1088,8 +1196,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 108,
-		Text:  "reform_manifestant_violence",
+		Index:  108,
+		Text:   "reform_manifestant_violence",
+		Topics: []string{"activism"},
 		YesWeights: []Weight{
 			{Axis: "reform", Value: 3},
 		},
@@ -1098,8 +1207,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 109,
-		Text:  "reform_opposition_compromise",
+		Index:  109,
+		Text:   "reform_opposition_compromise",
+		Topics: []string{"activism"},
 		YesWeights: []Weight{
 			{Axis: "reform", Value: 3},
 		},
@@ -1108,8 +1218,9 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 110,
-		Text:  "reform_individual_lifestyle_change",
+		Index:  110,
+		Text:   "reform_individual_lifestyle_change",
+		Topics: []string{"culture"},
 		YesWeights: []Weight{
 			{Axis: "reform", Value: 3},
 		},
@@ -1118,48 +1229,54 @@ var Questions = []Question{
 		},
 	},
 	{
-		Index: 111,
-		Text:  "religion_diffusion",
+		Index:  111,
+		Text:   "religion_diffusion",
+		Topics: []string{"religion"},
 		YesWeights: []Weight{
 			{Axis: "religion", Value: 3},
 		},
 		NoWeights: []Weight{},
 	},
 	{
-		Index: 112,
-		Text:  "complotism_secret_control",
+		Index:  112,
+		Text:   "complotism_secret_control",
+		Topics: []string{"government"},
 		YesWeights: []Weight{
 			{Axis: "complotism", Value: 3},
 		},
 		NoWeights: []Weight{},
 	},
 	{
-		Index: 113,
-		Text:  "pragmatism_policy_approach",
+		Index:  113,
+		Text:   "pragmatism_policy_approach",
+		Topics: []string{"government"},
 		YesWeights: []Weight{
 			{Axis: "pragmatism", Value: 3},
 		},
 		NoWeights: []Weight{},
 	},
 	{
-		Index: 114,
-		Text:  "monarchism_peace_sovereignty",
+		Index:  114,
+		Text:   "monarchism_peace_sovereignty",
+		Topics: []string{"government", "nationalism"},
 		YesWeights: []Weight{
 			{Axis: "monarchism", Value: 3},
 		},
 		NoWeights: []Weight{},
 	},
 	{
-		Index: 115,
-		Text:  "veganism_animal_exploitation",
+		Index:  115,
+		Text:   "veganism_animal_exploitation",
+		Topics: []string{"environment"},
 		YesWeights: []Weight{
 			{Axis: "veganism", Value: 3},
 		},
 		NoWeights: []Weight{},
 	},
 	{
-		Index: 116,
-		Text:  "anarchism_state_abolition",
+		Index:  116,
+		Text:   "anarchism_state_abolition",
+		Topics: []string{"government"},
 		YesWeights: []Weight{
 			{Axis: "anarchism", Value: 3},
 		},
@@ -14,6 +14,96 @@ var RUCopy = map[string]string{
 	"result":          "Результат",
 }
 
+// RUResultsCopy contains Russian translations for the results chart
+var RUResultsCopy = map[string]string{
+	"results_title":                    "Результаты PolitiScales",
+	"political_identity":               "Политическая идентичность",
+	"additional_characteristics":       "Дополнительные характеристики",
+	"political_moderate":               "Политический умеренный",
+	"axis_constructivism":              "Конструктивизм",
+	"axis_essentialism":                "Эссенциализм",
+	"axis_rehabilitative_justice":      "Восстановительное правосудие",
+	"axis_punitive_justice":            "Карательное правосудие",
+	"axis_progressive":                 "Прогрессивизм",
+	"axis_conservative":                "Консерватизм",
+	"axis_internationalism":            "Интернационализм",
+	"axis_nationalism":                 "Национализм",
+	"axis_communism":                   "Коммунизм",
+	"axis_capitalism":                  "Капитализм",
+	"axis_regulation":                  "Регулирование",
+	"axis_laissez_faire":               "Laissez-faire",
+	"axis_ecology":                     "Экология",
+	"axis_production":                  "Производство",
+	"axis_revolution":                  "Революция",
+	"axis_reform":                      "Реформа",
+	"axis_anarchism":                   "Анархизм",
+	"axis_pragmatism":                  "Прагматизм",
+	"axis_feminism":                    "Феминизм",
+	"axis_complotism":                  "Конспирология",
+	"axis_veganism":                    "Веганство",
+	"axis_monarchism":                  "Монархизм",
+	"axis_religion":                    "Религия",
+	"badge_anarchism":                  "Анархист",
+	"badge_pragmatism":                 "Прагматик",
+	"badge_feminism":                   "Феминист",
+	"badge_complotism":                 "Конспиролог",
+	"badge_veganism":                   "Веган",
+	"badge_monarchism":                 "Монархист",
+	"badge_religion":                   "Миссионер",
+	"slogan_constructivism":            "Социальный конструктор",
+	"slogan_essentialism":              "Естественный порядок",
+	"slogan_rehabilitative_justice":    "Восстановительная справедливость",
+	"slogan_punitive_justice":          "Закон и порядок",
+	"slogan_progressive":               "Взгляд вперёд",
+	"slogan_conservative":              "Традиционные ценности",
+	"slogan_internationalism":          "Гражданин мира",
+	"slogan_nationalism":               "Нация прежде всего",
+	"slogan_communism":                 "Трудящиеся, объединяйтесь",
+	"slogan_capitalism":                "Свободные рынки",
+	"slogan_regulation":                "Направляемая экономика",
+	"slogan_laissez_faire":             "Свобода рынка",
+	"slogan_ecology":                   "Зелёное будущее",
+	"slogan_production":                "Прогресс прежде всего",
+	"slogan_revolution":                "Радикальные перемены",
+	"slogan_reform":                    "Постепенный прогресс",
+	"slogan_anarchism":                 "Ни богов, ни господ",
+	"slogan_pragmatism":                "Практичные решения",
+	"slogan_feminism":                  "Гендерное равенство",
+	"slogan_complotism":                "Подвергай всё сомнению",
+	"slogan_veganism":                  "Права животных",
+	"slogan_monarchism":                "Королевская традиция",
+	"slogan_religion":                  "Верный последователь",
+	"identity_format":                  "{adjective} {noun}",
+	"adjective_constructivism":         "Конструктивистский",
+	"adjective_essentialism":           "Эссенциалистский",
+	"adjective_rehabilitative_justice": "Гуманистический",
+	"adjective_punitive_justice":       "Авторитарный",
+	"adjective_progressive":            "Прогрессивный",
+	"adjective_conservative":           "Консервативный",
+	"adjective_internationalism":       "Интернационалистский",
+	"adjective_nationalism":            "Националистический",
+	"adjective_communism":              "Коллективистский",
+	"adjective_capitalism":             "Капиталистический",
+	"adjective_regulation":             "Интервенционистский",
+	"adjective_laissez_faire":          "Рыночный",
+	"adjective_ecology":                "Экологический",
+	"adjective_production":             "Продуктивистский",
+	"adjective_revolution":             "Революционный",
+	"adjective_reform":                 "Реформистский",
+	"adjective_anarchism":              "Анархистский",
+	"adjective_pragmatism":             "Прагматичный",
+	"adjective_feminism":               "Феминистский",
+	"adjective_complotism":             "Конспирологический",
+	"adjective_veganism":               "Веганский",
+	"adjective_monarchism":             "Монархический",
+	"adjective_religion":               "Религиозный",
+	"noun_communism":                   "социалист",
+	"noun_capitalism":                  "капиталист",
+	"noun_regulation":                  "социал-демократ",
+	"noun_laissez_faire":               "либертарианец",
+	"noun_moderate":                    "центрист",
+}
+
 // RUQuestions is a map of Russian Politiscales questions.
 var RUQuestions = map[string]string{
 	"constructivism_becoming_woman":               "“Женщиной становятся, а не рождаются.”",
@@ -14,6 +14,96 @@ var ZHCopy = map[string]string{
 	"result":          "结果",
 }
 
+// ZHResultsCopy contains Chinese translations for the results chart
+var ZHResultsCopy = map[string]string{
+	"results_title":                    "PolitiScales 结果",
+	"political_identity":               "政治身份",
+	"additional_characteristics":       "其他特征",
+	"political_moderate":               "政治温和派",
+	"axis_constructivism":              "建构主义",
+	"axis_essentialism":                "本质主义",
+	"axis_rehabilitative_justice":      "矫治型司法",
+	"axis_punitive_justice":            "惩罚型司法",
+	"axis_progressive":                 "进步主义",
+	"axis_conservative":                "保守主义",
+	"axis_internationalism":            "国际主义",
+	"axis_nationalism":                 "民族主义",
+	"axis_communism":                   "共产主义",
+	"axis_capitalism":                  "资本主义",
+	"axis_regulation":                  "管制",
+	"axis_laissez_faire":               "自由放任",
+	"axis_ecology":                     "生态",
+	"axis_production":                  "生产",
+	"axis_revolution":                  "革命",
+	"axis_reform":                      "改革",
+	"axis_anarchism":                   "无政府主义",
+	"axis_pragmatism":                  "实用主义",
+	"axis_feminism":                    "女权主义",
+	"axis_complotism":                  "阴谋论",
+	"axis_veganism":                    "纯素主义",
+	"axis_monarchism":                  "君主主义",
+	"axis_religion":                    "宗教",
+	"badge_anarchism":                  "无政府主义者",
+	"badge_pragmatism":                 "实用主义者",
+	"badge_feminism":                   "女权主义者",
+	"badge_complotism":                 "阴谋论者",
+	"badge_veganism":                   "纯素者",
+	"badge_monarchism":                 "君主主义者",
+	"badge_religion":                   "传教者",
+	"slogan_constructivism":            "社会建构者",
+	"slogan_essentialism":              "自然秩序",
+	"slogan_rehabilitative_justice":    "修复性正义",
+	"slogan_punitive_justice":          "法律与秩序",
+	"slogan_progressive":               "前瞻思维",
+	"slogan_conservative":              "传统价值",
+	"slogan_internationalism":          "世界公民",
+	"slogan_nationalism":               "国家至上",
+	"slogan_communism":                 "工人团结",
+	"slogan_capitalism":                "自由市场",
+	"slogan_regulation":                "引导型经济",
+	"slogan_laissez_faire":             "市场自由",
+	"slogan_ecology":                   "绿色未来",
+	"slogan_production":                "进步优先",
+	"slogan_revolution":                "彻底变革",
+	"slogan_reform":                    "渐进发展",
+	"slogan_anarchism":                 "没有神也没有主人",
+	"slogan_pragmatism":                "务实方案",
+	"slogan_feminism":                  "性别平等",
+	"slogan_complotism":                "质疑一切",
+	"slogan_veganism":                  "动物权利",
+	"slogan_monarchism":                "王室传统",
+	"slogan_religion":                  "虔诚信徒",
+	"identity_format":                  "{adjective}{noun}",
+	"adjective_constructivism":         "建构主义",
+	"adjective_essentialism":           "本质主义",
+	"adjective_rehabilitative_justice": "人道主义",
+	"adjective_punitive_justice":       "威权主义",
+	"adjective_progressive":            "进步主义",
+	"adjective_conservative":           "保守主义",
+	"adjective_internationalism":       "国际主义",
+	"adjective_nationalism":            "民族主义",
+	"adjective_communism":              "集体主义",
+	"adjective_capitalism":             "资本主义",
+	"adjective_regulation":             "干预主义",
+	"adjective_laissez_faire":          "自由市场",
+	"adjective_ecology":                "生态",
+	"adjective_production":             "生产主义",
+	"adjective_revolution":             "革命",
+	"adjective_reform":                 "改良主义",
+	"adjective_anarchism":              "无政府主义",
+	"adjective_pragmatism":             "务实",
+	"adjective_feminism":               "女权主义",
+	"adjective_complotism":             "阴谋论",
+	"adjective_veganism":               "纯素",
+	"adjective_monarchism":             "君主主义",
+	"adjective_religion":               "宗教",
+	"noun_communism":                   "社会主义者",
+	"noun_capitalism":                  "资本主义者",
+	"noun_regulation":                  "社会民主主义者",
+	"noun_laissez_faire":               "自由意志主义者",
+	"noun_moderate":                    "温和派",
+}
+
 // ZHQuestions is a map of Chinese Politiscales questions.
 var ZHQuestions = map[string]string{
 	"constructivism_becoming_woman":               "女性不是天生的，而是被建构的。",
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
@@ -134,3 +135,29 @@ func TestPolitiscalesScoring(t *testing.T) {
 		t.Error("Expected English question translations to exist")
 	}
 }
+
+func TestPolitiscalesArabicResultsChart(t *testing.T) {
+	resetState()
+	politiscalesLanguage = "ar"
+	defer func() { politiscalesLanguage = "en" }()
+
+	handlePolitiscales(context.Background(), createRequestWithAnswer(""))
+	var content string
+	for i := 0; i < len(politiscales.Questions); i++ {
+		response, err := handlePolitiscales(context.Background(), createRequestWithAnswer("agree"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		content = extractTextContent(response)
+	}
+
+	if !strings.Contains(content, "Politiscales Quiz Complete!") {
+		t.Fatal("expected quiz completion")
+	}
+	if !strings.Contains(content, `direction="rtl"`) {
+		t.Error("Arabic completion chart should be right-to-left")
+	}
+	if !strings.Contains(content, politiscales.ARResultsCopy["political_identity"]) {
+		t.Error("Arabic completion chart should use Arabic headings")
+	}
+}
@@ -0,0 +1,237 @@
+// Package raster turns the SVG charts drawn by this server into PNG images, for
+// MCP clients that cannot render inline SVG markup.
+//
+// Only the subset of SVG the chart generators emit is supported: svg, g, rect,
+// circle, ellipse, line, polyline, polygon, path and text elements, presentation
+// attributes, class rules from a style element, and affine transforms. Text is set
+// in the embedded Go fonts; symbols they lack, such as emoji, are left out, but
+// text in a script they lack, such as Arabic or Chinese, fails with
+// ErrMissingGlyphs rather than drawing a chart with its words missing.
+package raster
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"strings"
+)
+
+// MaxSize is the largest width or height, in pixels, an SVG document may declare
+const MaxSize = 4096
+
+// ErrMissingGlyphs reports text with letters the embedded fonts cannot draw
+var ErrMissingGlyphs = errors.New("text has letters the embedded fonts cannot draw")
+
+// PNG rasterises an SVG document and encodes it as a PNG image
+func PNG(svg string) ([]byte, error) {
+	img, err := Render(svg)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Render rasterises an SVG document onto a new image the size of its svg element
+func Render(svg string) (*image.RGBA, error) {
+	r := &renderer{rules: map[string]map[string]string{}, faces: map[faceKey]*textFace{}}
+	decoder := xml.NewDecoder(strings.NewReader(svg))
+	decoder.Entity = xml.HTMLEntity
+
+	stack := []style{defaultStyle()}
+	var css strings.Builder
+	var text *textRun
+	inStyle, defsDepth := false, 0
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid SVG: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			name, attrs := t.Name.Local, attributes(t)
+			s := stack[len(stack)-1].apply(r.properties(name, attrs))
+			if transform, ok := attrs["transform"]; ok {
+				m, err := parseTransform(transform)
+				if err != nil {
+					return nil, err
+				}
+				s.transform = s.transform.multiply(m)
+			}
+			if name == "svg" && r.canvas == nil {
+				viewBox, err := r.createCanvas(attrs)
+				if err != nil {
+					return nil, err
+				}
+				s.transform = viewBox.multiply(s.transform)
+			}
+			stack = append(stack, s)
+
+			switch {
+			case name == "style":
+				inStyle = true
+			case name == "defs" || defsDepth > 0:
+				defsDepth++
+			case r.canvas == nil:
+				return nil, fmt.Errorf("invalid SVG: <%s> outside an <svg> element", name)
+			case name == "text":
+				text = &textRun{x: number(attrs["x"]), y: number(attrs["y"]), style: s}
+			case text == nil:
+				if err := r.drawShape(name, attrs, s); err != nil {
+					return nil, err
+				}
+			}
+
+		case xml.CharData:
+			if inStyle {
+				css.Write(t)
+			} else if text != nil {
+				text.content.Write(t)
+			}
+
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+			switch name := t.Name.Local; {
+			case name == "style":
+				inStyle = false
+				r.parseRules(css.String())
+				css.Reset()
+			case defsDepth > 0:
+				defsDepth--
+			case name == "text" && text != nil:
+				r.drawText(text)
+				text = nil
+			}
+		}
+	}
+
+	if r.canvas == nil {
+		return nil, fmt.Errorf("invalid SVG: no <svg> element")
+	}
+	if len(r.missing) > 0 {
+		return nil, fmt.Errorf("%w: %q", ErrMissingGlyphs, string(r.missing[:min(len(r.missing), 8)]))
+	}
+	return r.canvas, nil
+}
+
+// renderer holds the canvas and stylesheet while a document is drawn
+type renderer struct {
+	canvas  *image.RGBA
+	rules   map[string]map[string]string // Selector -> property -> value
+	faces   map[faceKey]*textFace
+	missing []rune // Letters left out of text for want of a glyph
+}
+
+// createCanvas sizes the canvas from the root svg element and returns the
+// transform from its viewBox, if any, onto the canvas
+func (r *renderer) createCanvas(attrs map[string]string) (matrix, error) {
+	viewBox := numbers(attrs["viewBox"])
+	width, height := number(attrs["width"]), number(attrs["height"])
+	if len(viewBox) == 4 && (width == 0 || height == 0) {
+		width, height = viewBox[2], viewBox[3]
+	}
+	if width <= 0 || height <= 0 || width > MaxSize || height > MaxSize {
+		return identity, fmt.Errorf("invalid SVG size %gx%g: width and height must be between 1 and %d", width, height, MaxSize)
+	}
+	r.canvas = image.NewRGBA(image.Rect(0, 0, int(width+0.5), int(height+0.5)))
+
+	if len(viewBox) != 4 || viewBox[2] <= 0 || viewBox[3] <= 0 {
+		return identity, nil
+	}
+	return matrix{width / viewBox[2], 0, 0, height / viewBox[3], 0, 0}.
+		multiply(matrix{1, 0, 0, 1, -viewBox[0], -viewBox[1]}), nil
+}
+
+// attributes collects an element's attributes by local name
+func attributes(element xml.StartElement) map[string]string {
+	attrs := make(map[string]string, len(element.Attr))
+	for _, attr := range element.Attr {
+		attrs[attr.Name.Local] = attr.Value
+	}
+	return attrs
+}
+
+// properties resolves an element's style properties: presentation attributes,
+// overridden by stylesheet rules for its tag and classes, overridden by its style
+// attribute, as in CSS
+func (r *renderer) properties(name string, attrs map[string]string) map[string]string {
+	props := map[string]string{}
+	for key, value := range attrs {
+		if styleProperties[key] {
+			props[key] = value
+		}
+	}
+	selectors := []string{name}
+	for _, class := range strings.Fields(attrs["class"]) {
+		selectors = append(selectors, "."+class)
+	}
+	for _, selector := range selectors {
+		for key, value := range r.rules[selector] {
+			props[key] = value
+		}
+	}
+	for key, value := range declarations(attrs["style"]) {
+		props[key] = value
+	}
+	return props
+}
+
+// parseRules adds the rules of a style element to the stylesheet. Only tag and
+// class selectors are understood.
+func (r *renderer) parseRules(css string) {
+	for {
+		start := strings.Index(css, "/*")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(css[start+2:], "*/")
+		if end < 0 {
+			css = css[:start]
+			break
+		}
+		css = css[:start] + css[start+2+end+2:]
+	}
+
+	for _, block := range strings.Split(css, "}") {
+		selectors, body, found := strings.Cut(block, "{")
+		if !found {
+			continue
+		}
+		decls := declarations(body)
+		for _, selector := range strings.Split(selectors, ",") {
+			selector = strings.TrimSpace(selector)
+			if selector == "" {
+				continue
+			}
+			if r.rules[selector] == nil {
+				r.rules[selector] = map[string]string{}
+			}
+			for key, value := range decls {
+				r.rules[selector][key] = value
+			}
+		}
+	}
+}
+
+// declarations parses "property: value; ..." CSS declarations
+func declarations(body string) map[string]string {
+	decls := map[string]string{}
+	for _, decl := range strings.Split(body, ";") {
+		key, value, found := strings.Cut(decl, ":")
+		if key = strings.TrimSpace(key); found && key != "" {
+			decls[key] = strings.TrimSpace(value)
+		}
+	}
+	return decls
+}
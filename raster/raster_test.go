@@ -0,0 +1,229 @@
+package raster
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/x86ed/MCP-PoliticalCompass/v3/eightvalues"
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+// render draws an SVG document, failing the test on error
+func render(t *testing.T, svg string) *image.RGBA {
+	t.Helper()
+	img, err := Render(svg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return img
+}
+
+// expectColor checks a pixel's colour, allowing for rounding
+func expectColor(t *testing.T, img *image.RGBA, x, y int, expected color.RGBA) {
+	t.Helper()
+	got := img.RGBAAt(x, y)
+	for i, pair := range [][2]uint8{{got.R, expected.R}, {got.G, expected.G}, {got.B, expected.B}, {got.A, expected.A}} {
+		if math.Abs(float64(pair[0])-float64(pair[1])) > 2 {
+			t.Errorf("pixel (%d, %d): expected %v, got %v (channel %d)", x, y, expected, got, i)
+			return
+		}
+	}
+}
+
+// inked counts the pixels in a region that differ from a background colour
+func inked(img *image.RGBA, region image.Rectangle, background color.RGBA) int {
+	count := 0
+	for y := region.Min.Y; y < region.Max.Y; y++ {
+		for x := region.Min.X; x < region.Max.X; x++ {
+			if img.RGBAAt(x, y) != background {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+var (
+	white = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	red   = color.RGBA{0xff, 0, 0, 0xff}
+	blue  = color.RGBA{0, 0, 0xff, 0xff}
+)
+
+func TestRenderShapes(t *testing.T) {
+	img := render(t, `<svg width="100" height="60" xmlns="http://www.w3.org/2000/svg">
+  <!-- Background -->
+  <rect width="100" height="60" fill="white"/>
+  <rect x="10" y="10" width="20" height="20" fill="#f00" opacity="0.5"/>
+  <circle cx="60" cy="20" r="10" fill="#0000ff" stroke="#000000" stroke-width="2"/>
+  <line x1="0" y1="50" x2="100" y2="50" stroke="red" stroke-width="4"/>
+  <polygon points="80,30 95,30 95,45" fill="none" stroke="blue"/>
+</svg>`)
+
+	if img.Bounds() != image.Rect(0, 0, 100, 60) {
+		t.Fatalf("expected a 100x60 image, got %v", img.Bounds())
+	}
+	expectColor(t, img, 5, 5, white)
+	expectColor(t, img, 20, 20, color.RGBA{0xff, 0x80, 0x80, 0xff})
+	expectColor(t, img, 60, 20, blue)
+	expectColor(t, img, 60, 10, color.RGBA{0, 0, 0, 0xff})
+	expectColor(t, img, 50, 49, red)
+	expectColor(t, img, 50, 53, white)
+	// An unfilled polygon is only outlined
+	expectColor(t, img, 92, 40, white)
+	if img.RGBAAt(94, 35) == white {
+		t.Error("expected the polygon's outline to be drawn")
+	}
+}
+
+func TestRenderDashesAndPaths(t *testing.T) {
+	img := render(t, `<svg width="40" height="40"><rect width="40" height="40" fill="white"/>
+  <line x1="0" y1="5" x2="40" y2="5" stroke="red" stroke-width="2" stroke-dasharray="4 6"/>
+  <path d="M10 20 h20 v10 h-20 Z" fill="blue"/>
+</svg>`)
+	expectColor(t, img, 2, 5, red)
+	expectColor(t, img, 7, 5, white)
+	expectColor(t, img, 12, 5, red)
+	expectColor(t, img, 20, 25, blue)
+	expectColor(t, img, 20, 35, white)
+}
+
+func TestRenderGroupsAndViewBox(t *testing.T) {
+	// The viewBox doubles everything, and the group moves and scales its children
+	img := render(t, `<svg width="80" height="80" viewBox="0 0 40 40"><rect width="40" height="40" fill="white"/>
+  <g transform="translate(10 10) scale(2)" fill="red"><rect width="5" height="5"/></g>
+</svg>`)
+	expectColor(t, img, 25, 25, red)
+	expectColor(t, img, 38, 38, red)
+	expectColor(t, img, 42, 42, white)
+	expectColor(t, img, 15, 15, white)
+}
+
+func TestRenderStyleRules(t *testing.T) {
+	// As in CSS, class rules override presentation attributes
+	img := render(t, `<svg width="20" height="20"><defs><style>
+  /* a comment */ .box { fill: #0000ff; }
+</style><rect width="20" height="20" fill="red"/></defs>
+  <rect width="20" height="20" class="box" fill="red"/>
+</svg>`)
+	expectColor(t, img, 10, 10, blue)
+}
+
+func TestRenderText(t *testing.T) {
+	base := `<svg width="200" height="60"><rect width="200" height="60" fill="white"/>%s</svg>`
+	text := func(element string) *image.RGBA {
+		return render(t, strings.Replace(base, "%s", element, 1))
+	}
+	left, right := image.Rect(0, 0, 100, 60), image.Rect(100, 0, 200, 60)
+
+	img := text(`<text x="100" y="30" font-size="20" fill="#000">  Hello
+    world </text>`)
+	if inked(img, left, white) > 0 || inked(img, right, white) == 0 {
+		t.Error("expected start-anchored text to run right of its anchor")
+	}
+	img = text(`<text x="100" y="30" font-size="20" text-anchor="end">Hello</text>`)
+	if inked(img, left, white) == 0 || inked(img, right, white) > 0 {
+		t.Error("expected end-anchored text to end at its anchor")
+	}
+	img = text(`<g direction="rtl"><text x="100" y="30" font-size="20">Hello</text></g>`)
+	if inked(img, left, white) == 0 || inked(img, right, white) > 0 {
+		t.Error("expected right-to-left text to start at its anchor and run leftwards")
+	}
+
+	// Rotated text runs up the page
+	img = text(`<text x="100" y="30" font-size="12" text-anchor="middle" transform="rotate(-90 100 30)">Rotated label</text>`)
+	if inked(img, image.Rect(0, 0, 85, 60), white) > 0 || inked(img, image.Rect(85, 0, 105, 60), white) == 0 {
+		t.Error("expected rotated text in a narrow upright strip")
+	}
+
+	// Characters missing from the font are left out rather than drawn as boxes
+	img = text(`<text x="10" y="30" font-size="20">💰</text>`)
+	if inked(img, img.Bounds(), white) > 0 {
+		t.Error("expected an emoji to be left out")
+	}
+}
+
+func TestRenderMissingScripts(t *testing.T) {
+	results := map[string]float64{"constructivism": 50, "essentialism": 20, "monarchism": 80}
+
+	// The English chart draws its heading in the title area
+	img := render(t, politiscales.GeneratePolitiscalesResultsSVGWithOptions(results, politiscales.SVGOptions{}))
+	if inked(img, image.Rect(250, 15, 550, 50), color.RGBA{0xf8, 0xf9, 0xfa, 0xff}) == 0 {
+		t.Error("expected the heading to be drawn in the title area")
+	}
+
+	// The Arabic chart's letters have no glyph, so it fails rather than drawing an empty title area
+	_, err := Render(politiscales.GeneratePolitiscalesResultsSVGWithOptions(results, politiscales.SVGOptions{Language: "ar"}))
+	if !errors.Is(err, ErrMissingGlyphs) {
+		t.Errorf("expected ErrMissingGlyphs for an Arabic chart, got %v", err)
+	}
+	if _, err := Render(`<svg width="100" height="40"><text x="10" y="30">政治</text></svg>`); !errors.Is(err, ErrMissingGlyphs) {
+		t.Errorf("expected ErrMissingGlyphs for Chinese text, got %v", err)
+	}
+}
+
+func TestRenderErrors(t *testing.T) {
+	for name, svg := range map[string]string{
+		"not XML":        `<svg width="10" height="10"><rect`,
+		"no svg element": `<rect width="10" height="10"/>`,
+		"no size":        `<svg><rect width="10" height="10"/></svg>`,
+		"too large":      `<svg width="100000" height="10"></svg>`,
+		"bad path":       `<svg width="10" height="10"><path d="M0 0 X 5 5"/></svg>`,
+		"bad transform":  `<svg width="10" height="10"><g transform="spin(90)"></g></svg>`,
+	} {
+		if _, err := Render(svg); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestParsePath(t *testing.T) {
+	paths, err := parsePath("M0 0 L10 0 l0 10 H0 z m20 20 c0 5 5 10 10 10 s10 -5 10 -10 Q40 0 50 0 T60 0 v-1e1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(paths) != 2 || !paths[0].closed || len(paths[0].points) != 4 || paths[1].closed {
+		t.Fatalf("unexpected subpaths: %+v", paths)
+	}
+	if last := paths[1].points[len(paths[1].points)-1]; math.Abs(last.x-60) > 1e-9 || math.Abs(last.y+10) > 1e-9 {
+		t.Errorf("expected the path to end at (60, -10), got %v", last)
+	}
+	if tokens := pathTokens("M-4.5.5-1e-2"); strings.Join(tokens, " ") != "M -4.5 .5 -1e-2" {
+		t.Errorf("unexpected tokens %q", tokens)
+	}
+}
+
+func TestPNGCharts(t *testing.T) {
+	charts := map[string]string{
+		"political compass": politicalcompass.GenerateSVG(3, -2),
+		"8values":           eightvalues.GenerateSVG(60, 40, 70, 30),
+		"politiscales":      politiscales.GeneratePolitiscalesResultsSVG(map[string]float64{"communism": 30, "capitalism": 60, "religion": 80}),
+		"flag":              politiscales.GenerateFlagSVG(map[string]float64{"ecology": 70, "capitalism": 60, "religion": 80}),
+	}
+	for name, svg := range charts {
+		data, err := PNG(svg)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		img, err := png.Decode(bytes.NewReader(data))
+		if err != nil {
+			t.Errorf("%s: invalid PNG: %v", name, err)
+			continue
+		}
+		size := regexp.MustCompile(`<svg width="(\d+)" height="(\d+)"`).FindStringSubmatch(svg)
+		width, _ := strconv.Atoi(size[1])
+		height, _ := strconv.Atoi(size[2])
+		if img.Bounds() != image.Rect(0, 0, width, height) {
+			t.Errorf("%s: expected a %dx%d image, got %v", name, width, height, img.Bounds())
+		}
+	}
+}
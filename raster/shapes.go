@@ -0,0 +1,518 @@
+package raster
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"strconv"
+	"strings"
+
+	"golang.org/x/image/vector"
+)
+
+// point is a position in user or canvas space
+type point struct{ x, y float64 }
+
+// subpath is a polyline, closed back to its first point or left open
+type subpath struct {
+	points []point
+	closed bool
+}
+
+// curveSteps is the number of line segments a Bézier curve is flattened into
+const curveSteps = 24
+
+// drawShape fills and strokes a basic shape or path element
+func (r *renderer) drawShape(name string, attrs map[string]string, s style) error {
+	var paths []subpath
+	switch name {
+	case "rect":
+		paths = rectangle(number(attrs["x"]), number(attrs["y"]), number(attrs["width"]), number(attrs["height"]),
+			number(attrs["rx"]), number(attrs["ry"]), attrs["rx"] != "", attrs["ry"] != "")
+	case "circle":
+		radius := number(attrs["r"])
+		paths = ellipse(number(attrs["cx"]), number(attrs["cy"]), radius, radius, s.transform.scale())
+	case "ellipse":
+		paths = ellipse(number(attrs["cx"]), number(attrs["cy"]), number(attrs["rx"]), number(attrs["ry"]), s.transform.scale())
+	case "line":
+		paths = []subpath{{points: []point{{number(attrs["x1"]), number(attrs["y1"])}, {number(attrs["x2"]), number(attrs["y2"])}}}}
+	case "polyline", "polygon":
+		coords := numbers(attrs["points"])
+		path := subpath{closed: name == "polygon"}
+		for i := 0; i+1 < len(coords); i += 2 {
+			path.points = append(path.points, point{coords[i], coords[i+1]})
+		}
+		paths = []subpath{path}
+	case "path":
+		var err error
+		if paths, err = parsePath(attrs["d"]); err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+
+	if !s.fill.none && name != "line" && name != "polyline" {
+		var polygons [][]point
+		for _, path := range paths {
+			polygons = append(polygons, transformPoints(path.points, s.transform))
+		}
+		r.fill(polygons, s.fill.alpha(s.fillOpacity*s.opacity), false)
+	}
+	if !s.stroke.none && s.strokeWidth > 0 {
+		var polygons [][]point
+		for _, path := range dashPaths(paths, s.dashes) {
+			path.points = transformPoints(path.points, s.transform)
+			polygons = append(polygons, strokePolygons(path, s.strokeWidth*s.transform.scale(), s.lineCap, s.lineJoin)...)
+		}
+		r.fill(polygons, s.stroke.alpha(s.strokeOpacity*s.opacity), true)
+	}
+	return nil
+}
+
+// fill paints polygons onto the canvas. With union set every polygon is turned the
+// same way round, so overlaps add up instead of cancelling out.
+func (r *renderer) fill(polygons [][]point, c color.NRGBA, union bool) {
+	if c.A == 0 || len(polygons) == 0 {
+		return
+	}
+	minX, minY, maxX, maxY := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+	for _, polygon := range polygons {
+		for _, p := range polygon {
+			minX, minY = math.Min(minX, p.x), math.Min(minY, p.y)
+			maxX, maxY = math.Max(maxX, p.x), math.Max(maxY, p.y)
+		}
+	}
+	bounds := image.Rect(int(math.Floor(minX)), int(math.Floor(minY)), int(math.Ceil(maxX)), int(math.Ceil(maxY))).
+		Intersect(r.canvas.Bounds())
+	if bounds.Empty() {
+		return
+	}
+
+	z := vector.NewRasterizer(bounds.Dx(), bounds.Dy())
+	z.DrawOp = draw.Over
+	offsetX, offsetY := float64(bounds.Min.X), float64(bounds.Min.Y)
+	for _, polygon := range polygons {
+		if len(polygon) < 3 {
+			continue
+		}
+		reversed := union && signedArea(polygon) < 0
+		for i := range polygon {
+			p := polygon[i]
+			if reversed {
+				p = polygon[len(polygon)-1-i]
+			}
+			if i == 0 {
+				z.MoveTo(float32(p.x-offsetX), float32(p.y-offsetY))
+			} else {
+				z.LineTo(float32(p.x-offsetX), float32(p.y-offsetY))
+			}
+		}
+		z.ClosePath()
+	}
+	z.Draw(r.canvas, bounds, image.NewUniform(c), image.Point{})
+}
+
+// signedArea returns a polygon's area, positive when it turns clockwise on screen
+func signedArea(polygon []point) float64 {
+	area := 0.0
+	for i, p := range polygon {
+		q := polygon[(i+1)%len(polygon)]
+		area += p.x*q.y - q.x*p.y
+	}
+	return area / 2
+}
+
+// transformPoints maps points through a transform
+func transformPoints(points []point, m matrix) []point {
+	out := make([]point, len(points))
+	for i, p := range points {
+		out[i] = m.apply(p)
+	}
+	return out
+}
+
+// rectangle outlines a rect, with corners rounded by rx and ry
+func rectangle(x, y, width, height, rx, ry float64, hasRX, hasRY bool) []subpath {
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+	if !hasRY {
+		ry = rx
+	} else if !hasRX {
+		rx = ry
+	}
+	rx, ry = math.Max(0, math.Min(rx, width/2)), math.Max(0, math.Min(ry, height/2))
+	if rx == 0 || ry == 0 {
+		return []subpath{{points: []point{{x, y}, {x + width, y}, {x + width, y + height}, {x, y + height}}, closed: true}}
+	}
+
+	var points []point
+	corners := []struct{ cx, cy, start float64 }{
+		{x + width - rx, y + ry, -90}, {x + width - rx, y + height - ry, 0},
+		{x + rx, y + height - ry, 90}, {x + rx, y + ry, 180},
+	}
+	for _, corner := range corners {
+		for step := 0; step <= curveSteps/4; step++ {
+			sin, cos := math.Sincos((corner.start + 90*float64(step)/float64(curveSteps/4)) * math.Pi / 180)
+			points = append(points, point{corner.cx + rx*cos, corner.cy + ry*sin})
+		}
+	}
+	return []subpath{{points: points, closed: true}}
+}
+
+// ellipse outlines a circle or ellipse finely enough for its size on the canvas
+func ellipse(cx, cy, rx, ry, scale float64) []subpath {
+	if rx <= 0 || ry <= 0 {
+		return nil
+	}
+	steps := int(math.Max(rx, ry) * scale * 1.5)
+	steps = max(16, min(steps, 360))
+	points := make([]point, steps)
+	for i := range points {
+		sin, cos := math.Sincos(2 * math.Pi * float64(i) / float64(steps))
+		points[i] = point{cx + rx*cos, cy + ry*sin}
+	}
+	return []subpath{{points: points, closed: true}}
+}
+
+// parsePath flattens path data into subpaths. It supports the M, L, H, V, C, S, Q,
+// T and Z commands in absolute and relative form; curves become line segments.
+func parsePath(d string) ([]subpath, error) {
+	tokens := pathTokens(d)
+	var paths []subpath
+	var current subpath
+	var pen, start, control point
+	var command byte
+	finish := func() {
+		if len(current.points) > 1 {
+			paths = append(paths, current)
+		}
+		current = subpath{}
+	}
+	next := func() (float64, error) {
+		if len(tokens) == 0 || isCommand(tokens[0]) {
+			return 0, fmt.Errorf("invalid SVG path %q: command %c is missing a number", d, command)
+		}
+		n, err := strconv.ParseFloat(tokens[0], 64)
+		tokens = tokens[1:]
+		if err != nil {
+			return 0, fmt.Errorf("invalid SVG path %q: %w", d, err)
+		}
+		return n, nil
+	}
+	pair := func(relative bool) (point, error) {
+		x, err := next()
+		if err != nil {
+			return point{}, err
+		}
+		y, err := next()
+		if relative {
+			x, y = x+pen.x, y+pen.y
+		}
+		return point{x, y}, err
+	}
+
+	for len(tokens) > 0 {
+		if isCommand(tokens[0]) {
+			command = tokens[0][0]
+			tokens = tokens[1:]
+		} else if command == 0 {
+			return nil, fmt.Errorf("invalid SVG path %q: it must start with a command", d)
+		}
+		relative := command >= 'a'
+		previous := control
+		control = point{math.NaN(), math.NaN()}
+
+		switch command | 0x20 {
+		case 'm':
+			p, err := pair(relative)
+			if err != nil {
+				return nil, err
+			}
+			finish()
+			pen, start = p, p
+			current.points = []point{p}
+			// Further coordinate pairs after a move are line segments
+			command = 'L' | command&0x20
+			continue
+		case 'l':
+			p, err := pair(relative)
+			if err != nil {
+				return nil, err
+			}
+			pen = p
+		case 'h':
+			x, err := next()
+			if err != nil {
+				return nil, err
+			}
+			if relative {
+				x += pen.x
+			}
+			pen.x = x
+		case 'v':
+			y, err := next()
+			if err != nil {
+				return nil, err
+			}
+			if relative {
+				y += pen.y
+			}
+			pen.y = y
+		case 'c', 's':
+			var c1 point
+			if command|0x20 == 's' {
+				c1 = reflect(previous, pen)
+			} else {
+				var err error
+				if c1, err = pair(relative); err != nil {
+					return nil, err
+				}
+			}
+			c2, err := pair(relative)
+			if err != nil {
+				return nil, err
+			}
+			end, err := pair(relative)
+			if err != nil {
+				return nil, err
+			}
+			current.points = append(current.points, cubic(pen, c1, c2, end)...)
+			pen, control = end, c2
+			continue
+		case 'q', 't':
+			var c point
+			if command|0x20 == 't' {
+				c = reflect(previous, pen)
+			} else {
+				var err error
+				if c, err = pair(relative); err != nil {
+					return nil, err
+				}
+			}
+			end, err := pair(relative)
+			if err != nil {
+				return nil, err
+			}
+			// A quadratic curve is a cubic with its control points two thirds of the way to c
+			c1 := point{pen.x + 2*(c.x-pen.x)/3, pen.y + 2*(c.y-pen.y)/3}
+			c2 := point{end.x + 2*(c.x-end.x)/3, end.y + 2*(c.y-end.y)/3}
+			current.points = append(current.points, cubic(pen, c1, c2, end)...)
+			pen, control = end, c
+			continue
+		case 'z':
+			if len(tokens) > 0 && !isCommand(tokens[0]) {
+				return nil, fmt.Errorf("invalid SVG path %q: command Z takes no numbers", d)
+			}
+			current.closed = true
+			finish()
+			pen = start
+			current.points = []point{start}
+			continue
+		default:
+			return nil, fmt.Errorf("invalid SVG path %q: unsupported command %c", d, command)
+		}
+		current.points = append(current.points, pen)
+	}
+	finish()
+	return paths, nil
+}
+
+// pathTokens splits path data into commands and numbers
+func pathTokens(d string) []string {
+	var tokens []string
+	for i := 0; i < len(d); {
+		c := d[i]
+		switch {
+		case c == ' ' || c == ',' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case isCommand(string(c)):
+			tokens = append(tokens, string(c))
+			i++
+		default:
+			// A number runs until the next separator, command or second sign or point
+			j, seenPoint, seenExponent := i+1, c == '.', false
+			for ; j < len(d); j++ {
+				c := d[j]
+				if c == '.' && !seenPoint && !seenExponent {
+					seenPoint = true
+				} else if (c == 'e' || c == 'E') && !seenExponent {
+					seenExponent = true
+				} else if (c == '-' || c == '+') && (d[j-1] == 'e' || d[j-1] == 'E') {
+				} else if c < '0' || c > '9' {
+					break
+				}
+			}
+			tokens = append(tokens, d[i:j])
+			i = j
+		}
+	}
+	return tokens
+}
+
+// isCommand reports whether a path token is a command letter
+func isCommand(token string) bool {
+	return len(token) == 1 && strings.ContainsRune("MmLlHhVvCcSsQqTtZzAa", rune(token[0]))
+}
+
+// reflect mirrors the previous curve's control point about the pen, or returns the
+// pen when the previous command was not a curve
+func reflect(control, pen point) point {
+	if math.IsNaN(control.x) {
+		return pen
+	}
+	return point{2*pen.x - control.x, 2*pen.y - control.y}
+}
+
+// cubic flattens a cubic Bézier curve, returning the points after its start
+func cubic(p0, p1, p2, p3 point) []point {
+	points := make([]point, curveSteps)
+	for i := range points {
+		t := float64(i+1) / curveSteps
+		u := 1 - t
+		a, b, c, d := u*u*u, 3*u*u*t, 3*u*t*t, t*t*t
+		points[i] = point{a*p0.x + b*p1.x + c*p2.x + d*p3.x, a*p0.y + b*p1.y + c*p2.y + d*p3.y}
+	}
+	return points
+}
+
+// dashPaths splits subpaths into the dashes of a dash pattern, in user space
+func dashPaths(paths []subpath, dashes []float64) []subpath {
+	if len(dashes) == 0 {
+		return paths
+	}
+	var out []subpath
+	for _, path := range paths {
+		points := path.points
+		if path.closed && len(points) > 0 {
+			points = append(append([]point(nil), points...), points[0])
+		}
+		index, remaining, on := 0, dashes[0], true
+		var dash []point
+		if len(points) > 0 {
+			dash = []point{points[0]}
+		}
+		for i := 1; i < len(points); i++ {
+			a, b := points[i-1], points[i]
+			segment, done := math.Hypot(b.x-a.x, b.y-a.y), 0.0
+			for segment-done > remaining {
+				done += remaining
+				p := point{a.x + (b.x-a.x)*done/segment, a.y + (b.y-a.y)*done/segment}
+				if on {
+					out = append(out, subpath{points: append(dash, p)})
+				}
+				dash = []point{p}
+				on = !on
+				index = (index + 1) % len(dashes)
+				remaining = dashes[index]
+			}
+			remaining -= segment - done
+			dash = append(dash, b)
+		}
+		if on && len(dash) > 1 {
+			out = append(out, subpath{points: dash})
+		}
+	}
+	return out
+}
+
+// strokePolygons outlines a stroked subpath, given in canvas space, as polygons to
+// be filled as a union: a quad per segment plus its joins and caps
+func strokePolygons(path subpath, width float64, lineCap, lineJoin string) [][]point {
+	points := dedupe(path.points)
+	half := width / 2
+	if len(points) == 1 {
+		if lineCap == "round" {
+			return [][]point{disc(points[0], half)}
+		}
+		return nil
+	}
+	closed := path.closed && len(points) > 2
+	if closed {
+		points = append(points, points[0])
+	}
+
+	var polygons [][]point
+	count := len(points) - 1
+	for i := 0; i < count; i++ {
+		a, b := points[i], points[i+1]
+		dir := direction(a, b)
+		if !closed && lineCap == "square" {
+			if i == 0 {
+				a = point{a.x - dir.x*half, a.y - dir.y*half}
+			}
+			if i == count-1 {
+				b = point{b.x + dir.x*half, b.y + dir.y*half}
+			}
+		}
+		n := point{-dir.y * half, dir.x * half}
+		polygons = append(polygons, []point{{a.x + n.x, a.y + n.y}, {b.x + n.x, b.y + n.y}, {b.x - n.x, b.y - n.y}, {a.x - n.x, a.y - n.y}})
+	}
+
+	// Joins between consecutive segments, including the closing one
+	for i := 1; i < len(points); i++ {
+		if i == len(points)-1 && !closed {
+			break
+		}
+		before, at, after := points[i-1], points[i], points[(i+1)%len(points)]
+		if i == len(points)-1 {
+			after = points[1]
+		}
+		polygons = append(polygons, join(before, at, after, half, lineJoin)...)
+	}
+
+	if !closed && lineCap == "round" {
+		polygons = append(polygons, disc(points[0], half), disc(points[len(points)-1], half))
+	}
+	return polygons
+}
+
+// join fills the gap between two stroke segments meeting at a point
+func join(before, at, after point, half float64, lineJoin string) [][]point {
+	if lineJoin == "round" {
+		return [][]point{disc(at, half)}
+	}
+	d1, d2 := direction(before, at), direction(at, after)
+	n1, n2 := point{-d1.y, d1.x}, point{-d2.y, d2.x}
+	// The outer side of the bend is away from the turn
+	side := 1.0
+	if (n1.x+n2.x)*(d2.x-d1.x)+(n1.y+n2.y)*(d2.y-d1.y) > 0 {
+		side = -1
+	}
+	a := point{at.x + side*n1.x*half, at.y + side*n1.y*half}
+	b := point{at.x + side*n2.x*half, at.y + side*n2.y*half}
+
+	sum := math.Hypot(n1.x+n2.x, n1.y+n2.y)
+	// SVG's default miter limit of 4, compared with the miter length over the width
+	if lineJoin == "miter" && sum > 2.0/4 {
+		miter := half / (sum / 2)
+		tip := point{at.x + side*(n1.x+n2.x)/sum*miter, at.y + side*(n1.y+n2.y)/sum*miter}
+		return [][]point{{at, a, tip, b}}
+	}
+	return [][]point{{at, a, b}}
+}
+
+// disc outlines a filled circle for round caps and joins
+func disc(center point, radius float64) []point {
+	return ellipse(center.x, center.y, radius, radius, 1)[0].points
+}
+
+// direction returns the unit vector from a to b
+func direction(a, b point) point {
+	length := math.Hypot(b.x-a.x, b.y-a.y)
+	return point{(b.x - a.x) / length, (b.y - a.y) / length}
+}
+
+// dedupe drops consecutive repeated points, which have no direction between them
+func dedupe(points []point) []point {
+	var out []point
+	for _, p := range points {
+		if len(out) == 0 || math.Hypot(p.x-out[len(out)-1].x, p.y-out[len(out)-1].y) > 1e-9 {
+			out = append(out, p)
+		}
+	}
+	return out
+}
@@ -0,0 +1,292 @@
+package raster
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// styleProperties are the presentation attributes the renderer understands
+var styleProperties = map[string]bool{
+	"fill": true, "fill-opacity": true, "stroke": true, "stroke-opacity": true, "stroke-width": true,
+	"stroke-linecap": true, "stroke-linejoin": true, "stroke-dasharray": true, "opacity": true,
+	"font-size": true, "font-weight": true, "text-anchor": true, "direction": true,
+}
+
+// paint is a fill or stroke colour, or none
+type paint struct {
+	color color.NRGBA
+	none  bool
+}
+
+// style is the computed style of an element, inherited from its ancestors
+type style struct {
+	fill, stroke      paint
+	fillOpacity       float64
+	strokeOpacity     float64
+	opacity           float64 // The element's opacity times its ancestors'
+	strokeWidth       float64
+	lineCap, lineJoin string
+	dashes            []float64
+	fontSize          float64
+	bold              bool
+	anchor, direction string
+	transform         matrix
+}
+
+// defaultStyle is the initial style of the root element
+func defaultStyle() style {
+	return style{
+		fill:        paint{color: color.NRGBA{A: 0xff}},
+		stroke:      paint{none: true},
+		fillOpacity: 1, strokeOpacity: 1, opacity: 1,
+		strokeWidth: 1,
+		lineCap:     "butt", lineJoin: "miter",
+		fontSize: 16,
+		anchor:   "start", direction: "ltr",
+		transform: identity,
+	}
+}
+
+// apply returns the style of a child element with the given properties set.
+// Invalid values are ignored, leaving the inherited value. Opacity compounds, so a
+// group's applies to each of its children.
+func (s style) apply(props map[string]string) style {
+	for key, value := range props {
+		value = strings.TrimSpace(value)
+		switch key {
+		case "fill", "stroke":
+			p, ok := parsePaint(value)
+			if !ok {
+				continue
+			}
+			if key == "fill" {
+				s.fill = p
+			} else {
+				s.stroke = p
+			}
+		case "fill-opacity", "stroke-opacity", "opacity":
+			n, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			n = math.Max(0, math.Min(1, n))
+			switch key {
+			case "fill-opacity":
+				s.fillOpacity = n
+			case "stroke-opacity":
+				s.strokeOpacity = n
+			default:
+				s.opacity *= n
+			}
+		case "stroke-width":
+			if n, ok := length(value); ok && n >= 0 {
+				s.strokeWidth = n
+			}
+		case "stroke-linecap":
+			s.lineCap = value
+		case "stroke-linejoin":
+			s.lineJoin = value
+		case "stroke-dasharray":
+			s.dashes = parseDashes(value)
+		case "font-size":
+			if n, ok := length(value); ok && n > 0 {
+				s.fontSize = n
+			}
+		case "font-weight":
+			if n, err := strconv.Atoi(value); err == nil {
+				s.bold = n >= 600
+			} else {
+				s.bold = value == "bold" || value == "bolder"
+			}
+		case "text-anchor":
+			s.anchor = value
+		case "direction":
+			s.direction = value
+		}
+	}
+	return s
+}
+
+// namedColors are the CSS colour keywords the chart generators use, and a few more
+var namedColors = map[string]color.NRGBA{
+	"black":       {0, 0, 0, 0xff},
+	"white":       {0xff, 0xff, 0xff, 0xff},
+	"red":         {0xff, 0, 0, 0xff},
+	"green":       {0, 0x80, 0, 0xff},
+	"blue":        {0, 0, 0xff, 0xff},
+	"yellow":      {0xff, 0xff, 0, 0xff},
+	"orange":      {0xff, 0xa5, 0, 0xff},
+	"purple":      {0x80, 0, 0x80, 0xff},
+	"gray":        {0x80, 0x80, 0x80, 0xff},
+	"grey":        {0x80, 0x80, 0x80, 0xff},
+	"transparent": {0, 0, 0, 0},
+}
+
+// parsePaint reads a paint value: none, a colour keyword, #rgb, #rrggbb or rgb(r, g, b)
+func parsePaint(value string) (paint, bool) {
+	value = strings.ToLower(value)
+	if value == "none" {
+		return paint{none: true}, true
+	}
+	if c, ok := namedColors[value]; ok {
+		return paint{color: c}, true
+	}
+	if strings.HasPrefix(value, "#") {
+		hex := value[1:]
+		if len(hex) == 3 {
+			hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+		}
+		n, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil || len(hex) != 6 {
+			return paint{}, false
+		}
+		return paint{color: color.NRGBA{uint8(n >> 16), uint8(n >> 8), uint8(n), 0xff}}, true
+	}
+	if inner, found := strings.CutPrefix(value, "rgb("); found {
+		parts := numbers(strings.TrimSuffix(inner, ")"))
+		if len(parts) != 3 {
+			return paint{}, false
+		}
+		var c [3]uint8
+		for i, part := range parts {
+			c[i] = uint8(math.Max(0, math.Min(255, part)))
+		}
+		return paint{color: color.NRGBA{c[0], c[1], c[2], 0xff}}, true
+	}
+	return paint{}, false
+}
+
+// alpha returns a paint's colour with its alpha scaled by an opacity
+func (p paint) alpha(opacity float64) color.NRGBA {
+	c := p.color
+	c.A = uint8(float64(c.A)*opacity + 0.5)
+	return c
+}
+
+// parseDashes reads a stroke-dasharray, returning nil for none or an invalid list
+func parseDashes(value string) []float64 {
+	dashes := numbers(value)
+	total := 0.0
+	for _, dash := range dashes {
+		if dash < 0 {
+			return nil
+		}
+		total += dash
+	}
+	if total == 0 {
+		return nil
+	}
+	if len(dashes)%2 == 1 {
+		dashes = append(dashes, dashes...)
+	}
+	return dashes
+}
+
+// length reads a length in user units, allowing a px or pt suffix
+func length(value string) (float64, bool) {
+	scale := 1.0
+	if trimmed, found := strings.CutSuffix(value, "px"); found {
+		value = trimmed
+	} else if trimmed, found := strings.CutSuffix(value, "pt"); found {
+		value, scale = trimmed, 4.0/3
+	}
+	n, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	return n * scale, err == nil
+}
+
+// number reads a numeric attribute, returning 0 when it is missing or invalid
+func number(value string) float64 {
+	n, _ := length(strings.TrimSpace(value))
+	return n
+}
+
+// numbers reads a list of numbers separated by whitespace and/or commas
+func numbers(value string) []float64 {
+	var list []float64
+	for _, field := range strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+	}) {
+		n, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return nil
+		}
+		list = append(list, n)
+	}
+	return list
+}
+
+// matrix is an affine transform [a b c d e f], mapping (x, y) to
+// (a*x + c*y + e, b*x + d*y + f) as in SVG
+type matrix [6]float64
+
+// identity is the transform that leaves points unchanged
+var identity = matrix{1, 0, 0, 1, 0, 0}
+
+// multiply returns the transform that applies n and then m
+func (m matrix) multiply(n matrix) matrix {
+	return matrix{
+		m[0]*n[0] + m[2]*n[1],
+		m[1]*n[0] + m[3]*n[1],
+		m[0]*n[2] + m[2]*n[3],
+		m[1]*n[2] + m[3]*n[3],
+		m[0]*n[4] + m[2]*n[5] + m[4],
+		m[1]*n[4] + m[3]*n[5] + m[5],
+	}
+}
+
+// apply transforms a point
+func (m matrix) apply(p point) point {
+	return point{m[0]*p.x + m[2]*p.y + m[4], m[1]*p.x + m[3]*p.y + m[5]}
+}
+
+// scale returns how much the transform scales lengths, on average
+func (m matrix) scale() float64 {
+	return math.Sqrt(math.Abs(m[0]*m[3] - m[1]*m[2]))
+}
+
+// parseTransform reads an SVG transform list such as "translate(10 20) scale(2)"
+func parseTransform(value string) (matrix, error) {
+	result := identity
+	rest := strings.TrimSpace(value)
+	for rest != "" {
+		name, after, found := strings.Cut(rest, "(")
+		args, tail, closed := strings.Cut(after, ")")
+		if !found || !closed {
+			return identity, fmt.Errorf("invalid SVG transform %q", value)
+		}
+		name = strings.Trim(strings.TrimSpace(name), ",")
+		rest = strings.TrimLeft(tail, " ,\t\n")
+
+		n := numbers(args)
+		var m matrix
+		switch {
+		case name == "matrix" && len(n) == 6:
+			m = matrix{n[0], n[1], n[2], n[3], n[4], n[5]}
+		case name == "translate" && len(n) == 1:
+			m = matrix{1, 0, 0, 1, n[0], 0}
+		case name == "translate" && len(n) == 2:
+			m = matrix{1, 0, 0, 1, n[0], n[1]}
+		case name == "scale" && len(n) == 1:
+			m = matrix{n[0], 0, 0, n[0], 0, 0}
+		case name == "scale" && len(n) == 2:
+			m = matrix{n[0], 0, 0, n[1], 0, 0}
+		case name == "rotate" && (len(n) == 1 || len(n) == 3):
+			sin, cos := math.Sincos(n[0] * math.Pi / 180)
+			m = matrix{cos, sin, -sin, cos, 0, 0}
+			if len(n) == 3 {
+				m = matrix{1, 0, 0, 1, n[1], n[2]}.multiply(m).multiply(matrix{1, 0, 0, 1, -n[1], -n[2]})
+			}
+		case name == "skewX" && len(n) == 1:
+			m = matrix{1, 0, math.Tan(n[0] * math.Pi / 180), 1, 0, 0}
+		case name == "skewY" && len(n) == 1:
+			m = matrix{1, math.Tan(n[0] * math.Pi / 180), 0, 1, 0, 0}
+		default:
+			return identity, fmt.Errorf("invalid SVG transform %q", value)
+		}
+		result = result.multiply(m)
+	}
+	return result, nil
+}
@@ -0,0 +1,191 @@
+package raster
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"strings"
+	"unicode"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// The embedded Go fonts every chart's text is set in, whatever its font-family
+var (
+	regularFont = mustParseFont(goregular.TTF)
+	boldFont    = mustParseFont(gobold.TTF)
+)
+
+// mustParseFont parses an embedded font, which cannot fail short of a broken build
+func mustParseFont(data []byte) *opentype.Font {
+	f, err := opentype.Parse(data)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// textRun is a text element whose content is still being read
+type textRun struct {
+	x, y    float64
+	content strings.Builder
+	style   style
+}
+
+// faceKey identifies a font face by weight and size in canvas pixels
+type faceKey struct {
+	bold bool
+	size float64
+}
+
+// textFace is a sized font face with its font, to look up which characters it has
+type textFace struct {
+	face   font.Face
+	font   *opentype.Font
+	buffer sfnt.Buffer
+}
+
+// face returns the face for a weight and size, creating it on first use
+func (r *renderer) face(bold bool, size float64) *textFace {
+	key := faceKey{bold, size}
+	if face, ok := r.faces[key]; ok {
+		return face
+	}
+	f := regularFont
+	if bold {
+		f = boldFont
+	}
+	// At 72 DPI a point is a pixel, as font sizes in SVG are
+	face, _ := opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingNone})
+	r.faces[key] = &textFace{face: face, font: f}
+	return r.faces[key]
+}
+
+// supported drops the characters the font has no glyph for, rather than drawing
+// them as boxes, and returns the letters among them
+func (f *textFace) supported(text string) (string, []rune) {
+	var missing []rune
+	return strings.Map(func(c rune) rune {
+		if index, err := f.font.GlyphIndex(&f.buffer, c); err != nil || (index == 0 && c != ' ') {
+			if unicode.IsLetter(c) {
+				missing = append(missing, c)
+			}
+			return -1
+		}
+		return c
+	}, text), missing
+}
+
+// drawText sets a text element's content on a single line at its anchor, with
+// whitespace collapsed as SVG does by default
+func (r *renderer) drawText(run *textRun) {
+	s := run.style
+	scale := s.transform.scale()
+	if s.fill.none || scale == 0 {
+		return
+	}
+	face := r.face(s.bold, s.fontSize*scale)
+	text, missing := face.supported(strings.Join(strings.Fields(run.content.String()), " "))
+	r.missing = append(r.missing, missing...)
+	if text = strings.TrimSpace(text); text == "" {
+		return
+	}
+
+	width := float64(font.MeasureString(face.face, text)) / 64
+	anchor := s.anchor
+	if s.direction == "rtl" {
+		// Right-to-left text starts at its anchor and runs leftwards
+		switch anchor {
+		case "start", "":
+			anchor = "end"
+		case "end":
+			anchor = "start"
+		}
+	}
+	offset := 0.0
+	switch anchor {
+	case "middle":
+		offset = -width / 2
+	case "end":
+		offset = -width
+	}
+
+	c := s.fill.alpha(s.fillOpacity * s.opacity)
+	origin := s.transform.apply(point{run.x, run.y})
+	m := s.transform
+	rotation := matrix{m[0] / scale, m[1] / scale, m[2] / scale, m[3] / scale, 0, 0}
+	if math.Abs(rotation[1]) < 1e-9 && math.Abs(rotation[2]) < 1e-9 && rotation[0] > 0 && rotation[3] > 0 {
+		drawer := font.Drawer{Dst: r.canvas, Src: image.NewUniform(c), Face: face.face,
+			Dot: fixed.Point26_6{X: fixed.Int26_6((origin.x + offset) * 64), Y: fixed.Int26_6(origin.y * 64)}}
+		drawer.DrawString(text)
+		return
+	}
+	r.drawRotatedText(face.face, text, origin, offset, rotation, c)
+}
+
+// drawRotatedText sets text upright on a mask, then maps the mask onto the canvas
+// through a rotation about the text's origin
+func (r *renderer) drawRotatedText(face font.Face, text string, origin point, offset float64, rotation matrix, c color.NRGBA) {
+	metrics := face.Metrics()
+	ascent, descent := metrics.Ascent.Ceil()+1, metrics.Descent.Ceil()+1
+	width := int(math.Ceil(float64(font.MeasureString(face, text))/64)) + 2
+	mask := image.NewAlpha(image.Rect(0, 0, width, ascent+descent))
+	drawer := font.Drawer{Dst: mask, Src: image.Opaque, Face: face, Dot: fixed.P(1, ascent)}
+	drawer.DrawString(text)
+
+	// toMask maps an offset from the origin on the canvas back onto the mask
+	det := rotation[0]*rotation[3] - rotation[1]*rotation[2]
+	toMask := func(x, y float64) point {
+		u := (rotation[3]*x - rotation[2]*y) / det
+		v := (-rotation[1]*x + rotation[0]*y) / det
+		return point{u - offset + 1, v + float64(ascent)}
+	}
+
+	// The mask's corners on the canvas bound the pixels to visit
+	minX, minY, maxX, maxY := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+	for _, corner := range []point{{0, 0}, {float64(width), 0}, {0, float64(ascent + descent)}, {float64(width), float64(ascent + descent)}} {
+		local := point{corner.x - 1 + offset, corner.y - float64(ascent)}
+		p := rotation.apply(local)
+		minX, minY = math.Min(minX, origin.x+p.x), math.Min(minY, origin.y+p.y)
+		maxX, maxY = math.Max(maxX, origin.x+p.x), math.Max(maxY, origin.y+p.y)
+	}
+	bounds := image.Rect(int(math.Floor(minX)), int(math.Floor(minY)), int(math.Ceil(maxX)), int(math.Ceil(maxY))).
+		Intersect(r.canvas.Bounds())
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			p := toMask(float64(x)+0.5-origin.x, float64(y)+0.5-origin.y)
+			if coverage := sample(mask, p.x-0.5, p.y-0.5); coverage > 0 {
+				blend(r.canvas, x, y, c, coverage)
+			}
+		}
+	}
+}
+
+// sample reads a mask's coverage between pixel centres by bilinear interpolation
+func sample(mask *image.Alpha, x, y float64) float64 {
+	x0, y0 := math.Floor(x), math.Floor(y)
+	fx, fy := x-x0, y-y0
+	at := func(x, y int) float64 {
+		if !(image.Point{x, y}.In(mask.Rect)) {
+			return 0
+		}
+		return float64(mask.AlphaAt(x, y).A) / 0xff
+	}
+	ix, iy := int(x0), int(y0)
+	return at(ix, iy)*(1-fx)*(1-fy) + at(ix+1, iy)*fx*(1-fy) + at(ix, iy+1)*(1-fx)*fy + at(ix+1, iy+1)*fx*fy
+}
+
+// blend paints a colour over a canvas pixel at a partial coverage
+func blend(canvas *image.RGBA, x, y int, c color.NRGBA, coverage float64) {
+	alpha := float64(c.A) / 0xff * coverage
+	i := canvas.PixOffset(x, y)
+	pix := canvas.Pix[i : i+4 : i+4]
+	for channel, value := range []uint8{c.R, c.G, c.B, 0xff} {
+		pix[channel] = uint8(float64(value)*alpha + float64(pix[channel])*(1-alpha) + 0.5)
+	}
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+)
+
+// defaultNearestReferences is how many reference points nearest_reference_points lists by default
+const defaultNearestReferences = 5
+
+// referencePoints are the compass reference points in use: the built-in set,
+// extended by a file given at startup
+var referencePoints = politicalcompass.ReferencePoints
+
+// loadReferencePointsFile adds the points in a JSON file to the reference points,
+// replacing built-in points of the same name. It returns how many were loaded.
+func loadReferencePointsFile(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("reading reference points: %w", err)
+	}
+	defer f.Close()
+
+	points, err := politicalcompass.LoadReferencePoints(f)
+	if err != nil {
+		return 0, err
+	}
+	referencePoints = politicalcompass.MergeReferencePoints(referencePoints, points)
+	return len(points), nil
+}
+
+// referenceCategories lists the categories of the reference points in use
+func referenceCategories() []string {
+	seen := map[string]bool{}
+	var categories []string
+	for _, p := range referencePoints {
+		if p.Category != "" && !seen[p.Category] {
+			seen[p.Category] = true
+			categories = append(categories, p.Category)
+		}
+	}
+	sort.Strings(categories)
+	return categories
+}
+
+// selectReferencePoints picks reference points by a comma-separated list of names
+// and categories, or "all"; matching ignores case
+func selectReferencePoints(spec string) ([]politicalcompass.ReferencePoint, error) {
+	var selected []politicalcompass.ReferencePoint
+	chosen := map[string]bool{}
+	for _, term := range strings.Split(spec, ",") {
+		term = strings.ToLower(strings.TrimSpace(term))
+		if term == "" {
+			continue
+		}
+		matched := false
+		for _, p := range referencePoints {
+			if term == "all" || strings.ToLower(p.Name) == term || strings.ToLower(p.Category) == term {
+				matched = true
+				if !chosen[p.Name] {
+					chosen[p.Name] = true
+					selected = append(selected, p)
+				}
+			}
+		}
+		if !matched {
+			return nil, fmt.Errorf("unknown reference point or category: %s. Categories: %s", term, strings.Join(referenceCategories(), ", "))
+		}
+	}
+	return selected, nil
+}
+
+// handleNearestReferencePoints lists the reference points nearest the user's
+// compass position and overlays them, and any others chosen, on the chart
+func handleNearestReferencePoints(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	count := request.GetInt("count", defaultNearestReferences)
+	if count < 1 {
+		return mcp.NewToolResultError("count must be at least 1"), nil
+	}
+	category := strings.ToLower(strings.TrimSpace(request.GetString("category", "")))
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	candidates := referencePoints
+	if category != "" {
+		candidates = nil
+		for _, p := range referencePoints {
+			if strings.ToLower(p.Category) == category {
+				candidates = append(candidates, p)
+			}
+		}
+		if len(candidates) == 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("unknown category: %s. Please use one of: %s", category, strings.Join(referenceCategories(), ", "))), nil
+		}
+	}
+	shown, err := selectReferencePoints(request.GetString("show", ""))
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	result, err := currentResult(politicalCompassQuiz)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	economic, social := result.Scores[0], result.Scores[1]
+	nearest := politicalcompass.NearestReferencePoints(candidates, economic.Score, social.Score, count)
+
+	text := fmt.Sprintf("📍 **Nearest Reference Points**\n\nYour position: (%.2f, %.2f)\n\n", economic.Score, social.Score)
+	for i, p := range nearest {
+		text += fmt.Sprintf("%d. %s", i+1, p.Name)
+		if p.Category != "" {
+			text += " (" + p.Category + ")"
+		}
+		text += fmt.Sprintf(": (%.1f, %.1f), %.2f away\n", p.Economic, p.Social, p.Distance(economic.Score, social.Score))
+	}
+
+	overlay := append([]politicalcompass.ReferencePoint(nil), nearest...)
+	for _, p := range shown {
+		listed := false
+		for _, n := range nearest {
+			listed = listed || n.Name == p.Name
+		}
+		if !listed {
+			overlay = append(overlay, p)
+		}
+	}
+	svg := politicalcompass.GenerateSVGWithOptions(economic.Score, social.Score, politicalcompass.SVGOptions{
+		EconomicMargin: economic.Margin, SocialMargin: social.Margin, ReferencePoints: overlay,
+	})
+
+	text += "\n*Reference positions are approximate and for context only.*"
+	if !result.Complete {
+		text += fmt.Sprintf(" *The quiz is still in progress (%d/%d answered), so your position is provisional.*", result.Answered, result.Total)
+	}
+	text += "\n\n" + svg + "\n\nRender the SVG chart above so the user can see their position among the reference points."
+	return mcp.NewToolResultText(text), nil
+}
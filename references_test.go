@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+)
+
+func TestLoadReferencePointsFile(t *testing.T) {
+	defer func() { referencePoints = politicalcompass.ReferencePoints }()
+
+	path := filepath.Join(t.TempDir(), "points.json")
+	os.WriteFile(path, []byte(`[{"name": "Local Greens", "economic": -5, "social": -4, "color": "#00aa00", "category": "local"}]`), 0o644)
+	loaded, err := loadReferencePointsFile(path)
+	if err != nil || loaded != 1 {
+		t.Fatalf("expected one point to load, got %d (%v)", loaded, err)
+	}
+	if len(referencePoints) != len(politicalcompass.ReferencePoints)+1 {
+		t.Errorf("expected the point to be added to the built-in ones, got %d", len(referencePoints))
+	}
+	if categories := strings.Join(referenceCategories(), ","); categories != "figure,local,party" {
+		t.Errorf("unexpected categories %s", categories)
+	}
+
+	if _, err := loadReferencePointsFile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
+
+func TestSelectReferencePoints(t *testing.T) {
+	selected, err := selectReferencePoints("margaret thatcher, party, Margaret Thatcher")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(selected) != 8 || selected[0].Name != "Margaret Thatcher" {
+		t.Errorf("expected Thatcher and the 7 parties once each, got %d", len(selected))
+	}
+	if all, _ := selectReferencePoints("all"); len(all) != len(referencePoints) {
+		t.Errorf("expected every point, got %d", len(all))
+	}
+	if none, err := selectReferencePoints(""); err != nil || len(none) != 0 {
+		t.Errorf("expected no points for an empty list, got %d (%v)", len(none), err)
+	}
+	if _, err := selectReferencePoints("nobody"); err == nil || !strings.Contains(err.Error(), "Categories: figure, party") {
+		t.Errorf("expected an unknown point error, got %v", err)
+	}
+}
+
+func TestHandleNearestReferencePoints(t *testing.T) {
+	resetState()
+
+	request := func(args map[string]interface{}) (string, bool) {
+		response, err := handleNearestReferencePoints(context.Background(), createMockRequest("nearest_reference_points", args))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return extractTextContent(response), isErrorResult(response)
+	}
+
+	if content, isError := request(nil); !isError || !strings.Contains(content, "no answers") {
+		t.Errorf("expected an error before the quiz is started, got: %s", content)
+	}
+
+	completePoliticalCompass("strongly_agree")
+	content, isError := request(map[string]interface{}{"count": 2, "category": "party", "show": "Mahatma Gandhi"})
+	if isError {
+		t.Fatalf("unexpected error: %s", content)
+	}
+	if strings.Count(content, ". ") < 2 || !strings.Contains(content, "(party)") || strings.Contains(content, "(figure)") {
+		t.Errorf("expected two parties listed, got: %s", content)
+	}
+	if strings.Count(content, `r="4"`) != 3 || !strings.Contains(content, ">Mahatma Gandhi</text>") {
+		t.Errorf("expected the listed parties and Gandhi on the chart, got: %s", content)
+	}
+
+	for _, args := range []map[string]interface{}{{"count": 0}, {"category": "planet"}, {"show": "nobody"}} {
+		if content, isError := request(args); !isError {
+			t.Errorf("expected an error for %v, got: %s", args, content)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/eightvalues"
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+// reviewStatusNote closes the status of a quiz waiting in its review step
+const reviewStatusNote = "\n*📝 All questions answered and waiting for review. Amend any answer with `amend_answer`, then call `finalize` to see the final scores.*"
+
+// quizReview returns the review flags of a quiz's state
+func quizReview(quiz string) (review, reviewing, finalized *bool) {
+	switch quiz {
+	case politicalCompassQuiz:
+		return &quizState.Review, &quizState.Reviewing, &quizState.Finalized
+	case eightValuesQuiz:
+		return &eightValuesQuizState.Review, &eightValuesQuizState.Reviewing, &eightValuesQuizState.Finalized
+	default:
+		return &politiscalesQuizState.Review, &politiscalesQuizState.Reviewing, &politiscalesQuizState.Finalized
+	}
+}
+
+// reviewPending reports whether a quiz is in its review step: every answer is in
+// but the quiz has not been finalized, so its scores are held back
+func reviewPending(quiz string) bool {
+	_, reviewing, finalized := quizReview(quiz)
+	return *reviewing && !*finalized
+}
+
+// reviewHold is the error the tools that score a quiz give while it waits in its
+// review step, or nil when it may be scored
+func reviewHold(quiz string) error {
+	if !reviewPending(quiz) {
+		return nil
+	}
+	return fmt.Errorf("the %s quiz is in its review step, so nothing is scored until the user is done: call `finalize` with quiz %s first",
+		quizTitles[quiz], quiz)
+}
+
+// quizCompletion builds a quiz's completion message
+func quizCompletion(quiz string) string {
+	switch quiz {
+	case politicalCompassQuiz:
+		return politicalCompassCompletion()
+	case eightValuesQuiz:
+		return eightValuesCompletion()
+	default:
+		return politiscalesCompletion()
+	}
+}
+
+// finishQuiz is called once a quiz's last answer is in. It shows the review list
+// when the quiz was started with review, otherwise the completion message.
+func finishQuiz(quiz string) string {
+	if review, reviewing, finalized := quizReview(quiz); *review && !*finalized {
+		// The review step is kept even if an amended adaptive quiz would no longer stop here
+		*reviewing = true
+		return reviewList(quiz)
+	}
+	return quizCompletion(quiz)
+}
+
+// reviewAnswers lists a quiz's answers in the order they were asked
+func reviewAnswers(quiz string) []answeredItem {
+	switch quiz {
+	case politicalCompassQuiz:
+		return politicalCompassAnswers()
+	case eightValuesQuiz:
+		return eightValuesAnswers()
+	default:
+		return politiscalesAnswers()
+	}
+}
+
+// reviewList numbers every question with its answer for the review step
+func reviewList(quiz string) string {
+	answers := exportAnswers(quiz, reviewAnswers(quiz))
+	var b strings.Builder
+	fmt.Fprintf(&b, "📝 **%s: Review Your Answers**\n\nAll %d questions are answered. Nothing has been scored yet.\n\n", quizTitles[quiz], len(answers))
+	for i, answer := range answers {
+		fmt.Fprintf(&b, "%d. %s: **%s**\n", i+1, answer.Text, answer.Answer)
+	}
+	fmt.Fprintf(&b, "\n**Important Instructions:**\n"+
+		"1. Show this list to the user so they can check their answers\n"+
+		"2. To change an answer, call `amend_answer` with quiz %s, the number from this list and the new answer\n"+
+		"3. When the user is happy with their answers, call `finalize` with quiz %s to score the quiz and show the results", quiz, quiz)
+	return b.String()
+}
+
+// amendAnswer replaces the i-th answer, in asking order, and updates the running scores.
+// The caller must hold the mutex.
+func amendAnswer(quiz string, i int, value float64) {
+	switch quiz {
+	case politicalCompassQuiz:
+		question := politicalcompass.AllQuestions[shuffledQuestions[i]]
+		oldEconomic, oldSocial := question.Scores(politicalCompassAnswerValue(i, quizState.Responses[i]))
+		economic, social := question.Scores(value)
+		totalEconomicScore += economic - oldEconomic
+		totalSocialScore += social - oldSocial
+		quizState.Responses[i] = politicalcompass.NearestResponse(value)
+		if i < len(quizState.Values) {
+			quizState.Values[i] = value
+		}
+	case eightValuesQuiz:
+		effect := eightvalues.Questions[eightValuesShuffledQuestions[i]].Effect
+		delta := value - eightValuesQuizState.Responses[i]
+		eightValuesEconScore += delta * effect[eightvalues.Economic]
+		eightValuesDiplScore += delta * effect[eightvalues.Diplomatic]
+		eightValuesGovtScore += delta * effect[eightvalues.Government]
+		eightValuesSctyScore += delta * effect[eightvalues.Society]
+		eightValuesQuizState.Responses[i] = value
+	default:
+		question := politiscales.Questions[politiscalesAnswers()[i].question]
+		politiscalesQuizState.Responses[question.Index] = value
+		_ = calculatePolitiscalesResults()
+	}
+}
+
+// handleAmendAnswer changes one answer during a quiz's review step
+func handleAmendAnswer(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	quiz, err := request.RequireString("quiz")
+	if err != nil {
+		return mcp.NewToolResultError("quiz is required"), nil
+	}
+	if _, ok := quizTitles[quiz]; !ok {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid quiz: %s. Please use one of: %s, %s, %s",
+			quiz, politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz)), nil
+	}
+	number, err := request.RequireInt("question")
+	if err != nil {
+		return mcp.NewToolResultError("question is required: the number of the answer in the review list"), nil
+	}
+	answer := request.GetString("answer", "")
+	value, hasValue, valueErr := answerValue(request)
+	if valueErr != nil {
+		return mcp.NewToolResultError(valueErr.Error()), nil
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if !reviewPending(quiz) {
+		return mcp.NewToolResultError(reviewUnavailable(quiz, "amend_answer")), nil
+	}
+	items := reviewAnswers(quiz)
+	if number < 1 || number > len(items) {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid question: %d. Use a number from the review list, 1 to %d", number, len(items))), nil
+	}
+	if !hasValue {
+		values, tokens, copyTables, language := whatIfAnswerScale(quiz)
+		v, ok := values[normalizeAnswer(answer, copyTables, language)]
+		if !ok {
+			return mcp.NewToolResultError(invalidAnswerMessage(answer, tokens, copyTables, language)), nil
+		}
+		value = v
+	}
+
+	before := items[number-1]
+	amendAnswer(quiz, number-1, value)
+	text := questionText(itemRef{quiz, questionKey(quiz, before.question)})
+	return mcp.NewToolResultText(fmt.Sprintf("✏️ Answer %d amended\n\n%s\n- Before: %s\n- Now: %s\n\n"+
+		"Amend any other answer the same way, or call `finalize` with quiz %s when the user is done.",
+		number, text, describeAnswer(before.value), describeAnswer(value), quiz)), nil
+}
+
+// handleFinalize ends a quiz's review step, scoring the answers and showing the results
+func handleFinalize(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	quiz, err := request.RequireString("quiz")
+	if err != nil {
+		return mcp.NewToolResultError("quiz is required"), nil
+	}
+	if _, ok := quizTitles[quiz]; !ok {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid quiz: %s. Please use one of: %s, %s, %s",
+			quiz, politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz)), nil
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if !reviewPending(quiz) {
+		return mcp.NewToolResultError(reviewUnavailable(quiz, "finalize")), nil
+	}
+	_, _, finalized := quizReview(quiz)
+	*finalized = true
+	return mcp.NewToolResultText(quizCompletion(quiz)), nil
+}
+
+// reviewUnavailable explains why a quiz is not in its review step
+func reviewUnavailable(quiz, tool string) string {
+	review, _, finalized := quizReview(quiz)
+	switch {
+	case !*review:
+		return fmt.Sprintf("%s only works in the review step: start the %s quiz with review set to true", tool, quizTitles[quiz])
+	case *finalized:
+		return fmt.Sprintf("the %s quiz has already been finalized; reset it to answer again", quizTitles[quiz])
+	default:
+		return fmt.Sprintf("the %s quiz is not finished yet: answer every question with the `%s` tool first, then review", quizTitles[quiz], quiz)
+	}
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/eightvalues"
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+)
+
+// reviewTools maps the review tools to their handlers
+var reviewTools = map[string]server.ToolHandlerFunc{"finalize": handleFinalize, "amend_answer": handleAmendAnswer}
+
+func TestPoliticalCompassReview(t *testing.T) {
+	resetState()
+	handlePoliticalCompass(context.Background(), createMockRequest("political_compass", map[string]interface{}{"answer": "", "review": true}))
+	var message string
+	for i := 0; i < len(politicalcompass.AllQuestions); i++ {
+		response, _ := handlePoliticalCompass(context.Background(), createRequestWithAnswer("agree"))
+		message = extractTextContent(response)
+	}
+	if !strings.Contains(message, "Review Your Answers") || !strings.Contains(message, "\n62. ") || strings.Contains(message, "Quiz Complete") {
+		t.Fatalf("expected the review list instead of the results, got: %s", message)
+	}
+
+	// Nothing is scored or recorded while the review is pending
+	response, _ := handlePoliticalCompass(context.Background(), createRequestWithAnswer("disagree"))
+	if !strings.Contains(extractTextContent(response), "Review Your Answers") || len(quizState.Responses) != 62 {
+		t.Errorf("expected further answers to be ignored during the review, got %d answers", len(quizState.Responses))
+	}
+	status, _ := handleQuizStatus(context.Background(), createMockRequest("quiz_status", nil))
+	if text := extractTextContent(status); strings.Contains(text, "Final Scores") || !strings.Contains(text, "waiting for review") {
+		t.Errorf("expected the status to hold back the scores, got: %s", text)
+	}
+	if result, _ := currentResult(politicalCompassQuiz); result.Complete {
+		t.Error("expected the result to stay incomplete until finalized")
+	}
+
+	content, isError := callTool(t, handleAmendAnswer, "amend_answer", map[string]interface{}{"quiz": "political_compass", "question": 3, "answer": "strongly disagree"})
+	if isError || !strings.Contains(content, "Answer 3 amended") || !strings.Contains(content, "- Before: agree\n- Now: strongly disagree") {
+		t.Errorf("unexpected amend response: %s", content)
+	}
+	if quizState.Responses[2] != politicalcompass.StronglyDisagree {
+		t.Errorf("expected the third answer amended, got %v", quizState.Responses[2])
+	}
+	callTool(t, handleAmendAnswer, "amend_answer", map[string]interface{}{"quiz": "political_compass", "question": 5, "value": -0.5})
+	answers := map[int]float64{}
+	for _, item := range politicalCompassAnswers() {
+		answers[item.question] = item.value
+	}
+	economic, social := scorePoliticalCompass(answers)
+	if running, _ := politicalCompassPosition(totalEconomicScore, totalSocialScore); running != economic {
+		t.Errorf("expected the running economic score to follow the amendments, got %.2f and %.2f", running, economic)
+	}
+
+	content, isError = callTool(t, handleFinalize, "finalize", map[string]interface{}{"quiz": "political_compass"})
+	if isError || !strings.Contains(content, "Political Compass Quiz Complete!") || !strings.Contains(content, "Your Political Quadrant: "+getQuadrant(economic, social)) {
+		t.Errorf("expected the results once finalized, got: %s", content)
+	}
+	if result, _ := currentResult(politicalCompassQuiz); !result.Complete {
+		t.Error("expected the finalized result to be complete")
+	}
+	for _, tool := range []string{"finalize", "amend_answer"} {
+		if content, isError := callTool(t, reviewTools[tool], tool, map[string]interface{}{"quiz": "political_compass", "question": 1, "answer": "agree"}); !isError || !strings.Contains(content, "already been finalized") {
+			t.Errorf("expected %s to be refused after finalizing, got: %s", tool, content)
+		}
+	}
+}
+
+func TestEightValuesReview(t *testing.T) {
+	resetState()
+	handleEightValues(context.Background(), createMockRequest("eight_values", map[string]interface{}{"answer": "", "length": 8, "review": true}))
+	var message string
+	for i := 0; i < 8; i++ {
+		response, _ := handleEightValues(context.Background(), createRequestWithAnswer("neutral"))
+		message = extractTextContent(response)
+	}
+	if !strings.Contains(message, "8values: Review Your Answers") || !strings.Contains(message, "8. ") {
+		t.Fatalf("expected the review list, got: %s", message)
+	}
+
+	callTool(t, handleAmendAnswer, "amend_answer", map[string]interface{}{"quiz": "eight_values", "question": 2, "value": 0.8})
+	question := eightvalues.Questions[eightValuesShuffledQuestions[1]]
+	if eightValuesQuizState.Responses[1] != 0.8 || math.Abs(eightValuesEconScore-0.8*question.Effect[eightvalues.Economic]) > 1e-9 {
+		t.Errorf("expected the amendment in the running scores, got %v and %.2f", eightValuesQuizState.Responses, eightValuesEconScore)
+	}
+	if content, isError := callTool(t, handleFinalize, "finalize", map[string]interface{}{"quiz": "eight_values"}); isError || !strings.Contains(content, "8values Political Quiz Complete!") {
+		t.Errorf("expected the results once finalized, got: %s", content)
+	}
+}
+
+func TestPolitiscalesReviewWithPrefill(t *testing.T) {
+	resetState()
+	completePoliticalCompass("agree")
+	handlePolitiscales(context.Background(), createMockRequest("politiscales", map[string]interface{}{"answer": "", "length": 40, "review": true, "prefill": true}))
+	if !politiscalesQuizState.Review || reviewPending(politiscalesQuiz) {
+		t.Fatal("expected a prefilled quiz to keep the review flag without entering the review yet")
+	}
+	var message string
+	for !reviewPending(politiscalesQuiz) && len(politiscalesQuizState.Responses) < 40 {
+		response, _ := handlePolitiscales(context.Background(), createRequestWithAnswer("agree"))
+		message = extractTextContent(response)
+	}
+	if !strings.Contains(message, "Politiscales: Review Your Answers") {
+		t.Fatalf("expected the review list, got: %s", message)
+	}
+
+	item := politiscalesAnswers()[0]
+	content, isError := callTool(t, handleAmendAnswer, "amend_answer", map[string]interface{}{"quiz": "politiscales", "question": 1, "answer": "neutral"})
+	if isError || politiscalesAnswers()[0].value != 0 || politiscalesAnswers()[0].question != item.question {
+		t.Errorf("expected the first answer amended to neutral, got: %s", content)
+	}
+	status, _ := handlePolitiscalesStatus(context.Background(), createMockRequest("politiscales_status", nil))
+	if strings.Contains(extractTextContent(status), "<svg") {
+		t.Error("expected no results chart in the status before finalizing")
+	}
+	if content, isError := callTool(t, handleFinalize, "finalize", map[string]interface{}{"quiz": "politiscales"}); isError || !strings.Contains(content, "Politiscales Quiz Complete!") {
+		t.Errorf("expected the results once finalized, got: %s", content)
+	}
+}
+
+func TestReviewHoldsScores(t *testing.T) {
+	resetState()
+	handleEightValues(context.Background(), createMockRequest("eight_values", map[string]interface{}{"answer": "", "length": 20, "review": true}))
+	for i := 0; i < 20; i++ {
+		handleEightValues(context.Background(), createRequestWithAnswer("agree"))
+	}
+
+	// Every tool that scores the quiz waits for finalize
+	args := map[string]interface{}{"quiz": "eight_values"}
+	for name, handler := range map[string]server.ToolHandlerFunc{
+		"export_result":  handleExportResults,
+		"explain_result": handleExplainResult,
+		"match_parties":  handleMatchParties,
+	} {
+		if content, isError := callTool(t, handler, name, args); !isError || !strings.Contains(content, "review step") || strings.Contains(content, "Score") {
+			t.Errorf("expected %s to hold back scores during review, got: %s", name, content)
+		}
+	}
+	whatIfArgs := map[string]interface{}{"quiz": "eight_values", "overrides": map[string]interface{}{"0": "disagree"}}
+	if content, isError := callTool(t, handleWhatIf, "what_if", whatIfArgs); !isError || !strings.Contains(content, "review step") {
+		t.Errorf("expected what_if to hold back scores during review, got: %s", content)
+	}
+
+	callTool(t, handleFinalize, "finalize", args)
+	if content, isError := callTool(t, handleExportResults, "export_result", args); isError || !strings.Contains(content, `"scores"`) {
+		t.Errorf("expected scores once finalized, got: %s", content)
+	}
+	if content, isError := callTool(t, handleExplainResult, "explain_result", args); isError || !strings.Contains(content, "### Economic:") {
+		t.Errorf("expected the breakdown once finalized, got: %s", content)
+	}
+}
+
+func TestReviewToolErrors(t *testing.T) {
+	resetState()
+	tests := []struct {
+		name     string
+		tool     string
+		args     map[string]interface{}
+		expected string
+	}{
+		{"no review", "finalize", map[string]interface{}{"quiz": "political_compass"}, "start the Political Compass quiz with review"},
+		{"unknown quiz", "finalize", map[string]interface{}{"quiz": "astrology"}, "invalid quiz"},
+		{"no quiz", "amend_answer", map[string]interface{}{"question": 1}, "quiz is required"},
+		{"no question", "amend_answer", map[string]interface{}{"quiz": "eight_values"}, "question is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if content, isError := callTool(t, reviewTools[tt.tool], tt.tool, tt.args); !isError || !strings.Contains(content, tt.expected) {
+				t.Errorf("expected error containing %q, got: %s", tt.expected, content)
+			}
+		})
+	}
+
+	handleEightValues(context.Background(), createMockRequest("eight_values", map[string]interface{}{"answer": "", "length": 8, "review": true}))
+	handleEightValues(context.Background(), createRequestWithAnswer("agree"))
+	if content, _ := callTool(t, handleFinalize, "finalize", map[string]interface{}{"quiz": "eight_values"}); !strings.Contains(content, "not finished yet") {
+		t.Errorf("expected an unfinished quiz to be refused, got: %s", content)
+	}
+	for i := 0; i < 8; i++ {
+		handleEightValues(context.Background(), createRequestWithAnswer("agree"))
+	}
+	for _, args := range []map[string]interface{}{
+		{"quiz": "eight_values", "question": 9, "answer": "agree"},
+		{"quiz": "eight_values", "question": 1, "answer": "maybe"},
+		{"quiz": "eight_values", "question": 1, "value": 3},
+	} {
+		if content, isError := callTool(t, handleAmendAnswer, "amend_answer", args); !isError {
+			t.Errorf("expected an error for %v, got: %s", args, content)
+		}
+	}
+}
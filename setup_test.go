@@ -1,6 +1,9 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/mark3labs/mcp-go/server"
@@ -34,3 +37,55 @@ func TestSetupServer(t *testing.T) {
 		t.Fatal("Expected server to be created, got nil")
 	}
 }
+
+// toolAnswerDescriptions lists the answer argument description of each registered tool
+func toolAnswerDescriptions(t *testing.T) map[string]string {
+	t.Helper()
+	response := setupServer().HandleMessage(context.Background(), []byte(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`))
+	data, err := json.Marshal(response)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var list struct {
+		Result struct {
+			Tools []struct {
+				Name        string `json:"name"`
+				InputSchema struct {
+					Properties map[string]struct {
+						Description string `json:"description"`
+					} `json:"properties"`
+				} `json:"inputSchema"`
+			} `json:"tools"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(data, &list); err != nil {
+		t.Fatalf("unexpected tools/list response %s: %v", data, err)
+	}
+	descriptions := map[string]string{}
+	for _, tool := range list.Result.Tools {
+		if answer, ok := tool.InputSchema.Properties["answer"]; ok {
+			descriptions[tool.Name] = answer.Description
+		}
+	}
+	return descriptions
+}
+
+func TestPoliticalCompassAnswerDescription(t *testing.T) {
+	descriptions := toolAnswerDescriptions(t)
+	compass := descriptions["political_compass"]
+	if strings.Contains(compass, "neutral,") || strings.Contains(compass, "SD/D/N") || !strings.Contains(compass, "SD/D/A/SA") {
+		t.Errorf("expected the compass to document only its four answers, got: %s", compass)
+	}
+	if !strings.Contains(descriptions["eight_values"], "neutral") {
+		t.Errorf("expected 8values to document the neutral answer, got: %s", descriptions["eight_values"])
+	}
+
+	// Every answer the compass description lists is accepted
+	for _, answer := range []string{"SD", "D", "A", "SA", "1", "2", "4", "5", "strongly_agree", "disagree"} {
+		resetState()
+		handlePoliticalCompass(context.Background(), createRequestWithAnswer(""))
+		if response, _ := handlePoliticalCompass(context.Background(), createRequestWithAnswer(answer)); isErrorResult(response) || questionCount != 2 {
+			t.Errorf("expected the compass to accept %q, got: %s", answer, extractTextContent(response))
+		}
+	}
+}
@@ -0,0 +1,508 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/eightvalues"
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+// Share code layout, before URL-safe base64 encoding:
+//
+//	byte 0     format version (high 4 bits) and quiz ID (low 4 bits)
+//	byte 1     flags (high 4 bits: shareFlagPages, shareFlagReview, shareFlagFinalized) and
+//	           mode (low 4 bits: shareModeFull, shareModeAdaptive, shareModeShortForm or shareModeTopic)
+//	bytes 2-3  mode parameter: adaptive margin in tenths of a point, short form length, or practice topic number
+//	byte 4     question bank version
+//	bytes 5-8  first bytes of the question bank hash
+//	...        one 3-bit answer code per question, in bank order
+//	last byte  CRC-8 of everything before it, so any single mistyped character is caught
+//
+// Format version 1 codes have no bank version byte, so their hash follows the mode parameter.
+const (
+	shareCodeVersion   = 2
+	shareHeaderLength  = 9
+	shareHeaderV1      = 8
+	shareModeFull      = 0
+	shareModeAdaptive  = 1
+	shareModeShortForm = 2
+	shareModeTopic     = 3
+	shareModeMask      = 0x0f
+	shareFlagPages     = 0x80 // Political compass page mode
+	shareFlagReview    = 0x40 // Started with a review step
+	shareFlagFinalized = 0x20 // Review step finished
+)
+
+// shareQuizIDs numbers the quizzes in share codes
+var shareQuizIDs = []string{politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz}
+
+// shareAnswerPositions are the answer values behind codes 1-5 (strongly disagree
+// to strongly agree); code 0 is an unanswered question. Slider answers are stored
+// as the nearest position, and the political compass has no neutral answer.
+var shareAnswerPositions = map[string][]float64{
+	politicalCompassQuiz: {
+		politicalcompass.ResponseValues[politicalcompass.StronglyDisagree], politicalcompass.ResponseValues[politicalcompass.Disagree],
+		math.NaN(),
+		politicalcompass.ResponseValues[politicalcompass.Agree], politicalcompass.ResponseValues[politicalcompass.StronglyAgree],
+	},
+	eightValuesQuiz:  {eightvalues.StronglyDisagree, eightvalues.Disagree, eightvalues.Neutral, eightvalues.Agree, eightvalues.StronglyAgree},
+	politiscalesQuiz: {-1, -2.0 / 3.0, 0, 2.0 / 3.0, 1},
+}
+
+// shareAnswerCode returns the code (1-5) of the answer position nearest a value
+func shareAnswerCode(quiz string, value float64) byte {
+	best := -1
+	for i, position := range shareAnswerPositions[quiz] {
+		if math.IsNaN(position) {
+			continue
+		}
+		if best < 0 || math.Abs(value-position) < math.Abs(value-shareAnswerPositions[quiz][best]) {
+			best = i
+		}
+	}
+	return byte(best + 1)
+}
+
+// packAnswerCodes packs 3-bit codes, most significant bit first
+func packAnswerCodes(codes []byte) []byte {
+	packed := make([]byte, (len(codes)*3+7)/8)
+	for i, code := range codes {
+		for bit := 0; bit < 3; bit++ {
+			if code&(4>>bit) != 0 {
+				position := i*3 + bit
+				packed[position/8] |= 0x80 >> (position % 8)
+			}
+		}
+	}
+	return packed
+}
+
+// unpackAnswerCodes reverses packAnswerCodes for count codes
+func unpackAnswerCodes(packed []byte, count int) []byte {
+	codes := make([]byte, count)
+	for i := range codes {
+		for bit := 0; bit < 3; bit++ {
+			position := i*3 + bit
+			if packed[position/8]&(0x80>>(position%8)) != 0 {
+				codes[i] |= 4 >> bit
+			}
+		}
+	}
+	return codes
+}
+
+// shareCode encodes a quiz's answers and mode. The caller must hold the mutex.
+func shareCode(quiz string) (string, error) {
+	var items []answeredItem
+	mode, parameter := shareModeFull, 0
+	switch quiz {
+	case politicalCompassQuiz:
+		items = politicalCompassAnswers()
+		mode, parameter = shareMode(false, 0, 0, quizState.Topic)
+		if quizState.Pages {
+			mode |= shareFlagPages
+		}
+	case eightValuesQuiz:
+		items = eightValuesAnswers()
+		mode, parameter = shareMode(eightValuesQuizState.Adaptive, eightValuesQuizState.Margin, eightValuesQuizState.Length, eightValuesQuizState.Topic)
+	case politiscalesQuiz:
+		items = politiscalesAnswers()
+		mode, parameter = shareMode(politiscalesQuizState.Adaptive, politiscalesQuizState.Margin, politiscalesQuizState.Length, politiscalesQuizState.Topic)
+	default:
+		return "", fmt.Errorf("invalid quiz: %s. Please use one of: %s, %s, %s",
+			quiz, politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz)
+	}
+	if len(items) == 0 {
+		return "", fmt.Errorf("no answers to share yet: start the quiz with the `%s` tool", quiz)
+	}
+	if review, _, finalized := quizReview(quiz); *review {
+		mode |= shareFlagReview
+		if *finalized {
+			mode |= shareFlagFinalized
+		}
+	}
+
+	codes := make([]byte, quizQuestionCount(quiz))
+	for _, item := range items {
+		codes[item.question] = shareAnswerCode(quiz, item.value)
+	}
+
+	data := []byte{byte(shareCodeVersion<<4 | shareQuizID(quiz)), byte(mode), 0, 0, byte(questionBankVersion(quiz))}
+	binary.BigEndian.PutUint16(data[2:4], uint16(parameter))
+	data = append(data, questionBankHash(quiz)[:4]...)
+	data = append(data, packAnswerCodes(codes)...)
+	data = append(data, crc8(data))
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// crc8 computes a CRC-8 (polynomial 0x07), which detects every error burst of
+// up to 8 bits, such as one wrong base64 character
+func crc8(data []byte) byte {
+	var crc byte
+	for _, b := range data {
+		crc ^= b
+		for bit := 0; bit < 8; bit++ {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ 0x07
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// shareQuizID returns the number of a quiz in share codes
+func shareQuizID(quiz string) int {
+	for id, name := range shareQuizIDs {
+		if name == quiz {
+			return id
+		}
+	}
+	return -1
+}
+
+// shareMode encodes a quiz mode and its parameter
+func shareMode(adaptive bool, margin float64, length int, topic string) (mode, parameter int) {
+	switch {
+	case topic != "":
+		for i, t := range quizTopics {
+			if t.key == topic {
+				return shareModeTopic, i
+			}
+		}
+		return shareModeFull, 0
+	case adaptive:
+		return shareModeAdaptive, int(math.Round(margin * 10))
+	case length > 0:
+		return shareModeShortForm, length
+	default:
+		return shareModeFull, 0
+	}
+}
+
+// decodedShareCode is the content of a share code
+type decodedShareCode struct {
+	quiz      string
+	mode      int
+	parameter int
+	pages     bool           // Political compass page mode
+	review    bool           // Started with a review step
+	finalized bool           // Review step finished
+	answers   []answeredItem // in question ID order
+	warnings  []string       // answers the question bank migration could not carry over faithfully
+}
+
+// decodeShareCode checks and unpacks a share code
+func decodeShareCode(code string) (decodedShareCode, error) {
+	var decoded decodedShareCode
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(strings.TrimSpace(code), "="))
+	if err != nil || len(data) <= shareHeaderV1 {
+		return decoded, fmt.Errorf("invalid share code: not a share code")
+	}
+	body, checksum := data[:len(data)-1], data[len(data)-1]
+	if crc8(body) != checksum {
+		return decoded, fmt.Errorf("invalid share code: checksum mismatch, the code may have been mistyped or truncated")
+	}
+	headerLength, bankVersion, bankHash := shareHeaderLength, 0, []byte(nil)
+	switch version := int(body[0] >> 4); version {
+	case 1:
+		headerLength, bankHash = shareHeaderV1, body[4:8]
+	case shareCodeVersion:
+		if len(body) <= shareHeaderLength {
+			return decoded, fmt.Errorf("invalid share code: not a share code")
+		}
+		bankVersion, bankHash = int(body[4]), body[5:9]
+	default:
+		return decoded, fmt.Errorf("invalid share code: unsupported format version %d", version)
+	}
+	id := int(body[0] & 0x0f)
+	if id >= len(shareQuizIDs) {
+		return decoded, fmt.Errorf("invalid share code: unknown quiz %d", id)
+	}
+	decoded.quiz = shareQuizIDs[id]
+	decoded.mode = int(body[1] & shareModeMask)
+	flags := body[1] &^ shareModeMask
+	if flags&^(shareFlagPages|shareFlagReview|shareFlagFinalized) != 0 {
+		return decoded, fmt.Errorf("invalid share code: unknown flags %#x", flags)
+	}
+	decoded.pages, decoded.review, decoded.finalized = flags&shareFlagPages != 0, flags&shareFlagReview != 0, flags&shareFlagFinalized != 0
+	decoded.parameter = int(binary.BigEndian.Uint16(body[2:4]))
+
+	// Codes from an older question bank are carried over by its migrations
+	upgrade, err := findBankUpgrade(decoded.quiz, bankVersion, hex.EncodeToString(bankHash))
+	if err != nil {
+		return decoded, fmt.Errorf("incompatible share code: %v", err)
+	}
+
+	count := upgrade.count
+	packed := body[headerLength:]
+	if len(packed) != (count*3+7)/8 {
+		return decoded, fmt.Errorf("invalid share code: expected %d answers", count)
+	}
+	var answered []int
+	for question, answerCode := range unpackAnswerCodes(packed, count) {
+		if answerCode == 0 {
+			continue
+		}
+		if answerCode > 5 || math.IsNaN(shareAnswerPositions[decoded.quiz][answerCode-1]) {
+			return decoded, fmt.Errorf("invalid share code: bad answer for question %d", question)
+		}
+		answered = append(answered, question)
+		if current := upgrade.question(question); current >= 0 {
+			decoded.answers = append(decoded.answers, answeredItem{question: current, value: shareAnswerPositions[decoded.quiz][answerCode-1]})
+		}
+	}
+	sort.Slice(decoded.answers, func(i, j int) bool { return decoded.answers[i].question < decoded.answers[j].question })
+	decoded.warnings = upgrade.warnings(decoded.quiz, answered)
+	if len(decoded.answers) == 0 {
+		return decoded, fmt.Errorf("invalid share code: it contains no answers")
+	}
+	switch decoded.mode {
+	case shareModeFull:
+	case shareModeAdaptive:
+		if decoded.parameter <= 0 || decoded.parameter > 500 {
+			return decoded, fmt.Errorf("invalid share code: bad adaptive margin")
+		}
+	case shareModeShortForm:
+		if decoded.parameter <= 0 || decoded.parameter > quizQuestionCount(decoded.quiz) {
+			return decoded, fmt.Errorf("invalid share code: bad short form length")
+		}
+	case shareModeTopic:
+		if decoded.parameter >= len(quizTopics) || len(topicQuestions(decoded.quiz, quizTopics[decoded.parameter].key)) == 0 {
+			return decoded, fmt.Errorf("invalid share code: bad practice topic")
+		}
+	default:
+		return decoded, fmt.Errorf("invalid share code: unknown mode %d", decoded.mode)
+	}
+	if decoded.finalized && !decoded.review {
+		return decoded, fmt.Errorf("invalid share code: finalized without a review step")
+	}
+	if decoded.pages {
+		if decoded.quiz != politicalCompassQuiz || decoded.mode != shareModeFull {
+			return decoded, fmt.Errorf("invalid share code: page mode is only for the full political compass")
+		}
+		// Pages are answered in the official order, so the answers run from the first question
+		for i, item := range decoded.answers {
+			if item.question != i {
+				return decoded, fmt.Errorf("invalid share code: page mode answers must run in the official order")
+			}
+		}
+	}
+	return decoded, nil
+}
+
+// shareOrder puts the answered questions first, followed by the rest of a fresh
+// shuffled order (restricted to a short form when one is given)
+func shareOrder(answers []answeredItem, shuffled, subset []int) []int {
+	answered := map[int]bool{}
+	var order []int
+	for _, item := range answers {
+		answered[item.question] = true
+		order = append(order, item.question)
+	}
+	if subset != nil {
+		shuffled = restrictOrder(shuffled, subset)
+	}
+	for _, question := range shuffled {
+		if !answered[question] {
+			order = append(order, question)
+		}
+	}
+	return order
+}
+
+// restoreShareCode replaces a quiz's state with the decoded answers. An unfinished
+// quiz is left waiting for the answer to its next question, which is returned as
+// the order index of that question, or -1 when the quiz is complete. A complete
+// quiz with a review step that was not finalized is left in its review step. The
+// caller must hold the mutex.
+func restoreShareCode(decoded decodedShareCode) int {
+	answered, next := len(decoded.answers), -1
+	switch decoded.quiz {
+	case politicalCompassQuiz:
+		quizState = &QuizState{Pages: decoded.pages}
+		if decoded.mode == shareModeTopic {
+			quizState.Topic = quizTopics[decoded.parameter].key
+		}
+		shuffledQuestions = nil
+		initializeQuestions()
+		shuffledQuestions = shareOrder(decoded.answers, shuffledQuestions, nil)
+		totalEconomicScore, totalSocialScore = 0, 0
+		for _, item := range decoded.answers {
+			economicScore, socialScore := politicalcompass.AllQuestions[item.question].Scores(item.value)
+			totalEconomicScore += economicScore
+			totalSocialScore += socialScore
+			quizState.Responses = append(quizState.Responses, politicalcompass.NearestResponse(item.value))
+			quizState.Values = append(quizState.Values, item.value)
+		}
+		currentIndex, questionCount = answered, answered
+		if answered < len(shuffledQuestions) {
+			currentIndex++
+			if quizState.Pages {
+				// The rest of the page the next question is on has been presented
+				currentIndex = politicalcompass.Pages[politicalcompass.PageOf(shuffledQuestions[answered])].End
+			}
+			questionCount = currentIndex
+			next = answered
+		}
+
+	case eightValuesQuiz:
+		resetEightValuesState()
+		initializeEightValuesQuestions()
+		var subset []int
+		switch decoded.mode {
+		case shareModeAdaptive:
+			eightValuesQuizState.Adaptive, eightValuesQuizState.Margin = true, float64(decoded.parameter)/10
+		case shareModeShortForm:
+			eightValuesQuizState.Length = decoded.parameter
+			subset = eightValuesShortForm(decoded.parameter)
+		case shareModeTopic:
+			eightValuesQuizState.Topic = quizTopics[decoded.parameter].key
+			subset = topicQuestions(eightValuesQuiz, eightValuesQuizState.Topic)
+		}
+		eightValuesShuffledQuestions = shareOrder(decoded.answers, eightValuesShuffledQuestions, subset)
+		for _, item := range decoded.answers {
+			effect := eightvalues.Questions[item.question].Effect
+			eightValuesEconScore += item.value * effect[eightvalues.Economic]
+			eightValuesDiplScore += item.value * effect[eightvalues.Diplomatic]
+			eightValuesGovtScore += item.value * effect[eightvalues.Government]
+			eightValuesSctyScore += item.value * effect[eightvalues.Society]
+			eightValuesQuizState.Responses = append(eightValuesQuizState.Responses, item.value)
+		}
+		eightValuesCurrentIndex, eightValuesQuestionCount = answered, answered
+		if answered < len(eightValuesShuffledQuestions) && !eightValuesAdaptiveStable() {
+			if eightValuesQuizState.Adaptive {
+				selectAdaptiveQuestion(eightValuesShuffledQuestions, answered, eightValuesAxisEstimates(), eightValuesAxisWeight)
+			}
+			eightValuesCurrentIndex++
+			eightValuesQuestionCount++
+			next = answered
+		}
+
+	case politiscalesQuiz:
+		resetPolitiscalesState()
+		initializePolitiscalesQuestions()
+		var subset []int
+		switch decoded.mode {
+		case shareModeAdaptive:
+			politiscalesQuizState.Adaptive, politiscalesQuizState.Margin = true, float64(decoded.parameter)/10
+		case shareModeShortForm:
+			politiscalesQuizState.Length = decoded.parameter
+			subset = politiscalesShortForm(decoded.parameter)
+		case shareModeTopic:
+			politiscalesQuizState.Topic = quizTopics[decoded.parameter].key
+			subset = topicQuestions(politiscalesQuiz, politiscalesQuizState.Topic)
+		}
+		politiscalesShuffledQuestions = shareOrder(decoded.answers, politiscalesShuffledQuestions, subset)
+		for _, item := range decoded.answers {
+			politiscalesQuizState.Responses[politiscales.Questions[item.question].Index] = item.value
+		}
+		calculatePolitiscalesResults()
+		politiscalesCurrentIndex, politiscalesQuestionCount = answered, answered
+		if answered < len(politiscalesShuffledQuestions) && !politiscalesAdaptiveStable() {
+			if politiscalesQuizState.Adaptive {
+				selectAdaptiveQuestion(politiscalesShuffledQuestions, answered, politiscalesAxisEstimates(), politiscalesAxisWeight)
+			}
+			politiscalesCurrentIndex++
+			politiscalesQuestionCount++
+			next = answered
+		}
+	}
+
+	review, reviewing, finalized := quizReview(decoded.quiz)
+	*review = decoded.review
+	*reviewing = decoded.review && next < 0
+	*finalized = *reviewing && decoded.finalized
+	return next
+}
+
+// shareCodeLine is the share code note added to completion messages. The caller must hold the mutex.
+func shareCodeLine(quiz string) string {
+	code, err := shareCode(quiz)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("\n\nShare code: `%s` (paste it into `import_share_code` to restore this result in another session)", code)
+}
+
+// handleShareCode returns the share code of a quiz's current answers
+func handleShareCode(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	quiz, err := request.RequireString("quiz")
+	if err != nil {
+		return mcp.NewToolResultError("quiz is required"), nil
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	code, err := shareCode(quiz)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("🔗 **%s Share Code**\n\n`%s`\n\n"+
+		"Paste it into `import_share_code` to restore these answers and re-render the result. "+
+		"Slider answers are stored as the nearest fixed answer.", quizTitles[quiz], code)), nil
+}
+
+// handleImportShareCode rebuilds a quiz from a share code and re-renders its result
+func handleImportShareCode(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	code, err := request.RequireString("code")
+	if err != nil {
+		return mcp.NewToolResultError("code is required"), nil
+	}
+	decoded, err := decodeShareCode(code)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	next := restoreShareCode(decoded)
+	*quizBankWarnings(decoded.quiz) = decoded.warnings
+	if reviewPending(decoded.quiz) {
+		// The answers were shared before the review step ended, so they stay unscored
+		return mcp.NewToolResultText(fmt.Sprintf("📥 **%s Share Code Imported**\n\n%s\n",
+			quizTitles[decoded.quiz], bankWarningNote(decoded.quiz)) + reviewList(decoded.quiz)), nil
+	}
+	result, err := currentResult(decoded.quiz)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	text := fmt.Sprintf("📥 **%s Share Code Imported**\n\nQuestions answered: %d/%d\nMode: %s\n%s\n",
+		result.Title, result.Answered, result.Total, result.Mode, bankWarningNote(decoded.quiz)) + formatResultScores(result)
+	text += "\n" + resultChart(result)
+
+	if next >= 0 && decoded.quiz == politicalCompassQuiz && quizState.Pages {
+		page := politicalcompass.PageOf(shuffledQuestions[next])
+		text += fmt.Sprintf("\n\n*The quiz is unfinished, so these scores are provisional.* To continue, call the `%s` tool with `answers` "+
+			"listing the user's responses to the rest of page %d of %d, in order:\n\n%s",
+			decoded.quiz, page+1, len(politicalcompass.Pages), politicalCompassPageList(shuffledQuestions[next:currentIndex]))
+	} else if next >= 0 {
+		var question string
+		switch decoded.quiz {
+		case politicalCompassQuiz:
+			question = getPoliticalCompassQuestionText(politicalcompass.AllQuestions[shuffledQuestions[next]].Text)
+		case eightValuesQuiz:
+			question = getEightValuesQuestionText(eightvalues.Questions[eightValuesShuffledQuestions[next]].Text)
+		default:
+			question = getPolitiscalesQuestionText(politiscales.Questions[politiscalesShuffledQuestions[next]].Text)
+		}
+		text += fmt.Sprintf("\n\n*The quiz is unfinished, so these scores are provisional.* To continue, answer the next question with the `%s` tool:\n\n"+
+			"Question %d of %d:\n%s", decoded.quiz, next+1, result.Total, question)
+	} else {
+		text += "\n\nRender the SVG chart above so the user can see the restored result."
+	}
+	return mcp.NewToolResultText(text), nil
+}
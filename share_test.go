@@ -0,0 +1,293 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"math/rand"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+func TestPackAnswerCodes(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	codes := make([]byte, 117)
+	for i := range codes {
+		codes[i] = byte(rng.Intn(6))
+	}
+	packed := packAnswerCodes(codes)
+	if len(packed) != 44 {
+		t.Errorf("expected 117 codes to pack into 44 bytes, got %d", len(packed))
+	}
+	if got := unpackAnswerCodes(packed, len(codes)); !reflect.DeepEqual(got, codes) {
+		t.Errorf("codes did not survive packing: %v", got)
+	}
+}
+
+func TestShareCodeRoundTripPoliticalCompass(t *testing.T) {
+	resetState()
+
+	handlePoliticalCompass(context.Background(), createRequestWithAnswer(""))
+	answers := []string{"strongly_agree", "disagree", "agree", "strongly_disagree"}
+	var completion string
+	for i := 0; i < len(politicalcompass.AllQuestions); i++ {
+		response, _ := handlePoliticalCompass(context.Background(), createRequestWithAnswer(answers[i%len(answers)]))
+		completion = extractTextContent(response)
+	}
+	economic, social := totalEconomicScore, totalSocialScore
+
+	match := regexp.MustCompile("Share code: `([A-Za-z0-9_-]+)`").FindStringSubmatch(completion)
+	if match == nil {
+		t.Fatalf("expected a share code in the completion message, got: %s", completion)
+	}
+	code := match[1]
+	if len(code) > 48 {
+		t.Errorf("expected a short code, got %d characters", len(code))
+	}
+
+	resetState()
+	content, isError := callTool(t, handleImportShareCode, "import_share_code", map[string]interface{}{"code": code})
+	if isError {
+		t.Fatalf("unexpected error: %s", content)
+	}
+	if len(quizState.Responses) != len(politicalcompass.AllQuestions) || currentIndex != len(shuffledQuestions) {
+		t.Errorf("expected a complete quiz, got %d responses at index %d", len(quizState.Responses), currentIndex)
+	}
+	if economic != totalEconomicScore || social != totalSocialScore {
+		t.Errorf("restored scores %f, %f differ from %f, %f", totalEconomicScore, totalSocialScore, economic, social)
+	}
+	for _, expected := range []string{"Political Compass Share Code Imported", "Questions answered: 62/62", "<svg", "Render the SVG chart"} {
+		if !strings.Contains(content, expected) {
+			t.Errorf("expected %q in import output, got: %s", expected, content)
+		}
+	}
+}
+
+func TestShareCodeRestoresUnfinishedShortForm(t *testing.T) {
+	resetState()
+
+	handleEightValues(context.Background(), createMockRequest("eight_values", map[string]interface{}{"answer": "", "length": 20}))
+	for i := 0; i < 5; i++ {
+		handleEightValues(context.Background(), createRequestWithAnswer("agree"))
+	}
+	answered := append([]int(nil), eightValuesShuffledQuestions[:5]...)
+
+	response, _ := handleShareCode(context.Background(), createMockRequest("share_code", map[string]interface{}{"quiz": "eight_values"}))
+	code := regexp.MustCompile("`([A-Za-z0-9_-]+)`").FindStringSubmatch(extractTextContent(response))[1]
+
+	resetState()
+	content, isError := callTool(t, handleImportShareCode, "import_share_code", map[string]interface{}{"code": code})
+	if isError {
+		t.Fatalf("unexpected error: %s", content)
+	}
+	if eightValuesQuizState.Length != 20 || len(eightValuesQuizState.Responses) != 5 || eightValuesCurrentIndex != 6 {
+		t.Errorf("expected a 20-question short form with 5 answers waiting on question 6, got %+v at index %d",
+			eightValuesQuizState, eightValuesCurrentIndex)
+	}
+	if !strings.Contains(content, "provisional") || !strings.Contains(content, "Question 6 of 20") {
+		t.Errorf("expected the next question in import output, got: %s", content)
+	}
+
+	// The restored quiz carries on where it stopped
+	handleEightValues(context.Background(), createRequestWithAnswer("disagree"))
+	if len(eightValuesQuizState.Responses) != 6 || eightValuesQuizState.Responses[5] != -0.5 {
+		t.Errorf("expected the sixth answer to be recorded, got %v", eightValuesQuizState.Responses)
+	}
+	restored := append([]int(nil), eightValuesShuffledQuestions[:5]...)
+	if !reflect.DeepEqual(sortedCopy(restored), sortedCopy(answered)) {
+		t.Errorf("expected the answered questions first, got %v want %v", restored, answered)
+	}
+}
+
+func TestShareCodeRoundTripPages(t *testing.T) {
+	resetState()
+
+	handlePoliticalCompass(context.Background(), createMockRequest("political_compass", map[string]interface{}{"pages": true, "review": true}))
+	page := make([]interface{}, 7)
+	for i := range page {
+		page[i] = "agree"
+	}
+	handlePoliticalCompass(context.Background(), createMockRequest("political_compass", map[string]interface{}{"answers": page}))
+	handlePoliticalCompass(context.Background(), createMockRequest("political_compass", map[string]interface{}{"answers": []interface{}{"disagree", "disagree"}}))
+
+	code, err := shareCode(politicalCompassQuiz)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resetState()
+	content, isError := callTool(t, handleImportShareCode, "import_share_code", map[string]interface{}{"code": code})
+	if isError {
+		t.Fatalf("unexpected error: %s", content)
+	}
+	if !quizState.Pages || !quizState.Review || len(quizState.Responses) != 9 || currentIndex != 21 {
+		t.Errorf("expected page mode with review, 9 answers and page 2 presented, got %+v at index %d", quizState, currentIndex)
+	}
+	if !reflect.DeepEqual(shuffledQuestions[:9], []int{0, 1, 2, 3, 4, 5, 6, 7, 8}) {
+		t.Errorf("expected the official order, got %v", shuffledQuestions[:9])
+	}
+	if !strings.Contains(content, "Mode: pages (official order)") || !strings.Contains(content, "rest of page 2 of 6") {
+		t.Errorf("expected the rest of page 2 in import output, got: %s", content)
+	}
+
+	// The restored quiz finishes the page it stopped on
+	rest := make([]interface{}, 12)
+	for i := range rest {
+		rest[i] = "strongly_agree"
+	}
+	response, _ := handlePoliticalCompass(context.Background(), createMockRequest("political_compass", map[string]interface{}{"answers": rest}))
+	if isErrorResult(response) || len(quizState.Responses) != 21 || !strings.Contains(extractTextContent(response), "Page 3 of 6") {
+		t.Errorf("expected page 3 next, got: %s", extractTextContent(response))
+	}
+}
+
+func TestShareCodeRoundTripReview(t *testing.T) {
+	resetState()
+
+	handleEightValues(context.Background(), createMockRequest("eight_values", map[string]interface{}{"answer": "", "length": 20, "review": true}))
+	for i := 0; i < 20; i++ {
+		handleEightValues(context.Background(), createRequestWithAnswer("agree"))
+	}
+	if !reviewPending(eightValuesQuiz) {
+		t.Fatal("expected the quiz to be in its review step")
+	}
+	pending, err := shareCode(eightValuesQuiz)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	handleFinalize(context.Background(), createMockRequest("finalize", map[string]interface{}{"quiz": "eight_values"}))
+	finalized, _ := shareCode(eightValuesQuiz)
+
+	// A code shared during review comes back in review, with its scores held back
+	resetState()
+	content, isError := callTool(t, handleImportShareCode, "import_share_code", map[string]interface{}{"code": pending})
+	if isError || !reviewPending(eightValuesQuiz) || !strings.Contains(content, "Review Your Answers") || strings.Contains(content, "<svg") {
+		t.Errorf("expected the review list without scores, got: %s", content)
+	}
+
+	// A finalized code comes back scored, and cannot be reviewed again
+	resetState()
+	content, isError = callTool(t, handleImportShareCode, "import_share_code", map[string]interface{}{"code": finalized})
+	if isError || !eightValuesQuizState.Review || !eightValuesQuizState.Finalized || reviewPending(eightValuesQuiz) {
+		t.Errorf("expected a finalized quiz, got %+v", eightValuesQuizState)
+	}
+	if !strings.Contains(content, "<svg") {
+		t.Errorf("expected the result chart in import output, got: %s", content)
+	}
+}
+
+func TestShareCodeRoundsSliderAnswers(t *testing.T) {
+	resetState()
+
+	handlePolitiscales(context.Background(), createRequestWithAnswer(""))
+	handlePolitiscales(context.Background(), createRequestWithValue(0.5))
+	question := politiscalesShuffledQuestions[0]
+
+	code, err := shareCode(politiscalesQuiz)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resetState()
+	if _, isError := callTool(t, handleImportShareCode, "import_share_code", map[string]interface{}{"code": code}); isError {
+		t.Fatal("expected the code to import")
+	}
+	if got := politiscalesQuizState.Responses[politiscales.Questions[question].Index]; got != 2.0/3.0 {
+		t.Errorf("expected the slider answer to round to agree, got %f", got)
+	}
+}
+
+func TestImportShareCodeErrors(t *testing.T) {
+	resetState()
+	handleEightValues(context.Background(), createRequestWithAnswer(""))
+	handleEightValues(context.Background(), createRequestWithAnswer("agree"))
+	code, _ := shareCode(eightValuesQuiz)
+	data, _ := base64.RawURLEncoding.DecodeString(code)
+
+	// reencode alters a copy of the code and fixes its checksum
+	reencode := func(change func(data []byte)) string {
+		altered := append([]byte(nil), data...)
+		change(altered)
+		body := altered[:len(altered)-1]
+		altered[len(altered)-1] = crc8(body)
+		return base64.RawURLEncoding.EncodeToString(altered)
+	}
+	mistyped := []byte(code)
+	if mistyped[len(mistyped)/2] == 'A' {
+		mistyped[len(mistyped)/2] = 'B'
+	} else {
+		mistyped[len(mistyped)/2] = 'A'
+	}
+
+	tests := []struct {
+		name     string
+		code     string
+		expected string
+	}{
+		{"not base64", "!!!", "not a share code"},
+		{"mistyped", string(mistyped), "checksum mismatch"},
+		{"other bank", reencode(func(d []byte) { d[5] ^= 0xff }), "different 8values question bank"},
+		{"newer bank", reencode(func(d []byte) { d[4] = 9 }), "newer 8values question bank"},
+		{"future version", reencode(func(d []byte) { d[0] = 3<<4 | 1 }), "unsupported format version 3"},
+		{"unknown mode", reencode(func(d []byte) { d[1] = 9 }), "unknown mode 9"},
+		{"unknown flags", reencode(func(d []byte) { d[1] = 0x10 }), "unknown flags 0x10"},
+		{"finalized without review", reencode(func(d []byte) { d[1] = shareFlagFinalized }), "finalized without a review step"},
+		{"pages outside the compass", reencode(func(d []byte) { d[1] = shareFlagPages }), "page mode is only for the full political compass"},
+		{"no answers", reencode(func(d []byte) {
+			for i := shareHeaderLength; i < len(d)-1; i++ {
+				d[i] = 0
+			}
+		}), "no answers"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, isError := callTool(t, handleImportShareCode, "import_share_code", map[string]interface{}{"code": tt.code})
+			if !isError || !strings.Contains(content, tt.expected) {
+				t.Errorf("expected error containing %q, got: %s", tt.expected, content)
+			}
+		})
+	}
+
+	// The political compass has no neutral answer (code 3)
+	resetState()
+	handlePoliticalCompass(context.Background(), createRequestWithAnswer(""))
+	handlePoliticalCompass(context.Background(), createRequestWithAnswer("agree"))
+	code, _ = shareCode(politicalCompassQuiz)
+	data, _ = base64.RawURLEncoding.DecodeString(code)
+	neutral := reencode(func(d []byte) { d[shareHeaderLength] = 3 << 5 })
+	if content, isError := callTool(t, handleImportShareCode, "import_share_code", map[string]interface{}{"code": neutral}); !isError || !strings.Contains(content, "bad answer for question 0") {
+		t.Errorf("expected a neutral compass answer to be rejected, got: %s", content)
+	}
+
+	if _, err := shareCode(politiscalesQuiz); err == nil || !strings.Contains(err.Error(), "no answers to share yet") {
+		t.Errorf("expected an error sharing an unstarted quiz, got %v", err)
+	}
+}
+
+func TestCRC8DetectsSingleCharacterErrors(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	data := make([]byte, 33)
+	rng.Read(data)
+	code := []byte(base64.RawURLEncoding.EncodeToString(data))
+	want := crc8(data)
+
+	alphabet := "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+	for i := range code {
+		for _, c := range []byte(alphabet) {
+			if c == code[i] {
+				continue
+			}
+			altered := append([]byte(nil), code...)
+			altered[i] = c
+			decoded, err := base64.RawURLEncoding.DecodeString(string(altered))
+			if err != nil || reflect.DeepEqual(decoded, data) {
+				continue
+			}
+			if crc8(decoded) == want {
+				t.Fatalf("changing character %d to %q went undetected", i, c)
+			}
+		}
+	}
+}
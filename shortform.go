@@ -0,0 +1,417 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/eightvalues"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+// Shortest allowed short forms: enough for a keyed pair of questions on every axis
+const (
+	minEightValuesLength  = 8
+	minPolitiscalesLength = 40
+)
+
+// shortFormSimulations is the number of simulated respondents used to estimate
+// how closely a short form agrees with the full quiz
+const shortFormSimulations = 300
+
+// keyedLoad is a question's weight on one axis. positive is true when agreeing
+// with the question raises the axis score, false when disagreeing does.
+type keyedLoad struct {
+	axis     int
+	positive bool
+	weight   float64
+}
+
+// selectShortForm deterministically picks length questions so that every axis
+// keeps its share of the full quiz's weight, split evenly between positive and
+// negative keyed questions where the bank allows it. Each step fills the
+// (axis, key) bucket furthest below its target with the heaviest remaining
+// question in it, preferring the lowest index on ties. The selection is
+// returned in ascending order.
+func selectShortForm(loads [][]keyedLoad, axes, length int) []int {
+	if length >= len(loads) {
+		all := make([]int, len(loads))
+		for i := range all {
+			all[i] = i
+		}
+		return all
+	}
+
+	// Bucket b = axis*2 for positive keys, axis*2+1 for negative keys
+	available := make([]float64, axes*2)
+	for _, question := range loads {
+		for _, load := range question {
+			available[keyedBucket(load)] += load.weight
+		}
+	}
+
+	share := float64(length) / float64(len(loads))
+	targets := make([]float64, axes*2)
+	for axis := 0; axis < axes; axis++ {
+		pos, neg := available[axis*2], available[axis*2+1]
+		target := (pos + neg) * share
+		// Aim for half the axis target on each key, moving any shortfall to the other key
+		targets[axis*2] = math.Min(pos, math.Max(target/2, target-neg))
+		targets[axis*2+1] = math.Min(neg, target-targets[axis*2])
+	}
+
+	selected := make([]bool, len(loads))
+	filled := make([]float64, axes*2)
+	var chosen []int
+	for len(chosen) < length {
+		// Most underfilled bucket that still has unselected questions
+		bucket, deficit := -1, math.Inf(-1)
+		for b := range targets {
+			if targets[b] == 0 {
+				continue
+			}
+			d := (targets[b] - filled[b]) / targets[b]
+			if d > deficit && bucketHasQuestion(loads, selected, b) {
+				bucket, deficit = b, d
+			}
+		}
+
+		best, bestWeight := -1, -1.0
+		for question, qLoads := range loads {
+			if selected[question] {
+				continue
+			}
+			weight := 0.0
+			for _, load := range qLoads {
+				if bucket < 0 || keyedBucket(load) == bucket {
+					weight += load.weight
+				}
+			}
+			if weight > bestWeight {
+				best, bestWeight = question, weight
+			}
+		}
+
+		selected[best] = true
+		chosen = append(chosen, best)
+		for _, load := range loads[best] {
+			filled[keyedBucket(load)] += load.weight
+		}
+	}
+
+	sort.Ints(chosen)
+	return chosen
+}
+
+// keyedBucket returns the (axis, key) bucket index of a load
+func keyedBucket(load keyedLoad) int {
+	if load.positive {
+		return load.axis * 2
+	}
+	return load.axis*2 + 1
+}
+
+// bucketHasQuestion reports whether an unselected question loads on the bucket
+func bucketHasQuestion(loads [][]keyedLoad, selected []bool, bucket int) bool {
+	for question, qLoads := range loads {
+		if selected[question] {
+			continue
+		}
+		for _, load := range qLoads {
+			if keyedBucket(load) == bucket {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// restrictOrder keeps the questions of order that are in subset, preserving their order
+func restrictOrder(order, subset []int) []int {
+	keep := make(map[int]bool, len(subset))
+	for _, question := range subset {
+		keep[question] = true
+	}
+	restricted := make([]int, 0, len(subset))
+	for _, question := range order {
+		if keep[question] {
+			restricted = append(restricted, question)
+		}
+	}
+	return restricted
+}
+
+// shortFormLength reads the length argument used when starting a quiz. It returns
+// 0 for the full quiz.
+func shortFormLength(request mcp.CallToolRequest, minimum, total int) (int, error) {
+	length := request.GetInt("length", 0)
+	if length == 0 || length >= total {
+		return 0, nil
+	}
+	if length < minimum {
+		return 0, fmt.Errorf("invalid length: short forms need at least %d questions (the full quiz has %d)", minimum, total)
+	}
+	return length, nil
+}
+
+// shortFormAgreement compares short-form scores with full-quiz scores over
+// simulated respondents
+type shortFormAgreement struct {
+	Correlation    float64 // mean Pearson correlation across axes
+	MeanDifference float64 // mean absolute score difference in percentage points
+}
+
+// String formats the agreement for quiz messages
+func (a shortFormAgreement) String() string {
+	return fmt.Sprintf("r = %.2f, mean difference %.1f points", a.Correlation, a.MeanDifference)
+}
+
+// shortFormAgreementCache memoises agreement estimates by quiz and length
+var shortFormAgreementCache = map[string]shortFormAgreement{}
+
+// estimateShortFormAgreement simulates respondents with random positions on each
+// axis, answers every question for them, and compares the short-form scores with
+// the full ones. score maps answers (by question index) to per-axis percentages.
+func estimateShortFormAgreement(key string, subset []int, respond func(rng *rand.Rand) []float64, score func(answers map[int]float64) []float64) shortFormAgreement {
+	if agreement, ok := shortFormAgreementCache[key]; ok {
+		return agreement
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	var full, short [][]float64
+	for i := 0; i < shortFormSimulations; i++ {
+		answers := respond(rng)
+		all := make(map[int]float64, len(answers))
+		for question, answer := range answers {
+			all[question] = answer
+		}
+		part := make(map[int]float64, len(subset))
+		for _, question := range subset {
+			part[question] = answers[question]
+		}
+		full = append(full, score(all))
+		short = append(short, score(part))
+	}
+
+	var agreement shortFormAgreement
+	axes, correlated := len(full[0]), 0
+	for axis := 0; axis < axes; axis++ {
+		xs := make([]float64, len(full))
+		ys := make([]float64, len(full))
+		for i := range full {
+			xs[i], ys[i] = full[i][axis], short[i][axis]
+			agreement.MeanDifference += math.Abs(xs[i] - ys[i])
+		}
+		if r, ok := pearson(xs, ys); ok {
+			agreement.Correlation += r
+			correlated++
+		}
+	}
+	if correlated > 0 {
+		agreement.Correlation /= float64(correlated)
+	}
+	agreement.MeanDifference /= float64(axes * len(full))
+
+	shortFormAgreementCache[key] = agreement
+	return agreement
+}
+
+// pearson returns the correlation of xs and ys; ok is false if either is constant
+func pearson(xs, ys []float64) (float64, bool) {
+	var meanX, meanY float64
+	for i := range xs {
+		meanX += xs[i]
+		meanY += ys[i]
+	}
+	meanX /= float64(len(xs))
+	meanY /= float64(len(ys))
+
+	var cov, varX, varY float64
+	for i := range xs {
+		cov += (xs[i] - meanX) * (ys[i] - meanY)
+		varX += (xs[i] - meanX) * (xs[i] - meanX)
+		varY += (ys[i] - meanY) * (ys[i] - meanY)
+	}
+	if varX == 0 || varY == 0 {
+		return 0, false
+	}
+	return cov / math.Sqrt(varX*varY), true
+}
+
+// quantizeAnswer snaps a simulated answer to the nearest of the fixed answer values
+func quantizeAnswer(value float64, positions []float64) float64 {
+	nearest := positions[0]
+	for _, position := range positions {
+		if math.Abs(value-position) < math.Abs(value-nearest) {
+			nearest = position
+		}
+	}
+	return nearest
+}
+
+// 8values short forms
+
+// eightValuesLoads returns each 8values question's keyed loads from its Effect signs
+func eightValuesLoads() [][]keyedLoad {
+	loads := make([][]keyedLoad, len(eightvalues.Questions))
+	for i, question := range eightvalues.Questions {
+		for axis, effect := range question.Effect {
+			if effect != 0 {
+				loads[i] = append(loads[i], keyedLoad{axis: axis, positive: effect > 0, weight: math.Abs(effect)})
+			}
+		}
+	}
+	return loads
+}
+
+// eightValuesShortForm returns the questions of the 8values short form of the given length
+func eightValuesShortForm(length int) []int {
+	return selectShortForm(eightValuesLoads(), len(eightValuesAxisNames), length)
+}
+
+// scoreEightValues returns the four 8values percentages for answers keyed by
+// question index, normalised by the maxima of the answered questions only
+func scoreEightValues(answers map[int]float64) []float64 {
+	var scores, maxima [4]float64
+	for question, multiplier := range answers {
+		for axis, effect := range eightvalues.Questions[question].Effect {
+			scores[axis] += multiplier * effect
+			maxima[axis] += math.Abs(effect)
+		}
+	}
+	percentages := make([]float64, len(scores))
+	for axis := range scores {
+		percentages[axis] = eightValuesPercentage(scores[axis], maxima[axis])
+	}
+	return percentages
+}
+
+// eightValuesShortFormAgreement estimates how closely a short form matches the full 8values quiz
+func eightValuesShortFormAgreement(subset []int) shortFormAgreement {
+	positions := []float64{eightvalues.StronglyDisagree, eightvalues.Disagree, eightvalues.Neutral, eightvalues.Agree, eightvalues.StronglyAgree}
+	respond := func(rng *rand.Rand) []float64 {
+		var position [4]float64
+		for axis := range position {
+			position[axis] = rng.Float64()*2 - 1
+		}
+		answers := make([]float64, len(eightvalues.Questions))
+		for i, question := range eightvalues.Questions {
+			var signal, total float64
+			for axis, effect := range question.Effect {
+				signal += position[axis] * effect
+				total += math.Abs(effect)
+			}
+			answers[i] = quantizeAnswer(signal/total+rng.NormFloat64()*0.35, positions)
+		}
+		return answers
+	}
+	return estimateShortFormAgreement(fmt.Sprintf("eight_values:%d", len(subset)), subset, respond, scoreEightValues)
+}
+
+// Politiscales short forms
+
+// politiscalesLoads returns each politiscales question's keyed loads: yes weights
+// are positive keyed and no weights negative keyed on their axis
+func politiscalesLoads() [][]keyedLoad {
+	axisIndex := make(map[string]int, len(politiscales.Axes))
+	for i, axis := range politiscales.Axes {
+		axisIndex[axis.Name] = i
+	}
+
+	loads := make([][]keyedLoad, len(politiscales.Questions))
+	for i, question := range politiscales.Questions {
+		for _, weight := range question.YesWeights {
+			if weight.Value > 0 {
+				loads[i] = append(loads[i], keyedLoad{axis: axisIndex[weight.Axis], positive: true, weight: weight.Value})
+			}
+		}
+		for _, weight := range question.NoWeights {
+			if weight.Value > 0 {
+				loads[i] = append(loads[i], keyedLoad{axis: axisIndex[weight.Axis], positive: false, weight: weight.Value})
+			}
+		}
+	}
+	return loads
+}
+
+// politiscalesShortForm returns the questions of the politiscales short form of the given length
+func politiscalesShortForm(length int) []int {
+	return selectShortForm(politiscalesLoads(), len(politiscales.Axes), length)
+}
+
+// politiscalesShortFormAgreement estimates how closely a short form matches the full politiscales quiz
+func politiscalesShortFormAgreement(subset []int) shortFormAgreement {
+	positions := []float64{politiscales.StronglyDisagree, politiscales.Disagree, politiscales.Neutral, politiscales.Agree, politiscales.StronglyAgree}
+	respond := func(rng *rand.Rand) []float64 {
+		// Each pair gets one position, favouring its first axis when positive
+		favour := make(map[string]float64, len(politiscales.Axes))
+		pairs := make(map[string]float64)
+		for _, axis := range politiscales.Axes {
+			if axis.Pair == "" {
+				favour[axis.Name] = rng.Float64()*2 - 1
+				continue
+			}
+			position, seen := pairs[axis.Pair]
+			if !seen {
+				position = rng.Float64()*2 - 1
+				pairs[axis.Pair] = position
+				favour[axis.Name] = position
+			} else {
+				favour[axis.Name] = -position
+			}
+		}
+
+		answers := make([]float64, len(politiscales.Questions))
+		for i, question := range politiscales.Questions {
+			var signal, total float64
+			for _, weight := range question.YesWeights {
+				signal += weight.Value * favour[weight.Axis]
+				total += weight.Value
+			}
+			for _, weight := range question.NoWeights {
+				signal -= weight.Value * favour[weight.Axis]
+				total += weight.Value
+			}
+			if total > 0 {
+				signal /= total
+			}
+			answers[i] = quantizeAnswer(signal+rng.NormFloat64()*0.35, positions)
+		}
+		return answers
+	}
+	score := func(answers map[int]float64) []float64 {
+		responses := make(map[int32]float64, len(answers))
+		for question, answer := range answers {
+			responses[politiscales.Questions[question].Index] = answer
+		}
+		results := scorePolitiscales(responses)
+		scores := make([]float64, len(politiscales.Axes))
+		for i, axis := range politiscales.Axes {
+			scores[i] = results[axis.Name]
+		}
+		return scores
+	}
+	return estimateShortFormAgreement(fmt.Sprintf("politiscales:%d", len(subset)), subset, respond, score)
+}
+
+// eightValuesShortFormNote describes the current 8values short form, or returns "" for the full quiz
+func eightValuesShortFormNote() string {
+	if eightValuesQuizState.Length == 0 {
+		return ""
+	}
+	agreement := eightValuesShortFormAgreement(eightValuesShortForm(eightValuesQuizState.Length))
+	return fmt.Sprintf("Short form: %d of %d questions, balanced per axis. Expected agreement with the full quiz: %s\n\n",
+		eightValuesQuizState.Length, len(eightvalues.Questions), agreement)
+}
+
+// politiscalesShortFormNote describes the current politiscales short form, or returns "" for the full quiz
+func politiscalesShortFormNote() string {
+	if politiscalesQuizState.Length == 0 {
+		return ""
+	}
+	agreement := politiscalesShortFormAgreement(politiscalesShortForm(politiscalesQuizState.Length))
+	return fmt.Sprintf("Short form: %d of %d questions, balanced per axis. Expected agreement with the full quiz: %s\n\n",
+		politiscalesQuizState.Length, len(politiscales.Questions), agreement)
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/x86ed/MCP-PoliticalCompass/v3/eightvalues"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+func TestShortFormSelection(t *testing.T) {
+	forms := []struct {
+		name  string
+		loads [][]keyedLoad
+		axes  int
+		form  func(int) []int
+	}{
+		{"8values", eightValuesLoads(), len(eightValuesAxisNames), eightValuesShortForm},
+		{"politiscales", politiscalesLoads(), len(politiscales.Axes), politiscalesShortForm},
+	}
+
+	for _, f := range forms {
+		for _, length := range []int{20, 40} {
+			t.Run(fmt.Sprintf("%s/%d", f.name, length), func(t *testing.T) {
+				subset := f.form(length)
+				if len(subset) != length {
+					t.Fatalf("expected %d questions, got %d", length, len(subset))
+				}
+				if !reflect.DeepEqual(subset, f.form(length)) {
+					t.Error("short forms should be deterministic")
+				}
+
+				seen := map[int]bool{}
+				counts := make([]int, f.axes*2)
+				for _, question := range subset {
+					if seen[question] {
+						t.Fatalf("question %d selected twice", question)
+					}
+					seen[question] = true
+					for _, load := range f.loads[question] {
+						counts[keyedBucket(load)]++
+					}
+				}
+
+				// Every key the bank offers on a paired or multi-question axis is represented
+				available := make([]int, f.axes*2)
+				for _, loads := range f.loads {
+					for _, load := range loads {
+						available[keyedBucket(load)]++
+					}
+				}
+				for bucket, count := range counts {
+					if available[bucket] >= 5 && count == 0 {
+						t.Errorf("axis %d %s keyed questions missing from the short form", bucket/2, map[bool]string{true: "positive", false: "negative"}[bucket%2 == 0])
+					}
+				}
+			})
+		}
+	}
+
+	if full := eightValuesShortForm(len(eightvalues.Questions)); len(full) != len(eightvalues.Questions) {
+		t.Error("a length covering the whole bank should select every question")
+	}
+}
+
+func TestShortFormAgreement(t *testing.T) {
+	short := eightValuesShortFormAgreement(eightValuesShortForm(20))
+	longer := eightValuesShortFormAgreement(eightValuesShortForm(50))
+	full := eightValuesShortFormAgreement(eightValuesShortForm(len(eightvalues.Questions)))
+
+	if short.Correlation <= 0.5 || short.Correlation >= longer.Correlation {
+		t.Errorf("expected longer forms to agree more closely: 20 -> %.3f, 50 -> %.3f", short.Correlation, longer.Correlation)
+	}
+	if full.Correlation < 0.999 || full.MeanDifference > 1e-9 {
+		t.Errorf("the full quiz should agree with itself, got %v", full)
+	}
+
+	if agreement := politiscalesShortFormAgreement(politiscalesShortForm(40)); agreement.Correlation <= 0.5 {
+		t.Errorf("unexpectedly low politiscales agreement: %v", agreement)
+	}
+}
+
+func TestEightValuesShortFormQuiz(t *testing.T) {
+	resetState()
+
+	response, _ := handleEightValues(context.Background(), createMockRequest("eight_values", map[string]interface{}{"answer": "", "length": 20}))
+	content := extractTextContent(response)
+	if !strings.Contains(content, "Short form: 20 of 70 questions") || !strings.Contains(content, "Expected agreement with the full quiz: r = ") {
+		t.Fatalf("start message should describe the short form, got: %s", content)
+	}
+	if !strings.Contains(content, "Question 1 of 20") {
+		t.Errorf("questions should be counted against the short form, got: %s", content)
+	}
+	if !reflect.DeepEqual(sortedCopy(eightValuesShuffledQuestions), eightValuesShortForm(20)) {
+		t.Error("the quiz should ask exactly the short-form questions")
+	}
+
+	for i := 0; i < 20; i++ {
+		response, _ = handleEightValues(context.Background(), createRequestWithAnswer("strongly_agree"))
+	}
+	content = extractTextContent(response)
+	if !strings.Contains(content, "Quiz Complete!") {
+		t.Fatalf("expected completion after 20 answers, got: %s", content)
+	}
+
+	// Maxima come from the selected subset only
+	answers := map[int]float64{}
+	for _, question := range eightValuesShuffledQuestions {
+		answers[question] = eightvalues.StronglyAgree
+	}
+	expected := fmt.Sprintf("Economic Axis: %.1f%%", scoreEightValues(answers)[eightvalues.Economic])
+	if !strings.Contains(content, expected) {
+		t.Errorf("expected %q in completion, got: %s", expected, content)
+	}
+
+	status, _ := handleEightValuesStatus(context.Background(), createEmptyRequest())
+	statusText := extractTextContent(status)
+	if !strings.Contains(statusText, "Questions answered: 20/20") || !strings.Contains(statusText, "**Final Scores:**") {
+		t.Errorf("status should count against the short form, got: %s", statusText)
+	}
+}
+
+func TestPolitiscalesShortFormQuiz(t *testing.T) {
+	resetState()
+
+	handlePolitiscales(context.Background(), createMockRequest("politiscales", map[string]interface{}{"answer": "", "length": 40}))
+	var content string
+	for i := 0; i < 40; i++ {
+		response, _ := handlePolitiscales(context.Background(), createRequestWithAnswer("agree"))
+		content = extractTextContent(response)
+	}
+	if !strings.Contains(content, "Quiz Complete!") || !strings.Contains(content, "Short form: 40 of 117 questions") {
+		t.Fatalf("expected short-form completion after 40 answers, got: %s", content)
+	}
+	if len(politiscalesQuizState.Responses) != 40 {
+		t.Errorf("expected 40 responses, got %d", len(politiscalesQuizState.Responses))
+	}
+}
+
+func TestShortFormValidation(t *testing.T) {
+	resetState()
+
+	response, _ := handleEightValues(context.Background(), createMockRequest("eight_values", map[string]interface{}{"answer": "", "length": 3}))
+	if !isErrorResult(response) || !strings.Contains(extractTextContent(response), "invalid length") {
+		t.Errorf("expected invalid length error, got: %s", extractTextContent(response))
+	}
+
+	response, _ = handlePolitiscales(context.Background(), createMockRequest("politiscales", map[string]interface{}{"answer": "", "length": 60, "adaptive": true}))
+	if !isErrorResult(response) {
+		t.Error("adaptive and length should not be combined")
+	}
+
+	// A length covering the whole bank runs the full quiz
+	handleEightValues(context.Background(), createMockRequest("eight_values", map[string]interface{}{"answer": "", "length": 100}))
+	if eightValuesQuizState.Length != 0 || len(eightValuesShuffledQuestions) != len(eightvalues.Questions) {
+		t.Error("expected the full quiz")
+	}
+}
+
+func sortedCopy(values []int) []int {
+	sorted := append([]int(nil), values...)
+	for i := range sorted {
+		for j := i + 1; j < len(sorted); j++ {
+			if sorted[j] < sorted[i] {
+				sorted[i], sorted[j] = sorted[j], sorted[i]
+			}
+		}
+	}
+	return sorted
+}
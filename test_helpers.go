@@ -1,7 +1,10 @@
 package main
 
 import (
+	"context"
+
 	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
 )
 
 // Helper functions for testing with the new MCP library
@@ -16,6 +19,23 @@ func createMockRequest(toolName string, args map[string]interface{}) mcp.CallToo
 	}
 }
 
+// testingT is the part of testing.T the helpers use, so this file need not import testing
+type testingT interface {
+	Helper()
+	Fatalf(format string, args ...any)
+}
+
+// callTool calls a tool handler with the given arguments and returns the result's
+// text and whether it is an error
+func callTool(t testingT, handler server.ToolHandlerFunc, name string, args map[string]interface{}) (string, bool) {
+	t.Helper()
+	response, err := handler(context.Background(), createMockRequest(name, args))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return extractTextContent(response), isErrorResult(response)
+}
+
 // createRequestWithAnswer creates a request with an "answer" argument
 func createRequestWithAnswer(answer string) mcp.CallToolRequest {
 	return createMockRequest("test_tool", map[string]interface{}{
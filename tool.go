@@ -24,6 +24,9 @@ var (
 	currentIndex       = 0
 	quizState          = &QuizState{}
 
+	// political compass quiz language
+	politicalCompassLanguage = "en" // Default language
+
 	// 8values quiz state
 	eightValuesEconScore         = 0.0
 	eightValuesDiplScore         = 0.0
@@ -33,6 +36,7 @@ var (
 	eightValuesShuffledQuestions []int
 	eightValuesCurrentIndex      = 0
 	eightValuesQuizState         = &EightValuesQuizState{}
+	eightValuesLanguage          = "en" // Default language
 
 	// politiscales quiz state
 	politiscalesAxesScores        = make(map[string]float64)
@@ -45,7 +49,8 @@ var (
 
 // PoliticalCompassArgs represents the arguments for the political compass question tool
 type PoliticalCompassArgs struct {
-	Response string `json:"response" jsonschema:"required,description=Your response to the political compass question. Valid values: strongly_disagree, disagree, agree, strongly_agree"`
+	Response string   `json:"response" jsonschema:"required,description=Your response to the political compass question. Valid values: strongly_disagree, disagree, agree, strongly_agree"`
+	Value    *float64 `json:"value,omitempty" jsonschema:"description=Optional slider answer from -1 (strongly disagree) to 1 (strongly agree)"`
 }
 
 // ResetQuizArgs represents the arguments for the reset quiz tool (no arguments needed)
@@ -60,7 +65,8 @@ type QuizStatusArgs struct {
 
 // 8Values tool argument types
 type EightValuesArgs struct {
-	Response string `json:"response" jsonschema:"required,description=Your response to the 8values question. Valid values: strongly_disagree, disagree, neutral, agree, strongly_agree"`
+	Response string   `json:"response" jsonschema:"required,description=Your response to the 8values question. Valid values: strongly_disagree, disagree, neutral, agree, strongly_agree"`
+	Value    *float64 `json:"value,omitempty" jsonschema:"description=Optional slider answer from -1 (strongly disagree) to 1 (strongly agree)"`
 }
 
 type ResetEightValuesArgs struct {
@@ -73,7 +79,8 @@ type EightValuesStatusArgs struct {
 
 // Politiscales tool argument types
 type PolitiscalesArgs struct {
-	Response string `json:"response" jsonschema:"required,description=Your response to the politiscales question. Valid values: strongly_disagree, disagree, neutral, agree, strongly_agree"`
+	Response string   `json:"response" jsonschema:"required,description=Your response to the politiscales question. Valid values: strongly_disagree, disagree, neutral, agree, strongly_agree"`
+	Value    *float64 `json:"value,omitempty" jsonschema:"description=Optional slider answer from -1 (strongly disagree) to 1 (strongly agree)"`
 }
 
 type ResetPolitiscalesArgs struct {
@@ -90,17 +97,42 @@ type SetPolitiscalesLanguageArgs struct {
 
 // QuizState holds the current state of the quiz
 type QuizState struct {
-	Responses []politicalcompass.Response `json:"responses"`
+	Responses    []politicalcompass.Response `json:"responses"`
+	Values       []float64                   `json:"values"`                  // Raw answer values on the -1 to 1 scale, parallel to Responses
+	Seed         int64                       `json:"seed,omitempty"`          // Seed of the question shuffle
+	Pages        bool                        `json:"pages,omitempty"`         // Present the official six pages in order instead of shuffling
+	Review       bool                        `json:"review,omitempty"`        // Wait for finalize after the last answer
+	Reviewing    bool                        `json:"reviewing,omitempty"`     // Whether the answers are waiting in the review step
+	Finalized    bool                        `json:"finalized,omitempty"`     // Whether a reviewed quiz has been finalized
+	Topic        string                      `json:"topic,omitempty"`         // Topic of a practice run, "" for the whole quiz
+	BankWarnings []string                    `json:"bank_warnings,omitempty"` // Warnings from restoring answers made with an older question bank
 }
 
 // EightValuesQuizState holds the current state of the 8values quiz
 type EightValuesQuizState struct {
-	Responses []float64 `json:"responses"`
+	Responses    []float64 `json:"responses"`
+	Seed         int64     `json:"seed,omitempty"`          // Seed of the question shuffle
+	Adaptive     bool      `json:"adaptive,omitempty"`      // Stop once every axis is within Margin
+	Margin       float64   `json:"margin,omitempty"`        // Adaptive stopping margin in percentage points
+	Length       int       `json:"length,omitempty"`        // Short form length, 0 for the full quiz
+	Review       bool      `json:"review,omitempty"`        // Wait for finalize after the last answer
+	Reviewing    bool      `json:"reviewing,omitempty"`     // Whether the answers are waiting in the review step
+	Finalized    bool      `json:"finalized,omitempty"`     // Whether a reviewed quiz has been finalized
+	Topic        string    `json:"topic,omitempty"`         // Topic of a practice run, "" for the whole quiz
+	BankWarnings []string  `json:"bank_warnings,omitempty"` // Warnings from restoring answers made with an older question bank
 }
 
 // PolitiscalesQuizState holds the current state of the politiscales quiz
 type PolitiscalesQuizState struct {
-	Responses map[int32]float64 `json:"responses"` // Question index -> response value
+	Responses    map[int32]float64 `json:"responses"`               // Question index -> response value
+	Adaptive     bool              `json:"adaptive,omitempty"`      // Stop once every axis is within Margin
+	Margin       float64           `json:"margin,omitempty"`        // Adaptive stopping margin in percentage points
+	Length       int               `json:"length,omitempty"`        // Short form length, 0 for the full quiz
+	Review       bool              `json:"review,omitempty"`        // Wait for finalize after the last answer
+	Reviewing    bool              `json:"reviewing,omitempty"`     // Whether the answers are waiting in the review step
+	Finalized    bool              `json:"finalized,omitempty"`     // Whether a reviewed quiz has been finalized
+	Topic        string            `json:"topic,omitempty"`         // Topic of a practice run, "" for the whole quiz
+	BankWarnings []string          `json:"bank_warnings,omitempty"` // Warnings from restoring answers made with an older question bank
 }
 
 // Reset state helper function for tests
@@ -126,98 +158,110 @@ func initializeQuestions() {
 		for i := range shuffledQuestions {
 			shuffledQuestions[i] = i
 		}
-		rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+		// Page mode keeps the official order
+		if quizState.Pages {
+			return
+		}
+		quizState.Seed = time.Now().UnixNano()
+		rng := rand.New(rand.NewSource(quizState.Seed))
 		rng.Shuffle(len(shuffledQuestions), func(i, j int) {
 			shuffledQuestions[i], shuffledQuestions[j] = shuffledQuestions[j], shuffledQuestions[i]
 		})
+		// Practice runs ask only the questions on one topic, keeping the shuffled order
+		if quizState.Topic != "" {
+			shuffledQuestions = restrictOrder(shuffledQuestions, topicQuestions(politicalCompassQuiz, quizState.Topic))
+		}
 	}
 }
 
 // Handler function for political compass tool
 func handlePoliticalCompass(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	// Extract the answer argument
+	// Extract the answer argument, or the slider value that replaces it
 	answer, err := request.RequireString("answer")
-	if err != nil {
+	value, hasValue, valueErr := answerValue(request)
+	if valueErr != nil {
+		return mcp.NewToolResultError(valueErr.Error()), nil
+	}
+	_, hasAnswers := request.GetArguments()["answers"]
+	if err != nil && !hasValue && !hasAnswers && !request.GetBool("pages", false) {
 		return mcp.NewToolResultError("Answer is required"), nil
 	}
 
 	mutex.Lock()
 	defer mutex.Unlock()
 
+	// Page mode is chosen when the quiz starts
+	if questionCount == 0 && request.GetBool("pages", false) {
+		if request.GetBool("prefill", false) {
+			return mcp.NewToolResultError("pages and prefill cannot be combined: page mode asks every proposition in the official order"), nil
+		}
+		quizState.Pages = true
+		shuffledQuestions = nil
+	}
+	if questionCount == 0 {
+		quizState.Review = request.GetBool("review", false)
+		topic, err := topicOption(request, politicalCompassQuiz)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if topic != "" && (quizState.Pages || request.GetBool("prefill", false)) {
+			return mcp.NewToolResultError("topic cannot be combined with pages or prefill: a practice run asks every question on its topic"), nil
+		}
+		if topic != quizState.Topic {
+			quizState.Topic = topic
+			shuffledQuestions = nil
+		}
+	}
+
 	// Initialize questions if not done already
 	initializeQuestions()
 
+	if quizState.Pages {
+		return handlePoliticalCompassPage(request, answer, value, hasValue), nil
+	}
+	if hasAnswers {
+		return mcp.NewToolResultError("answers records a whole page at once and needs page mode: reset the quiz and start it with pages set to true"), nil
+	}
+
 	var question politicalcompass.Question
 	var isFirstQuestion = false
 
+	// Once every question is answered, the review waits for amend_answer or finalize
+	if reviewPending(politicalCompassQuiz) {
+		return mcp.NewToolResultText(reviewList(politicalCompassQuiz)), nil
+	}
+
 	// If this is a response to a previous question, process it first
 	if questionCount > 0 {
 		// Get the last asked question to calculate scores
-		lastQuestionIndex := shuffledQuestions[currentIndex-1]
-		lastQuestion := politicalcompass.AllQuestions[lastQuestionIndex]
+		lastQuestion := politicalcompass.AllQuestions[shuffledQuestions[currentIndex-1]]
 
 		// Parse the response
 		var response politicalcompass.Response
-		switch answer {
-		case "Strongly Disagree", "strongly_disagree":
-			response = politicalcompass.StronglyDisagree
-		case "Disagree", "disagree":
-			response = politicalcompass.Disagree
-		case "Agree", "agree":
-			response = politicalcompass.Agree
-		case "Strongly Agree", "strongly_agree":
-			response = politicalcompass.StronglyAgree
-		default:
-			return mcp.NewToolResultError(fmt.Sprintf("invalid response: %s. Please use one of: strongly_disagree, disagree, agree, strongly_agree", answer)), nil
+		if hasValue {
+			// Slider answers interpolate between the four weight columns
+			response = politicalcompass.NearestResponse(value)
+		} else {
+			var ok bool
+			if response, ok = parsePoliticalCompassAnswer(answer); !ok {
+				return mcp.NewToolResultError(invalidPoliticalCompassAnswer(answer)), nil
+			}
+			value = politicalcompass.ResponseValues[response]
 		}
 
-		// Calculate and accumulate scores
-		economicScore := lastQuestion.Economic[int(response)]
-		socialScore := lastQuestion.Social[int(response)]
-		totalEconomicScore += economicScore
-		totalSocialScore += socialScore
-
-		// Record the response in quiz state
-		quizState.Responses = append(quizState.Responses, response)
+		recordPoliticalCompassAnswer(lastQuestion, response, value)
 	} else {
 		isFirstQuestion = true
+
+		// Answers to matching questions in the other quizzes can be carried over
+		if request.GetBool("prefill", false) {
+			return startPrefilled(politicalCompassQuiz, false, 0, 0, quizState.Review), nil
+		}
 	}
 
 	// Check if we've asked all questions
 	if currentIndex >= len(shuffledQuestions) {
-		// Calculate final position using the same algorithm as pc.js
-		// Normalize scores: divide by 8.0 and 19.5 respectively
-		valE := totalEconomicScore / 8.0
-		valS := totalSocialScore / 19.5
-
-		// Apply offsets (same as e0 and s0 in pc.js)
-		valE += 0.38
-		valS += 2.41
-
-		// Round to 2 decimal places for consistency
-		avgEconomicScore := float64(int(valE*100+0.5)) / 100
-		avgSocialScore := float64(int(valS*100+0.5)) / 100
-
-		// Determine quadrant
-		quadrant := getQuadrant(avgEconomicScore, avgSocialScore)
-
-		// Generate SVG graph showing the user's position
-		svg := politicalcompass.GenerateSVG(avgEconomicScore, avgSocialScore)
-
-		message := fmt.Sprintf("🎉 Political Compass Quiz Complete!\n\n"+
-			"Questions answered: %d\n"+
-			"Final Economic Score: %.2f (Left: + | Right: -)\n"+
-			"Final Social Score: %.2f (Libertarian: + | Authoritarian: -)\n"+
-			"Your Political Quadrant: %s\n\n"+
-			"%s\n\n"+
-			"**Instructions for displaying the results:**\n"+
-			"1. Show the above scores and quadrant information to the user\n"+
-			"2. **IMPORTANT: Render the SVG chart above so the user can see their position visually. (it's inline markdown so an artifact may work best)**\n"+
-			"3. The red dot on the chart shows your exact political position\n\n"+
-			"Thank you for completing the Political Compass quiz!",
-			questionCount, avgEconomicScore, avgSocialScore, quadrant, svg)
-
-		return mcp.NewToolResultText(message), nil
+		return mcp.NewToolResultText(finishQuiz(politicalCompassQuiz)), nil
 	}
 
 	// Get the next question
@@ -228,14 +272,16 @@ func handlePoliticalCompass(ctx context.Context, request mcp.CallToolRequest) (*
 
 	var message string
 	if isFirstQuestion {
-		message = fmt.Sprintf("🗳️ Political Compass Quiz Started!\n\n"+
+		message = fmt.Sprintf("🗳️ Political Compass Quiz Started! (Language: %s)\n\n"+
+			"%s"+
 			"Question %d of %d:\n%s\n\n"+
 			"Please respond with: strongly_disagree, disagree, agree, or strongly_agree\n\n"+
 			"**Important Instructions:**\n"+
 			"1. Present this question in the chat for the user to see\n"+
 			"2. After the user provides their response, show both the question and their answer in chat\n"+
 			"3. Then call this tool again with their response to continue to the next question",
-			questionCount, len(politicalcompass.AllQuestions), question.Text)
+			politicalCompassLanguage, topicPracticeNote(politicalCompassQuiz), questionCount, len(shuffledQuestions), getPoliticalCompassQuestionText(question.Text))
+		message += prefillHint(politicalCompassQuiz)
 	} else {
 		message = fmt.Sprintf("✅ Response recorded!\n\n"+
 			"Progress: %d of %d questions completed\n\n"+
@@ -245,13 +291,83 @@ func handlePoliticalCompass(ctx context.Context, request mcp.CallToolRequest) (*
 			"1. Present this question in the chat for the user to see\n"+
 			"2. After the user provides their response, show both the question and their answer in chat\n"+
 			"3. Then call this tool again with their response to continue to the next question",
-			questionCount-1, len(politicalcompass.AllQuestions),
-			questionCount, len(politicalcompass.AllQuestions), question.Text)
+			questionCount-1, len(shuffledQuestions),
+			questionCount, len(shuffledQuestions), getPoliticalCompassQuestionText(question.Text))
 	}
 
 	return mcp.NewToolResultText(message), nil
 }
 
+// politicalCompassCompletion builds the message shown once every question is answered
+func politicalCompassCompletion() string {
+	// Calculate final position using the same algorithm as pc.js
+	avgEconomicScore, avgSocialScore := politicalCompassPosition(totalEconomicScore, totalSocialScore)
+
+	// Determine quadrant
+	quadrant := getQuadrant(avgEconomicScore, avgSocialScore)
+
+	// Generate SVG graph showing the user's position and its confidence intervals
+	economicMargin, socialMargin := politicalCompassConfidenceMargins()
+	svg := politicalcompass.GenerateSVGWithOptions(avgEconomicScore, avgSocialScore,
+		politicalcompass.SVGOptions{EconomicMargin: economicMargin, SocialMargin: socialMargin})
+
+	message := fmt.Sprintf("🎉 Political Compass Quiz Complete!\n\n"+
+		"%s"+
+		"Questions answered: %d\n"+
+		"Final Economic Score: %.2f%s (Left: + | Right: -)\n"+
+		"Final Social Score: %.2f%s (Libertarian: + | Authoritarian: -)\n"+
+		"Your Political Quadrant: %s\n\n"+
+		"%s\n\n"+
+		"**Instructions for displaying the results:**\n"+
+		"1. Show the above scores and quadrant information to the user\n"+
+		"2. **IMPORTANT: Render the SVG chart above so the user can see their position visually. (it's inline markdown so an artifact may work best)**\n"+
+		"3. The red dot on the chart shows your exact political position, and the error bars its 95%% confidence interval\n\n"+
+		"Thank you for completing the Political Compass quiz!",
+		topicPracticeNote(politicalCompassQuiz)+reliabilityWarning(politicalCompassDiagnostics(), "quiz_status"),
+		questionCount,
+		avgEconomicScore, formatConfidence(avgEconomicScore, economicMargin, -10, 10, 2),
+		avgSocialScore, formatConfidence(avgSocialScore, socialMargin, -10, 10, 2),
+		quadrant, svg)
+	message += shareCodeLine(politicalCompassQuiz)
+	message += partyMatchLine(politicalCompassQuiz)
+
+	return message
+}
+
+// parsePoliticalCompassAnswer resolves a written answer, in any supported form or
+// language, to its fixed response
+func parsePoliticalCompassAnswer(answer string) (politicalcompass.Response, bool) {
+	switch normalizeAnswer(answer, politicalCompassCopyTables, politicalCompassLanguage) {
+	case "strongly_disagree":
+		return politicalcompass.StronglyDisagree, true
+	case "disagree":
+		return politicalcompass.Disagree, true
+	case "agree":
+		return politicalcompass.Agree, true
+	case "strongly_agree":
+		return politicalcompass.StronglyAgree, true
+	}
+	return 0, false
+}
+
+// invalidPoliticalCompassAnswer explains an answer parsePoliticalCompassAnswer rejected
+func invalidPoliticalCompassAnswer(answer string) string {
+	// The political compass has no neutral option
+	return invalidAnswerMessage(answer,
+		[]string{"strongly_disagree", "disagree", "agree", "strongly_agree"},
+		politicalCompassCopyTables, politicalCompassLanguage)
+}
+
+// recordPoliticalCompassAnswer scores an answer and records the response (nearest
+// fixed answer) and its raw value in quiz state. The caller must hold the mutex.
+func recordPoliticalCompassAnswer(question politicalcompass.Question, response politicalcompass.Response, value float64) {
+	economicScore, socialScore := question.Scores(value)
+	totalEconomicScore += economicScore
+	totalSocialScore += socialScore
+	quizState.Responses = append(quizState.Responses, response)
+	quizState.Values = append(quizState.Values, value)
+}
+
 // Handler function for reset quiz tool
 func handleResetQuiz(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	mutex.Lock()
@@ -278,7 +394,7 @@ func handleQuizStatus(ctx context.Context, request mcp.CallToolRequest) (*mcp.Ca
 	defer mutex.Unlock()
 
 	// Get current state
-	totalQuestions := len(politicalcompass.AllQuestions)
+	totalQuestions := politicalCompassTotal()
 	answered := len(quizState.Responses)
 	remaining := totalQuestions - answered
 
@@ -290,8 +406,9 @@ func handleQuizStatus(ctx context.Context, request mcp.CallToolRequest) (*mcp.Ca
 			if i < len(shuffledQuestions) {
 				questionIndex := shuffledQuestions[i]
 				question := politicalcompass.AllQuestions[questionIndex]
-				economicScore += question.Economic[int(response)]
-				socialScore += question.Social[int(response)]
+				economic, social := question.Scores(politicalCompassAnswerValue(i, response))
+				economicScore += economic
+				socialScore += social
 			}
 		}
 		// Normalize scores like the main calculation
@@ -308,16 +425,23 @@ func handleQuizStatus(ctx context.Context, request mcp.CallToolRequest) (*mcp.Ca
 **Progress:**
 - Questions answered: %d/%d
 - Questions remaining: %d
+- Language: %s
 - Completion: %.1f%%
-`, answered, totalQuestions, remaining,
+`, answered, totalQuestions, remaining, politicalCompassLanguage,
 		float64(answered)/float64(totalQuestions)*100)
+	statusText += politicalCompassPageStatus()
+	if quizState.Topic != "" {
+		statusText += "- Mode: " + strings.TrimSpace(topicPracticeNote(politicalCompassQuiz)) + "\n"
+	}
 
 	// Only show scores and quadrant if quiz is complete
-	if remaining == 0 && answered > 0 {
+	if remaining == 0 && answered > 0 && !reviewPending(politicalCompassQuiz) {
+		// Margins are on the compass scale; the status scores above are that scale times 20, less 10
+		economicMargin, socialMargin := politicalCompassConfidenceMargins()
 		statusText += fmt.Sprintf(`
 **Final Scores:**
-- Economic axis: %.2f (%.2f%% toward %s)
-- Social axis: %.2f (%.2f%% toward %s)
+- Economic axis: %.2f (%.2f%% toward %s, ±%.2f at 95%% confidence)
+- Social axis: %.2f (%.2f%% toward %s, ±%.2f at 95%% confidence)
 
 **Your Quadrant:** %s
 `, economicScore, abs(economicScore)/10*100,
@@ -327,6 +451,7 @@ func handleQuizStatus(ctx context.Context, request mcp.CallToolRequest) (*mcp.Ca
 				}
 				return "Left (Planned)"
 			}(),
+			economicMargin*20,
 			socialScore, abs(socialScore)/10*100,
 			func() string {
 				if socialScore > 0 {
@@ -334,6 +459,7 @@ func handleQuizStatus(ctx context.Context, request mcp.CallToolRequest) (*mcp.Ca
 				}
 				return "Authoritarian"
 			}(),
+			socialMargin*20,
 			getQuadrant(economicScore, socialScore))
 	}
 
@@ -354,10 +480,21 @@ func handleQuizStatus(ctx context.Context, request mcp.CallToolRequest) (*mcp.Ca
 		}
 	}
 
+	// Slider answers are counted under their nearest response; show the exact values too
+	rawValues := make([]float64, answered)
+	for i, response := range quizState.Responses {
+		rawValues[i] = politicalCompassAnswerValue(i, response)
+	}
+	statusText += formatRawValues(rawValues, politicalcompass.ResponseValues[:])
+	statusText += formatDiagnostics(politicalCompassDiagnostics())
+	statusText += bankWarningNote(politicalCompassQuiz)
+
 	if answered == 0 {
 		statusText += "\n*No questions answered yet. Use the `political_compass` tool to start the quiz.*"
 	} else if remaining > 0 {
 		statusText += fmt.Sprintf("\n*Continue with the `political_compass` tool to answer %d more questions.*", remaining)
+	} else if reviewPending(politicalCompassQuiz) {
+		statusText += reviewStatusNote
 	} else {
 		statusText += "\n*✅ Quiz complete! All questions have been answered.*"
 	}
@@ -365,6 +502,41 @@ func handleQuizStatus(ctx context.Context, request mcp.CallToolRequest) (*mcp.Ca
 	return mcp.NewToolResultText(statusText), nil
 }
 
+// politicalCompassAnswerValue returns the raw value of the i-th answer, falling back to
+// the fixed position of its response when no value was recorded
+func politicalCompassAnswerValue(i int, response politicalcompass.Response) float64 {
+	if i < len(quizState.Values) {
+		return quizState.Values[i]
+	}
+	return politicalcompass.ResponseValues[response]
+}
+
+// politicalCompassPosition converts summed economic and social scores into a
+// compass position, using the same normalisation and offsets as pc.js
+func politicalCompassPosition(economicTotal, socialTotal float64) (economic, social float64) {
+	// Normalize scores: divide by 8.0 and 19.5 respectively
+	valE := economicTotal / 8.0
+	valS := socialTotal / 19.5
+
+	// Apply offsets (same as e0 and s0 in pc.js)
+	valE += 0.38
+	valS += 2.41
+
+	// Round to 2 decimal places for consistency
+	return float64(int(valE*100+0.5)) / 100, float64(int(valS*100+0.5)) / 100
+}
+
+// scorePoliticalCompass returns the compass position for answers keyed by question index
+func scorePoliticalCompass(answers map[int]float64) (economic, social float64) {
+	var economicTotal, socialTotal float64
+	for question, value := range answers {
+		economicScore, socialScore := politicalcompass.AllQuestions[question].Scores(value)
+		economicTotal += economicScore
+		socialTotal += socialScore
+	}
+	return politicalCompassPosition(economicTotal, socialTotal)
+}
+
 // Helper function for absolute value
 func abs(x float64) float64 {
 	if x < 0 {
@@ -409,6 +581,30 @@ func resetPolitiscalesState() {
 	politiscalesQuizState = &PolitiscalesQuizState{Responses: make(map[int32]float64)}
 }
 
+// eightValuesMaxima returns the largest possible score on each axis over the questions asked so far
+func eightValuesMaxima() (maxEcon, maxDipl, maxGovt, maxScty float64) {
+	for i := range eightValuesQuizState.Responses {
+		if i >= len(eightValuesShuffledQuestions) {
+			break
+		}
+		q := eightvalues.Questions[eightValuesShuffledQuestions[i]]
+		maxEcon += abs(q.Effect[eightvalues.Economic])
+		maxDipl += abs(q.Effect[eightvalues.Diplomatic])
+		maxGovt += abs(q.Effect[eightvalues.Government])
+		maxScty += abs(q.Effect[eightvalues.Society])
+	}
+	return maxEcon, maxDipl, maxGovt, maxScty
+}
+
+// eightValuesPercentage applies the 8values calc_score formula, treating an axis
+// no asked question touched as centred
+func eightValuesPercentage(score, max float64) float64 {
+	if max == 0 {
+		return 50
+	}
+	return 100 * (max + score) / (2 * max)
+}
+
 // Initialize shuffled question order for 8values
 func initializeEightValuesQuestions() {
 	if len(eightValuesShuffledQuestions) == 0 {
@@ -416,7 +612,8 @@ func initializeEightValuesQuestions() {
 		for i := range eightValuesShuffledQuestions {
 			eightValuesShuffledQuestions[i] = i
 		}
-		rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+		eightValuesQuizState.Seed = time.Now().UnixNano()
+		rng := rand.New(rand.NewSource(eightValuesQuizState.Seed))
 		rng.Shuffle(len(eightValuesShuffledQuestions), func(i, j int) {
 			eightValuesShuffledQuestions[i], eightValuesShuffledQuestions[j] = eightValuesShuffledQuestions[j], eightValuesShuffledQuestions[i]
 		})
@@ -425,9 +622,13 @@ func initializeEightValuesQuestions() {
 
 // Handler function for 8values quiz tool
 func handleEightValues(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	// Extract the answer argument
+	// Extract the answer argument, or the slider value that replaces it
 	answer, err := request.RequireString("answer")
-	if err != nil {
+	value, hasValue, valueErr := answerValue(request)
+	if valueErr != nil {
+		return mcp.NewToolResultError(valueErr.Error()), nil
+	}
+	if err != nil && !hasValue {
 		return mcp.NewToolResultError("Answer is required"), nil
 	}
 
@@ -440,6 +641,11 @@ func handleEightValues(ctx context.Context, request mcp.CallToolRequest) (*mcp.C
 	var question eightvalues.Question
 	var isFirstQuestion = false
 
+	// Once every question is answered, the review waits for amend_answer or finalize
+	if reviewPending(eightValuesQuiz) {
+		return mcp.NewToolResultText(reviewList(eightValuesQuiz)), nil
+	}
+
 	// If this is a response to a previous question, process it first
 	if eightValuesQuestionCount > 0 {
 		// Get the last asked question to calculate scores
@@ -448,19 +654,24 @@ func handleEightValues(ctx context.Context, request mcp.CallToolRequest) (*mcp.C
 
 		// Parse the response and get multiplier
 		var multiplier float64
-		switch answer {
-		case "strongly_disagree":
-			multiplier = eightvalues.StronglyDisagree
-		case "disagree":
-			multiplier = eightvalues.Disagree
-		case "neutral":
-			multiplier = eightvalues.Neutral
-		case "agree":
-			multiplier = eightvalues.Agree
-		case "strongly_agree":
-			multiplier = eightvalues.StronglyAgree
-		default:
-			return mcp.NewToolResultError(fmt.Sprintf("invalid response: %s. Please use one of: strongly_disagree, disagree, neutral, agree, strongly_agree", answer)), nil
+		if hasValue {
+			// The multipliers already span -1 to 1, so slider values are used as-is
+			multiplier = value
+		} else {
+			switch normalizeAnswer(answer, eightValuesCopyTables, eightValuesLanguage) {
+			case "strongly_disagree":
+				multiplier = eightvalues.StronglyDisagree
+			case "disagree":
+				multiplier = eightvalues.Disagree
+			case "neutral":
+				multiplier = eightvalues.Neutral
+			case "agree":
+				multiplier = eightvalues.Agree
+			case "strongly_agree":
+				multiplier = eightvalues.StronglyAgree
+			default:
+				return mcp.NewToolResultError(invalidAnswerMessage(answer, answerTokens, eightValuesCopyTables, eightValuesLanguage)), nil
+			}
 		}
 
 		// Calculate and accumulate scores using the 8values scoring logic
@@ -474,77 +685,53 @@ func handleEightValues(ctx context.Context, request mcp.CallToolRequest) (*mcp.C
 		eightValuesQuizState.Responses = append(eightValuesQuizState.Responses, multiplier)
 	} else {
 		isFirstQuestion = true
-	}
 
-	// Check if we've asked all questions
-	if eightValuesCurrentIndex >= len(eightValuesShuffledQuestions) {
-		// Calculate maximum possible scores for each axis (like in 8values.js)
-		var maxEcon, maxDipl, maxGovt, maxScty float64
-		for _, q := range eightvalues.Questions {
-			maxEcon += abs(q.Effect[eightvalues.Economic])
-			maxDipl += abs(q.Effect[eightvalues.Diplomatic])
-			maxGovt += abs(q.Effect[eightvalues.Government])
-			maxScty += abs(q.Effect[eightvalues.Society])
+		adaptive, margin, err := adaptiveOptions(request, defaultEightValuesMargin)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
 		}
-
-		// Calculate final scores using the 8values calc_score formula:
-		// (100*(max+score)/(2*max)).toFixed(1)
-		econPercentage := (100 * (maxEcon + eightValuesEconScore) / (2 * maxEcon))
-		diplPercentage := (100 * (maxDipl + eightValuesDiplScore) / (2 * maxDipl))
-		govtPercentage := (100 * (maxGovt + eightValuesGovtScore) / (2 * maxGovt))
-		sctyPercentage := (100 * (maxScty + eightValuesSctyScore) / (2 * maxScty))
-
-		// Determine ideological classifications
-		var economicLabel, diplomaticLabel, governmentLabel, societyLabel string
-
-		if econPercentage > 50 {
-			economicLabel = "Socialist"
-		} else {
-			economicLabel = "Capitalist"
+		length, err := shortFormLength(request, minEightValuesLength, len(eightvalues.Questions))
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
 		}
-
-		if diplPercentage > 50 {
-			diplomaticLabel = "Internationalist"
-		} else {
-			diplomaticLabel = "Nationalist"
+		if adaptive && length > 0 {
+			return mcp.NewToolResultError("adaptive and length cannot be combined: choose an adaptive quiz or a fixed short form"), nil
 		}
-
-		if govtPercentage > 50 {
-			governmentLabel = "Libertarian"
-		} else {
-			governmentLabel = "Authoritarian"
+		topic, err := topicOption(request, eightValuesQuiz)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
 		}
-
-		if sctyPercentage > 50 {
-			societyLabel = "Progressive"
-		} else {
-			societyLabel = "Traditional"
+		if topic != "" && (adaptive || length > 0 || request.GetBool("prefill", false)) {
+			return mcp.NewToolResultError("topic cannot be combined with adaptive, length or prefill: a practice run asks every question on its topic"), nil
+		}
+		// Answers to matching questions in the other quizzes can be carried over
+		if request.GetBool("prefill", false) {
+			return startPrefilled(eightValuesQuiz, adaptive, margin, length, request.GetBool("review", false)), nil
+		}
+		eightValuesQuizState.Adaptive = adaptive
+		eightValuesQuizState.Review = request.GetBool("review", false)
+		eightValuesQuizState.Margin = margin
+
+		// Short forms ask a fixed balanced subset, keeping the shuffled order
+		if length > 0 {
+			eightValuesShuffledQuestions = restrictOrder(eightValuesShuffledQuestions, eightValuesShortForm(length))
+			eightValuesQuizState.Length = length
+		}
+		// Practice runs ask only the questions on one topic, keeping the shuffled order
+		if topic != "" {
+			eightValuesShuffledQuestions = restrictOrder(eightValuesShuffledQuestions, topicQuestions(eightValuesQuiz, topic))
+			eightValuesQuizState.Topic = topic
 		}
+	}
+
+	// Check if we've asked all questions, or an adaptive quiz has stabilised
+	if !isFirstQuestion && (eightValuesCurrentIndex >= len(eightValuesShuffledQuestions) || eightValuesAdaptiveStable()) {
+		return mcp.NewToolResultText(finishQuiz(eightValuesQuiz)), nil
+	}
 
-		// Generate SVG graph showing the user's position on all four axes
-		svg := eightvalues.GenerateSVG(econPercentage, diplPercentage, govtPercentage, sctyPercentage)
-
-		message := fmt.Sprintf("🎉 8values Political Quiz Complete!\n\n"+
-			"Questions answered: %d\n\n"+
-			"**Final Scores:**\n"+
-			"- Economic Axis: %.1f%% %s\n"+
-			"- Diplomatic Axis: %.1f%% %s\n"+
-			"- Government Axis: %.1f%% %s\n"+
-			"- Society Axis: %.1f%% %s\n\n"+
-			"%s\n\n"+
-			"**Instructions for displaying the results:**\n"+
-			"1. Show the above scores and classifications to the user\n"+
-			"2. **IMPORTANT: Render the SVG chart below as an artifact so the user can see their position visually**\n"+
-			"3. The chart shows your position on all four political axes\n\n"+
-			"Thank you for completing the 8values quiz!",
-			eightValuesQuestionCount,
-			econPercentage, economicLabel,
-			diplPercentage, diplomaticLabel,
-			govtPercentage, governmentLabel,
-			sctyPercentage, societyLabel,
-			svg)
-
-		return mcp.NewToolResultText(message), nil
+	// Adaptive quizzes pick the most informative remaining question
+	if eightValuesQuizState.Adaptive {
+		selectAdaptiveQuestion(eightValuesShuffledQuestions, eightValuesCurrentIndex, eightValuesAxisEstimates(), eightValuesAxisWeight)
 	}
 
 	// Get the next question
@@ -555,14 +742,16 @@ func handleEightValues(ctx context.Context, request mcp.CallToolRequest) (*mcp.C
 
 	var message string
 	if isFirstQuestion {
-		message = fmt.Sprintf("🗳️ 8values Political Quiz Started!\n\n"+
+		message = fmt.Sprintf("🗳️ 8values Political Quiz Started! (Language: %s)\n\n"+
+			"%s"+
 			"Question %d of %d:\n%s\n\n"+
 			"Please respond with: strongly_disagree, disagree, neutral, agree, or strongly_agree\n\n"+
 			"**Important Instructions:**\n"+
 			"1. Present this question in the chat for the user to see\n"+
 			"2. After the user provides their response, show both the question and their answer in chat\n"+
 			"3. Then call this tool again with their response to continue to the next question",
-			eightValuesQuestionCount, len(eightvalues.Questions), question.Text)
+			eightValuesLanguage, eightValuesShortFormNote()+topicPracticeNote(eightValuesQuiz), eightValuesQuestionCount, len(eightValuesShuffledQuestions), getEightValuesQuestionText(question.Text))
+		message += prefillHint(eightValuesQuiz)
 	} else {
 		message = fmt.Sprintf("✅ Response recorded!\n\n"+
 			"Progress: %d of %d questions completed\n\n"+
@@ -572,13 +761,100 @@ func handleEightValues(ctx context.Context, request mcp.CallToolRequest) (*mcp.C
 			"1. Present this question in the chat for the user to see\n"+
 			"2. After the user provides their response, show both the question and their answer in chat\n"+
 			"3. Then call this tool again with their response to continue to the next question",
-			eightValuesQuestionCount-1, len(eightvalues.Questions),
-			eightValuesQuestionCount, len(eightvalues.Questions), question.Text)
+			eightValuesQuestionCount-1, len(eightValuesShuffledQuestions),
+			eightValuesQuestionCount, len(eightValuesShuffledQuestions), getEightValuesQuestionText(question.Text))
 	}
 
 	return mcp.NewToolResultText(message), nil
 }
 
+// eightValuesCompletion builds the message shown once the 8values quiz is over
+func eightValuesCompletion() string {
+	// Calculate maximum possible scores for each axis (like in 8values.js),
+	// over the questions actually asked
+	maxEcon, maxDipl, maxGovt, maxScty := eightValuesMaxima()
+
+	// Calculate final scores using the 8values calc_score formula:
+	// (100*(max+score)/(2*max)).toFixed(1)
+	econPercentage := eightValuesPercentage(eightValuesEconScore, maxEcon)
+	diplPercentage := eightValuesPercentage(eightValuesDiplScore, maxDipl)
+	govtPercentage := eightValuesPercentage(eightValuesGovtScore, maxGovt)
+	sctyPercentage := eightValuesPercentage(eightValuesSctyScore, maxScty)
+
+	// Determine ideological classifications
+	var economicLabel, diplomaticLabel, governmentLabel, societyLabel string
+
+	if econPercentage > 50 {
+		economicLabel = "Socialist"
+	} else {
+		economicLabel = "Capitalist"
+	}
+
+	if diplPercentage > 50 {
+		diplomaticLabel = "Internationalist"
+	} else {
+		diplomaticLabel = "Nationalist"
+	}
+
+	if govtPercentage > 50 {
+		governmentLabel = "Libertarian"
+	} else {
+		governmentLabel = "Authoritarian"
+	}
+
+	if sctyPercentage > 50 {
+		societyLabel = "Progressive"
+	} else {
+		societyLabel = "Traditional"
+	}
+
+	// Generate SVG graph showing the user's position on all four axes, with confidence intervals
+	confidence := eightValuesConfidenceMargins()
+	svg := eightvalues.GenerateSVGWithOptions(econPercentage, diplPercentage, govtPercentage, sctyPercentage,
+		eightvalues.SVGOptions{Margins: confidence})
+
+	margins := [4]string{}
+	for axis, percentage := range [4]float64{econPercentage, diplPercentage, govtPercentage, sctyPercentage} {
+		margins[axis] = formatConfidence(percentage, confidence[axis], 0, 100, 1)
+	}
+	adaptiveNote := ""
+	if eightValuesQuizState.Adaptive {
+		adaptiveNote = fmt.Sprintf("Adaptive mode: stopped after %d of %d questions with every axis within ±%.1f points\n\n",
+			len(eightValuesQuizState.Responses), len(eightvalues.Questions), eightValuesQuizState.Margin)
+	}
+	if eightValuesQuizState.Length > 0 {
+		adaptiveNote = eightValuesShortFormNote()
+	}
+	adaptiveNote += topicPracticeNote(eightValuesQuiz)
+	// Patterned or contradictory answers are flagged next to the mode notes
+	adaptiveNote += reliabilityWarning(eightValuesDiagnostics(), "eight_values_status")
+
+	message := fmt.Sprintf("🎉 8values Political Quiz Complete!\n\n"+
+		"Questions answered: %d\n\n"+
+		"%s"+
+		"**Final Scores:**\n"+
+		"- Economic Axis: %.1f%% %s%s\n"+
+		"- Diplomatic Axis: %.1f%% %s%s\n"+
+		"- Government Axis: %.1f%% %s%s\n"+
+		"- Society Axis: %.1f%% %s%s\n\n"+
+		"%s\n\n"+
+		"**Instructions for displaying the results:**\n"+
+		"1. Show the above scores and classifications to the user\n"+
+		"2. **IMPORTANT: Render the SVG chart below as an artifact so the user can see their position visually**\n"+
+		"3. The chart shows your position on all four political axes, with error bars for the 95%% confidence intervals\n\n"+
+		"Thank you for completing the 8values quiz!",
+		eightValuesQuestionCount,
+		adaptiveNote,
+		econPercentage, economicLabel, margins[eightvalues.Economic],
+		diplPercentage, diplomaticLabel, margins[eightvalues.Diplomatic],
+		govtPercentage, governmentLabel, margins[eightvalues.Government],
+		sctyPercentage, societyLabel, margins[eightvalues.Society],
+		svg)
+	message += shareCodeLine(eightValuesQuiz)
+	message += partyMatchLine(eightValuesQuiz)
+	return message
+}
+
 // Handler function for reset 8values quiz tool
 func handleResetEightValues(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	mutex.Lock()
@@ -601,8 +877,20 @@ func handleEightValuesStatus(ctx context.Context, request mcp.CallToolRequest) (
 
 	// Get current state
 	totalQuestions := len(eightvalues.Questions)
+	if eightValuesQuizState.Length > 0 {
+		totalQuestions = eightValuesQuizState.Length
+	}
+	if eightValuesQuizState.Topic != "" {
+		totalQuestions = len(topicQuestions(eightValuesQuiz, eightValuesQuizState.Topic))
+	}
 	answered := len(eightValuesQuizState.Responses)
 	remaining := totalQuestions - answered
+	completion := float64(answered) / float64(totalQuestions) * 100
+	if eightValuesAdaptiveStable() {
+		// Adaptive quizzes finish once every axis is within the margin
+		remaining = 0
+		completion = 100
+	}
 
 	// Calculate current scores if we have responses
 	var econScore, diplScore, govtScore, sctyScore float64
@@ -621,20 +909,14 @@ func handleEightValuesStatus(ctx context.Context, request mcp.CallToolRequest) (
 			}
 		}
 
-		// Calculate maximum possible scores for percentages
-		var maxEcon, maxDipl, maxGovt, maxScty float64
-		for _, q := range eightvalues.Questions {
-			maxEcon += abs(q.Effect[eightvalues.Economic])
-			maxDipl += abs(q.Effect[eightvalues.Diplomatic])
-			maxGovt += abs(q.Effect[eightvalues.Government])
-			maxScty += abs(q.Effect[eightvalues.Society])
-		}
+		// Calculate maximum possible scores for percentages over the questions asked
+		maxEcon, maxDipl, maxGovt, maxScty := eightValuesMaxima()
 
 		// Calculate percentages
-		econPercentage = (100 * (maxEcon + econScore) / (2 * maxEcon))
-		diplPercentage = (100 * (maxDipl + diplScore) / (2 * maxDipl))
-		govtPercentage = (100 * (maxGovt + govtScore) / (2 * maxGovt))
-		sctyPercentage = (100 * (maxScty + sctyScore) / (2 * maxScty))
+		econPercentage = eightValuesPercentage(econScore, maxEcon)
+		diplPercentage = eightValuesPercentage(diplScore, maxDipl)
+		govtPercentage = eightValuesPercentage(govtScore, maxGovt)
+		sctyPercentage = eightValuesPercentage(sctyScore, maxScty)
 	}
 
 	// Create detailed status report
@@ -643,93 +925,46 @@ func handleEightValuesStatus(ctx context.Context, request mcp.CallToolRequest) (
 **Progress:**
 - Questions answered: %d/%d
 - Questions remaining: %d
+- Language: %s
 - Completion: %.1f%%
-`, answered, totalQuestions, remaining,
-		float64(answered)/float64(totalQuestions)*100)
+`, answered, totalQuestions, remaining, eightValuesLanguage, completion)
 
-	// Only show scores if quiz is complete
-	if remaining == 0 && answered > 0 {
-		// Determine ideological classifications using 8values setLabel logic
-		var economicLabel, diplomaticLabel, governmentLabel, societyLabel string
-
-		// Economic axis (equality-focused)
-		if econPercentage > 90 {
-			economicLabel = "Communist"
-		} else if econPercentage > 75 {
-			economicLabel = "Socialist"
-		} else if econPercentage > 60 {
-			economicLabel = "Social"
-		} else if econPercentage >= 40 {
-			economicLabel = "Centrist"
-		} else if econPercentage >= 25 {
-			economicLabel = "Market"
-		} else if econPercentage >= 10 {
-			economicLabel = "Capitalist"
-		} else {
-			economicLabel = "Laissez-Faire"
-		}
-
-		// Diplomatic axis (peace-focused)
-		if diplPercentage > 90 {
-			diplomaticLabel = "Cosmopolitan"
-		} else if diplPercentage > 75 {
-			diplomaticLabel = "Internationalist"
-		} else if diplPercentage > 60 {
-			diplomaticLabel = "Peaceful"
-		} else if diplPercentage >= 40 {
-			diplomaticLabel = "Balanced"
-		} else if diplPercentage >= 25 {
-			diplomaticLabel = "Patriotic"
-		} else if diplPercentage >= 10 {
-			diplomaticLabel = "Nationalist"
-		} else {
-			diplomaticLabel = "Chauvinist"
-		}
-
-		// Government axis (liberty-focused)
-		if govtPercentage > 90 {
-			governmentLabel = "Anarchist"
-		} else if govtPercentage > 75 {
-			governmentLabel = "Libertarian"
-		} else if govtPercentage > 60 {
-			governmentLabel = "Liberal"
-		} else if govtPercentage >= 40 {
-			governmentLabel = "Moderate"
-		} else if govtPercentage >= 25 {
-			governmentLabel = "Statist"
-		} else if govtPercentage >= 10 {
-			governmentLabel = "Authoritarian"
-		} else {
-			governmentLabel = "Totalitarian"
+	if eightValuesQuizState.Length > 0 {
+		statusText += "- Mode: " + strings.TrimSpace(eightValuesShortFormNote()) + "\n"
+	}
+	if eightValuesQuizState.Topic != "" {
+		statusText += "- Mode: " + strings.TrimSpace(topicPracticeNote(eightValuesQuiz)) + "\n"
+	}
+	if eightValuesQuizState.Adaptive {
+		statusText += fmt.Sprintf("- Mode: Adaptive (stops when every axis is within ±%.1f points)\n", eightValuesQuizState.Margin)
+		if answered > 0 {
+			statusText += "- Current margins:"
+			for axis, estimate := range eightValuesAxisEstimates() {
+				statusText += fmt.Sprintf(" %s ±%.1f", eightValuesAxisNames[axis], estimate.Margin)
+			}
+			statusText += "\n"
 		}
+	}
 
-		// Society axis (progress-focused)
-		if sctyPercentage > 90 {
-			societyLabel = "Revolutionary"
-		} else if sctyPercentage > 75 {
-			societyLabel = "Very Progressive"
-		} else if sctyPercentage > 60 {
-			societyLabel = "Progressive"
-		} else if sctyPercentage >= 40 {
-			societyLabel = "Neutral"
-		} else if sctyPercentage >= 25 {
-			societyLabel = "Traditional"
-		} else if sctyPercentage >= 10 {
-			societyLabel = "Very Traditional"
-		} else {
-			societyLabel = "Reactionary"
-		}
+	// Only show scores if quiz is complete
+	if remaining == 0 && answered > 0 && !reviewPending(eightValuesQuiz) {
+		// Determine ideological classifications using 8values setLabel logic
+		economicLabel := eightValuesLabel(eightvalues.Economic, econPercentage)
+		diplomaticLabel := eightValuesLabel(eightvalues.Diplomatic, diplPercentage)
+		governmentLabel := eightValuesLabel(eightvalues.Government, govtPercentage)
+		societyLabel := eightValuesLabel(eightvalues.Society, sctyPercentage)
 
+		confidence := eightValuesConfidenceMargins()
 		statusText += fmt.Sprintf(`
 **Final Scores:**
-- Economic Axis: %.1f%% %s
-- Diplomatic Axis: %.1f%% %s  
-- Government Axis: %.1f%% %s
-- Society Axis: %.1f%% %s
-`, econPercentage, economicLabel,
-			diplPercentage, diplomaticLabel,
-			govtPercentage, governmentLabel,
-			sctyPercentage, societyLabel)
+- Economic Axis: %.1f%% %s%s
+- Diplomatic Axis: %.1f%% %s%s
+- Government Axis: %.1f%% %s%s
+- Society Axis: %.1f%% %s%s
+`, econPercentage, economicLabel, formatConfidence(econPercentage, confidence[eightvalues.Economic], 0, 100, 1),
+			diplPercentage, diplomaticLabel, formatConfidence(diplPercentage, confidence[eightvalues.Diplomatic], 0, 100, 1),
+			govtPercentage, governmentLabel, formatConfidence(govtPercentage, confidence[eightvalues.Government], 0, 100, 1),
+			sctyPercentage, societyLabel, formatConfidence(sctyPercentage, confidence[eightvalues.Society], 0, 100, 1))
 	}
 
 	statusText += "\n**Response Distribution:**\n"
@@ -760,10 +995,19 @@ func handleEightValuesStatus(ctx context.Context, request mcp.CallToolRequest) (
 		}
 	}
 
+	// Slider answers fall between the fixed multipliers; show the exact values
+	statusText += formatRawValues(eightValuesQuizState.Responses, []float64{
+		eightvalues.StronglyDisagree, eightvalues.Disagree, eightvalues.Neutral, eightvalues.Agree, eightvalues.StronglyAgree,
+	})
+	statusText += formatDiagnostics(eightValuesDiagnostics())
+	statusText += bankWarningNote(eightValuesQuiz)
+
 	if answered == 0 {
 		statusText += "\n*No questions answered yet. Use the `eight_values` tool to start the quiz.*"
 	} else if remaining > 0 {
 		statusText += fmt.Sprintf("\n*Continue with the `eight_values` tool to answer %d more questions.*", remaining)
+	} else if reviewPending(eightValuesQuiz) {
+		statusText += reviewStatusNote
 	} else {
 		statusText += "\n*✅ Quiz complete! All questions have been answered.*"
 	}
@@ -771,51 +1015,108 @@ func handleEightValuesStatus(ctx context.Context, request mcp.CallToolRequest) (
 	return mcp.NewToolResultText(statusText), nil
 }
 
+// eightValuesLabels are the 8values setLabel classifications per axis, from the
+// highest band (above 90%) to the lowest (below 10%)
+var eightValuesLabels = [4][7]string{
+	eightvalues.Economic:   {"Communist", "Socialist", "Social", "Centrist", "Market", "Capitalist", "Laissez-Faire"},
+	eightvalues.Diplomatic: {"Cosmopolitan", "Internationalist", "Peaceful", "Balanced", "Patriotic", "Nationalist", "Chauvinist"},
+	eightvalues.Government: {"Anarchist", "Libertarian", "Liberal", "Moderate", "Statist", "Authoritarian", "Totalitarian"},
+	eightvalues.Society:    {"Revolutionary", "Very Progressive", "Progressive", "Neutral", "Traditional", "Very Traditional", "Reactionary"},
+}
+
+// eightValuesLabel classifies an 8values axis percentage using the setLabel bands
+func eightValuesLabel(axis int, percentage float64) string {
+	labels := eightValuesLabels[axis]
+	switch {
+	case percentage > 90:
+		return labels[0]
+	case percentage > 75:
+		return labels[1]
+	case percentage > 60:
+		return labels[2]
+	case percentage >= 40:
+		return labels[3]
+	case percentage >= 25:
+		return labels[4]
+	case percentage >= 10:
+		return labels[5]
+	default:
+		return labels[6]
+	}
+}
+
 // Calculate politiscales results based on current quiz state
 func calculatePolitiscalesResults() map[string]float64 {
 	return calculatePolitiscalesResultsInternal()
 }
 
 func calculatePolitiscalesResultsInternal() map[string]float64 {
-	scores := make(map[string]float64)
-	sums := make(map[string]float64)
+	results := scorePolitiscales(politiscalesQuizState.Responses)
+
+	// Update global politiscalesAxesScores for test compatibility
+	politiscalesAxesScores = results
+	return results
+}
+
+// politiscalesAxisBreakdown records how one politiscales axis score is built
+type politiscalesAxisBreakdown struct {
+	Contributions map[int32]float64 // raw score added by each answered question (answer × weight)
+	Sum           float64           // total positive weight of the answered questions on the axis
+	Ratio         float64           // pair normalisation factor; 1 unless the pair exceeded 100%
+}
+
+// Percentage returns the axis score before pair normalisation
+func (b politiscalesAxisBreakdown) Percentage() float64 {
+	if b.Sum == 0 {
+		return 0
+	}
+	var score float64
+	for _, contribution := range b.Contributions {
+		score += contribution
+	}
+	return score / b.Sum * 100
+}
+
+// breakdownPolitiscales scores responses keyed by question index, keeping each
+// question's contribution and the pair normalisation applied to every axis
+func breakdownPolitiscales(responses map[int32]float64) map[string]*politiscalesAxisBreakdown {
+	breakdowns := make(map[string]*politiscalesAxisBreakdown)
 
 	// Initialize scores for all axes
 	for _, axis := range politiscales.Axes {
-		scores[axis.Name] = 0.0
-		sums[axis.Name] = 0.0
+		breakdowns[axis.Name] = &politiscalesAxisBreakdown{Contributions: map[int32]float64{}, Ratio: 1}
 	}
 
 	// Calculate raw scores as per the TypeScript logic
-	for questionIndex, answerValue := range politiscalesQuizState.Responses {
+	for questionIndex, answerValue := range responses {
 		question := politiscales.Questions[questionIndex]
 
 		if answerValue > 0 {
 			// Positive response - use YesWeights
 			for _, weight := range question.YesWeights {
-				scores[weight.Axis] += answerValue * weight.Value
+				breakdowns[weight.Axis].Contributions[questionIndex] += answerValue * weight.Value
 				if weight.Value > 0 {
-					sums[weight.Axis] += weight.Value
+					breakdowns[weight.Axis].Sum += weight.Value
 				}
 			}
 		} else if answerValue < 0 {
 			// Negative response - use NoWeights
 			for _, weight := range question.NoWeights {
-				scores[weight.Axis] += (-answerValue) * weight.Value
+				breakdowns[weight.Axis].Contributions[questionIndex] += (-answerValue) * weight.Value
 				if weight.Value > 0 {
-					sums[weight.Axis] += weight.Value
+					breakdowns[weight.Axis].Sum += weight.Value
 				}
 			}
 		} else {
 			// Neutral (0) responses don't affect scores but still count towards sums
 			for _, weight := range question.YesWeights {
 				if weight.Value > 0 {
-					sums[weight.Axis] += weight.Value
+					breakdowns[weight.Axis].Sum += weight.Value
 				}
 			}
 			for _, weight := range question.NoWeights {
 				if weight.Value > 0 {
-					sums[weight.Axis] += weight.Value
+					breakdowns[weight.Axis].Sum += weight.Value
 				}
 			}
 		}
@@ -825,9 +1126,6 @@ func calculatePolitiscalesResultsInternal() map[string]float64 {
 	pairedAxes := make(map[string][]string)
 	for _, axis := range politiscales.Axes {
 		if axis.Pair != "" {
-			if pairedAxes[axis.Pair] == nil {
-				pairedAxes[axis.Pair] = []string{}
-			}
 			pairedAxes[axis.Pair] = append(pairedAxes[axis.Pair], axis.Name)
 		}
 	}
@@ -835,45 +1133,37 @@ func calculatePolitiscalesResultsInternal() map[string]float64 {
 	// Apply normalization for each pair
 	for _, pair := range pairedAxes {
 		if len(pair) == 2 {
-			axis1, axis2 := pair[0], pair[1]
-			var value1, value2 float64
-
-			if sums[axis1] > 0 {
-				value1 = (scores[axis1] / sums[axis1]) * 100
-			}
-			if sums[axis2] > 0 {
-				value2 = (scores[axis2] / sums[axis2]) * 100
-			}
-
+			value1 := breakdowns[pair[0]].Percentage()
+			value2 := breakdowns[pair[1]].Percentage()
 			if value1+value2 > 100 {
 				ratio := 100.0 / (value1 + value2)
-				scores[axis1] *= ratio
-				scores[axis2] *= ratio
+				breakdowns[pair[0]].Ratio = ratio
+				breakdowns[pair[1]].Ratio = ratio
 			}
 		}
 	}
 
-	// Convert to final percentages
+	return breakdowns
+}
+
+// scorePolitiscales computes the axis percentages for responses keyed by question index
+func scorePolitiscales(responses map[int32]float64) map[string]float64 {
 	results := make(map[string]float64)
-	for axis, score := range scores {
-		if sums[axis] > 0 {
-			results[axis] = (score / sums[axis]) * 100
-		} else {
-			results[axis] = 0.0
-		}
+	for axis, breakdown := range breakdownPolitiscales(responses) {
+		results[axis] = breakdown.Percentage() * breakdown.Ratio
 	}
-
-	// Update global politiscalesAxesScores for test compatibility
-	politiscalesAxesScores = results
-
 	return results
 }
 
 // Handler function for politiscales quiz tool
 func handlePolitiscales(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	// Extract the answer argument
+	// Extract the answer argument, or the slider value that replaces it
 	answer, err := request.RequireString("answer")
-	if err != nil {
+	value, hasValue, valueErr := answerValue(request)
+	if valueErr != nil {
+		return mcp.NewToolResultError(valueErr.Error()), nil
+	}
+	if err != nil && !hasValue {
 		return mcp.NewToolResultError("Answer is required"), nil
 	}
 
@@ -886,6 +1176,11 @@ func handlePolitiscales(ctx context.Context, request mcp.CallToolRequest) (*mcp.
 	var question politiscales.Question
 	var isFirstQuestion = false
 
+	// Once every question is answered, the review waits for amend_answer or finalize
+	if reviewPending(politiscalesQuiz) {
+		return mcp.NewToolResultText(reviewList(politiscalesQuiz)), nil
+	}
+
 	// If this is a response to a previous question, process it first
 	if politiscalesQuestionCount > 0 {
 		// Get the last asked question to calculate scores
@@ -894,19 +1189,24 @@ func handlePolitiscales(ctx context.Context, request mcp.CallToolRequest) (*mcp.
 
 		// Parse the response
 		var responseValue float64
-		switch answer {
-		case "strongly_disagree":
-			responseValue = -1.0 // StronglyDisagree
-		case "disagree":
-			responseValue = -2.0 / 3.0 // Disagree
-		case "neutral":
-			responseValue = 0.0 // Neutral
-		case "agree":
-			responseValue = 2.0 / 3.0 // Agree
-		case "strongly_agree":
-			responseValue = 1.0 // StronglyAgree
-		default:
-			return mcp.NewToolResultError(fmt.Sprintf("invalid response: %s. Please use: strongly_disagree, disagree, neutral, agree, strongly_agree", answer)), nil
+		if hasValue {
+			// Answer values already span -1 to 1, so slider values are used as-is
+			responseValue = value
+		} else {
+			switch normalizeAnswer(answer, politiscalesCopyTables, politiscalesLanguage) {
+			case "strongly_disagree":
+				responseValue = -1.0 // StronglyDisagree
+			case "disagree":
+				responseValue = -2.0 / 3.0 // Disagree
+			case "neutral":
+				responseValue = 0.0 // Neutral
+			case "agree":
+				responseValue = 2.0 / 3.0 // Agree
+			case "strongly_agree":
+				responseValue = 1.0 // StronglyAgree
+			default:
+				return mcp.NewToolResultError(invalidAnswerMessage(answer, answerTokens, politiscalesCopyTables, politiscalesLanguage)), nil
+			}
 		}
 
 		// Store the response in quiz state
@@ -919,36 +1219,53 @@ func handlePolitiscales(ctx context.Context, request mcp.CallToolRequest) (*mcp.
 		_ = calculatePolitiscalesResults()
 
 		// Check if quiz is complete after processing this response
-		if politiscalesCurrentIndex >= len(politiscales.Questions) {
-			// Quiz complete - calculate and display results
-			results := calculatePolitiscalesResults()
-
-			// Generate SVG visualization
-			svg := politiscales.GeneratePolitiscalesResultsSVG(results)
-
-			// Format results for display
-			message := fmt.Sprintf("🎉 Politiscales Quiz Complete!\n\n"+
-				"Questions answered: %d\n\n"+
-				"**Your Political Profile:**\n", politiscalesQuestionCount)
-
-			// Add axis scores to the message
-			for _, axis := range politiscales.Axes {
-				if score, exists := results[axis.Name]; exists {
-					message += fmt.Sprintf("- %s: %.2f\n", axis.Label, score)
-				}
-			}
-
-			message += fmt.Sprintf("\n%s\n\n"+
-				"**Instructions for displaying the results:**\n"+
-				"1. Show the above scores and profile information to the user\n"+
-				"2. **IMPORTANT: Render the SVG chart above so the user can see their political profile visually. (it's inline markdown so an artifact may work best)**\n"+
-				"3. The bars on the chart show your position on each political axis\n\n"+
-				"Thank you for completing the Politiscales quiz!", svg)
-
-			return mcp.NewToolResultText(message), nil
+		if politiscalesCurrentIndex >= len(politiscalesShuffledQuestions) || politiscalesAdaptiveStable() {
+			return mcp.NewToolResultText(finishQuiz(politiscalesQuiz)), nil
 		}
 	} else {
 		isFirstQuestion = true
+
+		adaptive, margin, err := adaptiveOptions(request, defaultPolitiscalesMargin)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		length, err := shortFormLength(request, minPolitiscalesLength, len(politiscales.Questions))
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if adaptive && length > 0 {
+			return mcp.NewToolResultError("adaptive and length cannot be combined: choose an adaptive quiz or a fixed short form"), nil
+		}
+		topic, err := topicOption(request, politiscalesQuiz)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if topic != "" && (adaptive || length > 0 || request.GetBool("prefill", false)) {
+			return mcp.NewToolResultError("topic cannot be combined with adaptive, length or prefill: a practice run asks every question on its topic"), nil
+		}
+		// Answers to matching questions in the other quizzes can be carried over
+		if request.GetBool("prefill", false) {
+			return startPrefilled(politiscalesQuiz, adaptive, margin, length, request.GetBool("review", false)), nil
+		}
+		politiscalesQuizState.Adaptive = adaptive
+		politiscalesQuizState.Review = request.GetBool("review", false)
+		politiscalesQuizState.Margin = margin
+
+		// Short forms ask a fixed balanced subset, keeping the shuffled order
+		if length > 0 {
+			politiscalesShuffledQuestions = restrictOrder(politiscalesShuffledQuestions, politiscalesShortForm(length))
+			politiscalesQuizState.Length = length
+		}
+		// Practice runs ask only the questions on one topic, keeping the shuffled order
+		if topic != "" {
+			politiscalesShuffledQuestions = restrictOrder(politiscalesShuffledQuestions, topicQuestions(politiscalesQuiz, topic))
+			politiscalesQuizState.Topic = topic
+		}
+	}
+
+	// Adaptive quizzes pick the most informative remaining question
+	if politiscalesQuizState.Adaptive {
+		selectAdaptiveQuestion(politiscalesShuffledQuestions, politiscalesCurrentIndex, politiscalesAxisEstimates(), politiscalesAxisWeight)
 	}
 
 	// Get next question
@@ -963,10 +1280,11 @@ func handlePolitiscales(ctx context.Context, request mcp.CallToolRequest) (*mcp.
 	questionText := getPolitiscalesQuestionText(question.Text)
 	// Create response text
 	responseText := fmt.Sprintf("Question %d of %d:\n\n%s\n\nPlease respond with: strongly_disagree, disagree, neutral, agree, or strongly_agree",
-		politiscalesQuestionCount, len(politiscales.Questions), questionText)
+		politiscalesQuestionCount, len(politiscalesShuffledQuestions), questionText)
 
 	if isFirstQuestion {
-		responseText = fmt.Sprintf("🗳️ Politiscales Quiz Started! (Language: %s)\n\n%s", politiscalesLanguage, responseText)
+		responseText = fmt.Sprintf("🗳️ Politiscales Quiz Started! (Language: %s)\n\n%s%s", politiscalesLanguage, politiscalesShortFormNote()+topicPracticeNote(politiscalesQuiz), responseText)
+		responseText += prefillHint(politiscalesQuiz)
 	} else {
 		responseText = fmt.Sprintf("✅ Response recorded!\n\n%s", responseText)
 	}
@@ -974,6 +1292,50 @@ func handlePolitiscales(ctx context.Context, request mcp.CallToolRequest) (*mcp.
 	return mcp.NewToolResultText(responseText), nil
 }
 
+// politiscalesCompletion builds the message shown once the politiscales quiz is over
+func politiscalesCompletion() string {
+	results := calculatePolitiscalesResults()
+
+	// Generate SVG visualization with confidence intervals
+	confidence := politiscalesConfidenceMargins(results)
+	svg := politiscalesChart(results,
+		politiscales.SVGOptions{Language: politiscalesLanguage, Margins: confidence})
+
+	// Format results for display
+	message := fmt.Sprintf("🎉 Politiscales Quiz Complete!\n\n"+
+		"Questions answered: %d\n\n", politiscalesQuestionCount)
+
+	if politiscalesQuizState.Adaptive {
+		message += fmt.Sprintf("Adaptive mode: stopped after %d of %d questions with every axis within ±%.1f points\n\n",
+			len(politiscalesQuizState.Responses), len(politiscales.Questions), politiscalesQuizState.Margin)
+	}
+	if politiscalesQuizState.Length > 0 {
+		message += politiscalesShortFormNote()
+	}
+	message += topicPracticeNote(politiscalesQuiz)
+	message += reliabilityWarning(politiscalesDiagnostics(), "politiscales_status")
+	message += fmt.Sprintf("**Political Identity:** %s\n\n", politiscales.IdentityName(results, politiscalesLanguage))
+	message += "**Your Political Profile:**\n"
+
+	// Add axis scores to the message
+	for _, axis := range politiscales.Axes {
+		if score, exists := results[axis.Name]; exists {
+			message += fmt.Sprintf("- %s: %.2f%s\n", axis.Label, score,
+				formatConfidence(score, confidence[axis.Name], 0, 100, 1))
+		}
+	}
+
+	message += fmt.Sprintf("\n%s\n\n"+
+		"**Instructions for displaying the results:**\n"+
+		"1. Show the above scores and profile information to the user\n"+
+		"2. **IMPORTANT: Render the SVG chart above so the user can see their political profile visually. (it's inline markdown so an artifact may work best)**\n"+
+		"3. The bars on the chart show your position on each political axis, with error bars for the 95%% confidence intervals\n\n"+
+		"Thank you for completing the Politiscales quiz!", svg)
+	message += shareCodeLine(politiscalesQuiz)
+	message += partyMatchLine(politiscalesQuiz)
+	return message
+}
+
 // Handler function for reset politiscales quiz tool
 func handleResetPolitiscales(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	mutex.Lock()
@@ -995,8 +1357,20 @@ func handlePolitiscalesStatus(ctx context.Context, request mcp.CallToolRequest)
 	defer mutex.Unlock()
 
 	totalQuestions := len(politiscales.Questions)
+	if politiscalesQuizState.Length > 0 {
+		totalQuestions = politiscalesQuizState.Length
+	}
+	if politiscalesQuizState.Topic != "" {
+		totalQuestions = len(topicQuestions(politiscalesQuiz, politiscalesQuizState.Topic))
+	}
 	answered := len(politiscalesQuizState.Responses)
 	remaining := totalQuestions - answered
+	completion := float64(answered) / float64(totalQuestions) * 100
+	if politiscalesAdaptiveStable() {
+		// Adaptive quizzes finish once every axis is within the margin
+		remaining = 0
+		completion = 100
+	}
 
 	statusText := fmt.Sprintf(`🗳️ **Politiscales Quiz Status**
 
@@ -1006,14 +1380,35 @@ func handlePolitiscalesStatus(ctx context.Context, request mcp.CallToolRequest)
 - Remaining questions: %d
 - Language: %s
 - Completion: %.1f%%
-`, answered, totalQuestions, remaining, politiscalesLanguage,
-		float64(answered)/float64(totalQuestions)*100)
+`, answered, totalQuestions, remaining, politiscalesLanguage, completion)
+
+	if politiscalesQuizState.Length > 0 {
+		statusText += "- Mode: " + strings.TrimSpace(politiscalesShortFormNote()) + "\n"
+	}
+	if politiscalesQuizState.Topic != "" {
+		statusText += "- Mode: " + strings.TrimSpace(topicPracticeNote(politiscalesQuiz)) + "\n"
+	}
+	if politiscalesQuizState.Adaptive {
+		statusText += fmt.Sprintf("- Mode: Adaptive (stops when every axis is within ±%.1f points)\n", politiscalesQuizState.Margin)
+		if answered > 0 {
+			widest, widestAxis := 0.0, ""
+			for i, estimate := range politiscalesAxisEstimates() {
+				if estimate.Margin >= widest {
+					widest, widestAxis = estimate.Margin, politiscales.Axes[i].Name
+				}
+			}
+			statusText += fmt.Sprintf("- Widest margin: ±%.1f (%s)\n", widest, widestAxis)
+		}
+	}
 
 	// Only show scores if quiz is complete
-	if remaining == 0 && answered > 0 {
+	if remaining == 0 && answered > 0 && !reviewPending(politiscalesQuiz) {
 		results := calculatePolitiscalesResultsInternal()
-		svg := politiscales.GeneratePolitiscalesResultsSVG(results)
+		confidence := politiscalesConfidenceMargins(results)
+		svg := politiscalesChart(results,
+			politiscales.SVGOptions{Language: politiscalesLanguage, Margins: confidence})
 		statusText += "\n**Final Results:**\n"
+		statusText += fmt.Sprintf("- Political identity: %s\n", politiscales.IdentityName(results, politiscalesLanguage))
 
 		// Group and display results by pairs
 		axesByPair := make(map[string][]string)
@@ -1036,9 +1431,11 @@ func handlePolitiscalesStatus(ctx context.Context, request mcp.CallToolRequest)
 				score1 := results[axes[0]]
 				score2 := results[axes[1]]
 				if score1 > score2 {
-					statusText += fmt.Sprintf("- %s: %.1f%% %s\n", pairName, score1, axes[0])
+					statusText += fmt.Sprintf("- %s: %.1f%% %s%s\n", pairName, score1, axes[0],
+						formatConfidence(score1, confidence[axes[0]], 0, 100, 1))
 				} else {
-					statusText += fmt.Sprintf("- %s: %.1f%% %s\n", pairName, score2, axes[1])
+					statusText += fmt.Sprintf("- %s: %.1f%% %s%s\n", pairName, score2, axes[1],
+						formatConfidence(score2, confidence[axes[1]], 0, 100, 1))
 				}
 			}
 		}
@@ -1047,7 +1444,8 @@ func handlePolitiscalesStatus(ctx context.Context, request mcp.CallToolRequest)
 		if len(unpairedAxes) > 0 {
 			statusText += "\n**Special Indicators:**\n"
 			for _, axis := range unpairedAxes {
-				statusText += fmt.Sprintf("- %s: %.1f%%\n", axis, results[axis])
+				statusText += fmt.Sprintf("- %s: %.1f%%%s\n", axis, results[axis],
+					formatConfidence(results[axis], confidence[axis], 0, 100, 1))
 			}
 		}
 
@@ -1082,10 +1480,25 @@ func handlePolitiscalesStatus(ctx context.Context, request mcp.CallToolRequest)
 		}
 	}
 
+	// Slider answers fall between the fixed values; show the exact values in answer order
+	var rawValues []float64
+	for _, questionIndex := range politiscalesShuffledQuestions {
+		if value, ok := politiscalesQuizState.Responses[politiscales.Questions[questionIndex].Index]; ok {
+			rawValues = append(rawValues, value)
+		}
+	}
+	statusText += formatRawValues(rawValues, []float64{
+		politiscales.StronglyDisagree, politiscales.Disagree, politiscales.Neutral, politiscales.Agree, politiscales.StronglyAgree,
+	})
+	statusText += formatDiagnostics(politiscalesDiagnostics())
+	statusText += bankWarningNote(politiscalesQuiz)
+
 	if answered == 0 {
 		statusText += "\n*No questions answered yet. Use the `politiscales` tool to start the quiz.*"
 	} else if remaining > 0 {
 		statusText += fmt.Sprintf("\n*Continue with the `politiscales` tool to answer %d more questions.*", remaining)
+	} else if reviewPending(politiscalesQuiz) {
+		statusText += reviewStatusNote
 	} else {
 		statusText += "\n*✅ Quiz complete! All questions have been answered.*"
 	}
@@ -1112,44 +1525,7 @@ func initializePolitiscalesQuestions() {
 
 // Get question text in the specified language
 func getPolitiscalesQuestionText(key string) string {
-	switch politiscalesLanguage {
-	case "fr":
-		if text, ok := politiscales.FRQuestions[key]; ok {
-			return text
-		}
-	case "es":
-		if text, ok := politiscales.ESQuestions[key]; ok {
-			return text
-		}
-	case "it":
-		if text, ok := politiscales.ITQuestions[key]; ok {
-			return text
-		}
-	case "ar":
-		if text, ok := politiscales.ARQuestions[key]; ok {
-			return text
-		}
-	case "ru":
-		if text, ok := politiscales.RUQuestions[key]; ok {
-			return text
-		}
-	case "zh":
-		if text, ok := politiscales.ZHQuestions[key]; ok {
-			return text
-		}
-	default:
-		if text, ok := politiscales.ENQuestions[key]; ok {
-			return text
-		}
-	}
-
-	// Fallback to English if not found
-	if text, ok := politiscales.ENQuestions[key]; ok {
-		return text
-	}
-
-	// Final fallback to the key itself
-	return key
+	return translate(politiscalesQuestionTables, politiscalesLanguage, key)
 }
 
 // Handler function for setting politiscales language
@@ -1164,18 +1540,9 @@ func handleSetPolitiscalesLanguage(ctx context.Context, request mcp.CallToolRequ
 	defer mutex.Unlock()
 
 	// Validate language
-	validLanguages := []string{"en", "fr", "es", "it", "ar", "ru", "zh"}
-	isValid := false
-	for _, lang := range validLanguages {
-		if language == lang {
-			isValid = true
-			break
-		}
-	}
-
-	if !isValid {
+	if !isSupportedLanguage(language) {
 		return mcp.NewToolResultError(fmt.Sprintf("Invalid language: %s. Valid languages are: %s",
-			language, strings.Join(validLanguages, ", "))), nil
+			language, strings.Join(supportedLanguages, ", "))), nil
 	}
 
 	// Check if quiz is in progress
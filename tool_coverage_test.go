@@ -349,6 +349,9 @@ func TestHandlePolitiscalesStatusComprehensive(t *testing.T) {
 		if !strings.Contains(content, "Final Results:") {
 			t.Error("Expected final results section")
 		}
+		if !strings.Contains(content, "- Political identity: ") {
+			t.Error("Expected political identity name in final results")
+		}
 	})
 
 	// Test response distribution with all response types
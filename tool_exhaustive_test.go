@@ -171,7 +171,7 @@ func TestHandlePolitiscalesExhaustive(t *testing.T) {
 	})
 
 	// Test 5: Test error cases for invalid responses
-	invalidResponses := []string{"invalid", "maybe", "sometimes", "AGREE", "StronglyAgree", "1", "yes", "no"}
+	invalidResponses := []string{"invalid", "maybe", "sometimes", "AGREES", "Strongly", "0", "6", "yes", "no"}
 	for _, invalidResp := range invalidResponses {
 		t.Run("Invalid response: "+invalidResp, func(t *testing.T) {
 			resetState()
@@ -243,6 +243,9 @@ func TestHandlePolitiscalesExhaustive(t *testing.T) {
 				if !strings.Contains(content, "Your Political Profile:") {
 					t.Error("Expected political profile in completion")
 				}
+				if !strings.Contains(content, "**Political Identity:** ") {
+					t.Error("Expected political identity name in completion")
+				}
 				if !strings.Contains(content, "<svg") {
 					t.Error("Expected SVG chart in politiscales completion")
 				}
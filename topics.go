@@ -0,0 +1,304 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/eightvalues"
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+// quizTopics lists the topics questions are tagged with, in display order
+var quizTopics = []struct{ key, label string }{
+	{"economy", "Economy"},
+	{"welfare", "Welfare"},
+	{"environment", "Environment"},
+	{"technology", "Technology"},
+	{"foreign_policy", "Foreign policy"},
+	{"immigration", "Immigration"},
+	{"nationalism", "Nationalism"},
+	{"race", "Race"},
+	{"government", "Government"},
+	{"activism", "Activism"},
+	{"civil_liberties", "Civil liberties"},
+	{"criminal_justice", "Criminal justice"},
+	{"religion", "Religion"},
+	{"sexuality", "Sexuality"},
+	{"gender", "Gender"},
+	{"family", "Family"},
+	{"education", "Education"},
+	{"culture", "Culture"},
+}
+
+// topicLabel returns a topic's display name
+func topicLabel(topic string) string {
+	for _, t := range quizTopics {
+		if t.key == topic {
+			return t.label
+		}
+	}
+	return topic
+}
+
+// parseTopic resolves a topic key or display name, ignoring case, spaces and hyphens
+func parseTopic(raw string) (string, error) {
+	compact := strings.NewReplacer(" ", "_", "-", "_").Replace(strings.ToLower(strings.TrimSpace(raw)))
+	keys := make([]string, len(quizTopics))
+	for i, t := range quizTopics {
+		if compact == t.key {
+			return t.key, nil
+		}
+		keys[i] = t.key
+	}
+	return "", fmt.Errorf("invalid topic: %s. Please use one of: %s", raw, strings.Join(keys, ", "))
+}
+
+// questionTopics returns the topic tags of a question by index
+func questionTopics(quiz string, question int) []string {
+	switch quiz {
+	case politicalCompassQuiz:
+		return politicalcompass.AllQuestions[question].Topics
+	case eightValuesQuiz:
+		return eightvalues.Questions[question].Topics
+	default:
+		return politiscales.Questions[question].Topics
+	}
+}
+
+// topicQuestions returns the questions of a quiz tagged with a topic, in bank order
+func topicQuestions(quiz, topic string) []int {
+	var questions []int
+	for question := 0; question < quizQuestionCount(quiz); question++ {
+		for _, tag := range questionTopics(quiz, question) {
+			if tag == topic {
+				questions = append(questions, question)
+				break
+			}
+		}
+	}
+	return questions
+}
+
+// topicOption reads the topic argument used when starting a practice run. It
+// returns "" for the whole quiz.
+func topicOption(request mcp.CallToolRequest, quiz string) (string, error) {
+	raw := request.GetString("topic", "")
+	if strings.TrimSpace(raw) == "" {
+		return "", nil
+	}
+	topic, err := parseTopic(raw)
+	if err != nil {
+		return "", err
+	}
+	if len(topicQuestions(quiz, topic)) == 0 {
+		return "", fmt.Errorf("no %s question is about %s; see topic_breakdown for the topics it covers", quizTitles[quiz], topicLabel(topic))
+	}
+	return topic, nil
+}
+
+// quizTopic returns the topic of a quiz's practice run, or "" for the whole quiz
+func quizTopic(quiz string) string {
+	switch quiz {
+	case politicalCompassQuiz:
+		return quizState.Topic
+	case eightValuesQuiz:
+		return eightValuesQuizState.Topic
+	default:
+		return politiscalesQuizState.Topic
+	}
+}
+
+// topicPracticeNote describes a quiz's practice run, or returns "" for the whole quiz
+func topicPracticeNote(quiz string) string {
+	topic := quizTopic(quiz)
+	if topic == "" {
+		return ""
+	}
+	return fmt.Sprintf("Practice run: only the %d %s questions, so the scores reflect this topic alone\n\n",
+		len(topicQuestions(quiz, topic)), topicLabel(topic))
+}
+
+// topicBreakdown is a quiz's leaning over the answered questions on one topic
+type topicBreakdown struct {
+	topic    string
+	answered []answeredItem
+	total    int             // questions on the topic in the quiz's bank
+	scores   []exportedScore // the axes the answered questions bear on
+}
+
+// breakdownTopics scores each topic a quiz covers on its answered questions alone.
+// The caller must hold the mutex.
+func breakdownTopics(quiz string) []topicBreakdown {
+	items := reviewAnswers(quiz)
+	var breakdowns []topicBreakdown
+	for _, t := range quizTopics {
+		questions := topicQuestions(quiz, t.key)
+		if len(questions) == 0 {
+			continue
+		}
+		onTopic := map[int]bool{}
+		for _, question := range questions {
+			onTopic[question] = true
+		}
+		breakdown := topicBreakdown{topic: t.key, total: len(questions)}
+		for _, item := range items {
+			if onTopic[item.question] {
+				breakdown.answered = append(breakdown.answered, item)
+			}
+		}
+		if len(breakdown.answered) > 0 {
+			breakdown.scores = topicScores(quiz, breakdown.answered)
+		}
+		breakdowns = append(breakdowns, breakdown)
+	}
+	return breakdowns
+}
+
+// topicScores scores a subset of answers on the axes they bear on. Political
+// compass axes are a share of the strongest possible leaning, from -100 to 100,
+// since its offsets only make sense for the whole quiz; the other quizzes use
+// their usual percentages over the subset.
+func topicScores(quiz string, items []answeredItem) []exportedScore {
+	var scores []exportedScore
+	switch quiz {
+	case politicalCompassQuiz:
+		var sums, maxima [2]float64
+		for _, item := range items {
+			question := politicalcompass.AllQuestions[item.question]
+			economic, social := question.Scores(item.value)
+			sums[0] += economic
+			sums[1] += social
+			for axis, weights := range [][4]float64{question.Economic, question.Social} {
+				strongest := 0.0
+				for _, weight := range weights {
+					strongest = math.Max(strongest, math.Abs(weight))
+				}
+				maxima[axis] += strongest
+			}
+		}
+		poles := [2][2]string{{"Left", "Right"}, {"Libertarian", "Authoritarian"}}
+		for axis, name := range []string{"Economic", "Social"} {
+			if maxima[axis] == 0 {
+				continue
+			}
+			// Same sign convention as the compass chart: positive is right and authoritarian
+			leaning := 100 * sums[axis] / maxima[axis]
+			label := "Centre"
+			if leaning > 0 {
+				label = poles[axis][1]
+			} else if leaning < 0 {
+				label = poles[axis][0]
+			}
+			scores = append(scores, exportedScore{Axis: name, Score: leaning, Label: label})
+		}
+
+	case eightValuesQuiz:
+		answers := map[int]float64{}
+		var weights [4]float64
+		for _, item := range items {
+			answers[item.question] = item.value
+			for axis, effect := range eightvalues.Questions[item.question].Effect {
+				weights[axis] += math.Abs(effect)
+			}
+		}
+		for axis, score := range scoreEightValues(answers) {
+			if weights[axis] > 0 {
+				scores = append(scores, exportedScore{Axis: eightValuesAxisNames[axis], Score: score, Label: eightValuesLabel(axis, score)})
+			}
+		}
+
+	default:
+		responses := map[int32]float64{}
+		for _, item := range items {
+			responses[politiscales.Questions[item.question].Index] = item.value
+		}
+		breakdowns := breakdownPolitiscales(responses)
+		for _, axis := range politiscales.Axes {
+			if breakdown := breakdowns[axis.Name]; breakdown.Sum > 0 {
+				scores = append(scores, exportedScore{Axis: axis.Name, Score: breakdown.Percentage() * breakdown.Ratio})
+			}
+		}
+		sort.SliceStable(scores, func(i, j int) bool { return scores[i].Score > scores[j].Score })
+	}
+	return scores
+}
+
+// formatTopicScores lists topic scores on one line
+func formatTopicScores(quiz string, scores []exportedScore) string {
+	parts := make([]string, len(scores))
+	for i, score := range scores {
+		switch quiz {
+		case politicalCompassQuiz:
+			parts[i] = fmt.Sprintf("%s %+.0f%% (%s)", score.Axis, score.Score, score.Label)
+		case eightValuesQuiz:
+			parts[i] = fmt.Sprintf("%s %.1f%% (%s)", score.Axis, score.Score, score.Label)
+		default:
+			parts[i] = fmt.Sprintf("%s %.1f%%", politiscales.ResultsText(politiscalesLanguage, "axis_"+score.Axis, score.Axis), score.Score)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// handleTopicBreakdown reports a quiz's leaning topic by topic, or the answers on one topic
+func handleTopicBreakdown(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	quiz, err := request.RequireString("quiz")
+	if err != nil {
+		return mcp.NewToolResultError("quiz is required"), nil
+	}
+	if _, ok := quizTitles[quiz]; !ok {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid quiz: %s. Please use one of: %s, %s, %s",
+			quiz, politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz)), nil
+	}
+	topic := ""
+	if raw := request.GetString("topic", ""); strings.TrimSpace(raw) != "" {
+		if topic, err = parseTopic(raw); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	result, err := currentResult(quiz)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	text := fmt.Sprintf("🗂️ **%s Topic Breakdown**\n\nQuestions answered: %d/%d\n", result.Title, result.Answered, result.Total)
+	if !result.Complete {
+		text += "*The quiz is still in progress, so these leanings are provisional.*\n"
+	}
+	if quiz == politicalCompassQuiz {
+		text += "*Compass leanings run from -100% to +100% of the strongest possible answers on the topic.*\n"
+	}
+
+	found := false
+	text += "\n| Topic | Answered | Leaning |\n|---|---|---|\n"
+	for _, breakdown := range breakdownTopics(quiz) {
+		if topic != "" && breakdown.topic != topic {
+			continue
+		}
+		found = true
+		leaning := "*not answered yet*"
+		if len(breakdown.scores) > 0 {
+			leaning = markdownCell(formatTopicScores(quiz, breakdown.scores))
+		}
+		text += fmt.Sprintf("| %s | %d/%d | %s |\n", topicLabel(breakdown.topic), len(breakdown.answered), breakdown.total, leaning)
+
+		if topic != "" && len(breakdown.answered) > 0 {
+			text += fmt.Sprintf("\n**Your answers on %s:**\n", topicLabel(topic))
+			for _, answer := range exportAnswers(quiz, breakdown.answered) {
+				text += fmt.Sprintf("- %s: %s\n", answer.Text, answer.Answer)
+			}
+		}
+	}
+	if !found {
+		return mcp.NewToolResultError(fmt.Sprintf("no %s question is about %s", result.Title, topicLabel(topic))), nil
+	}
+	return mcp.NewToolResultText(text), nil
+}
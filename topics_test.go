@@ -0,0 +1,216 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestEveryQuestionHasKnownTopics(t *testing.T) {
+	known := map[string]bool{}
+	for _, topic := range quizTopics {
+		known[topic.key] = true
+	}
+	used := map[string]bool{}
+	for _, quiz := range []string{politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz} {
+		for question := 0; question < quizQuestionCount(quiz); question++ {
+			topics := questionTopics(quiz, question)
+			if len(topics) == 0 {
+				t.Errorf("%s question %d has no topic", quiz, question)
+			}
+			for _, topic := range topics {
+				if !known[topic] {
+					t.Errorf("%s question %d has unknown topic %q", quiz, question, topic)
+				}
+				used[topic] = true
+			}
+		}
+	}
+	for topic := range known {
+		if !used[topic] {
+			t.Errorf("topic %q is not used by any question", topic)
+		}
+	}
+}
+
+func TestParseTopic(t *testing.T) {
+	for raw, expected := range map[string]string{"economy": "economy", "Criminal Justice": "criminal_justice", " civil-liberties ": "civil_liberties"} {
+		if topic, err := parseTopic(raw); err != nil || topic != expected {
+			t.Errorf("parseTopic(%q) = %q, %v; expected %q", raw, topic, err, expected)
+		}
+	}
+	if _, err := parseTopic("astrology"); err == nil || !strings.Contains(err.Error(), "criminal_justice") {
+		t.Errorf("expected an error listing the topics, got %v", err)
+	}
+}
+
+func TestEightValuesTopicPracticeRun(t *testing.T) {
+	resetState()
+	topic := topicQuestions(eightValuesQuiz, "criminal_justice")
+
+	response, _ := handleEightValues(context.Background(), createMockRequest("eight_values", map[string]interface{}{"answer": "", "topic": "Criminal justice"}))
+	content := extractTextContent(response)
+	if !strings.Contains(content, fmt.Sprintf("Question 1 of %d", len(topic))) || !strings.Contains(content, "Practice run") {
+		t.Fatalf("expected a practice run of %d questions, got: %s", len(topic), content)
+	}
+	for i := 0; i < len(topic); i++ {
+		response, _ = handleEightValues(context.Background(), createRequestWithAnswer("agree"))
+	}
+	content = extractTextContent(response)
+	if !strings.Contains(content, "8values Political Quiz Complete!") || !strings.Contains(content, "Practice run") {
+		t.Errorf("expected the practice run to complete, got: %s", content)
+	}
+	for _, item := range eightValuesAnswers() {
+		if !containsInt(topic, item.question) {
+			t.Errorf("question %d is not about criminal justice", item.question)
+		}
+	}
+	result, _ := currentResult(eightValuesQuiz)
+	if !result.Complete || result.Total != len(topic) || result.Mode != "practice (topic: Criminal justice)" {
+		t.Errorf("unexpected practice result: %+v", result)
+	}
+
+	// The practice topic survives a share code round trip
+	response, _ = handleShareCode(context.Background(), createMockRequest("share_code", map[string]interface{}{"quiz": "eight_values"}))
+	code := regexp.MustCompile("`([A-Za-z0-9_-]+)`").FindStringSubmatch(extractTextContent(response))[1]
+	resetState()
+	if content, isError := callTool(t, handleImportShareCode, "import_share_code", map[string]interface{}{"code": code}); isError || eightValuesQuizState.Topic != "criminal_justice" || !strings.Contains(content, "practice (topic: Criminal justice)") {
+		t.Errorf("expected the practice run restored, got topic %q: %s", eightValuesQuizState.Topic, content)
+	}
+}
+
+func TestPoliticalCompassTopicPracticeRun(t *testing.T) {
+	resetState()
+	topic := topicQuestions(politicalCompassQuiz, "religion")
+
+	handlePoliticalCompass(context.Background(), createMockRequest("political_compass", map[string]interface{}{"answer": "", "topic": "religion"}))
+	if len(shuffledQuestions) != len(topic) {
+		t.Fatalf("expected %d religion questions, got %d", len(topic), len(shuffledQuestions))
+	}
+	var content string
+	for i := 0; i < len(topic); i++ {
+		response, _ := handlePoliticalCompass(context.Background(), createRequestWithAnswer("agree"))
+		content = extractTextContent(response)
+	}
+	if !strings.Contains(content, "Political Compass Quiz Complete!") {
+		t.Errorf("expected the practice run to complete, got: %s", content)
+	}
+	status, _ := handleQuizStatus(context.Background(), createMockRequest("quiz_status", nil))
+	if text := extractTextContent(status); !strings.Contains(text, fmt.Sprintf("Questions answered: %d/%d", len(topic), len(topic))) || !strings.Contains(text, "Practice run") {
+		t.Errorf("expected the status to count the practice run, got: %s", text)
+	}
+}
+
+func TestTopicPracticeRunErrors(t *testing.T) {
+	cases := []struct {
+		name    string
+		handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		args    map[string]interface{}
+		message string
+	}{
+		{"unknown topic", handleEightValues, map[string]interface{}{"topic": "astrology"}, "invalid topic"},
+		{"with length", handleEightValues, map[string]interface{}{"topic": "economy", "length": 20}, "cannot be combined"},
+		{"with adaptive", handlePolitiscales, map[string]interface{}{"topic": "economy", "adaptive": true}, "cannot be combined"},
+		{"with pages", handlePoliticalCompass, map[string]interface{}{"topic": "economy", "pages": true}, "cannot be combined"},
+		{"not covered", handlePoliticalCompass, map[string]interface{}{"topic": "activism"}, "no Political Compass question is about Activism"},
+	}
+	for _, c := range cases {
+		resetState()
+		c.args["answer"] = ""
+		response, _ := c.handler(context.Background(), createMockRequest("", c.args))
+		if content, isError := extractTextContent(response), isErrorResult(response); !isError || !strings.Contains(content, c.message) {
+			t.Errorf("%s: expected an error containing %q, got: %s", c.name, c.message, content)
+		}
+	}
+}
+
+func TestTopicBreakdown(t *testing.T) {
+	resetState()
+	if content, isError := callTool(t, handleTopicBreakdown, "topic_breakdown", map[string]interface{}{"quiz": "eight_values"}); !isError || !strings.Contains(content, "no answers") {
+		t.Errorf("expected an error before any answer, got: %s", content)
+	}
+
+	handleEightValues(context.Background(), createRequestWithAnswer(""))
+	for i := 0; i < 30; i++ {
+		handleEightValues(context.Background(), createRequestWithAnswer("strongly_agree"))
+	}
+	content, isError := callTool(t, handleTopicBreakdown, "topic_breakdown", map[string]interface{}{"quiz": "eight_values"})
+	if isError || !strings.Contains(content, "8values Topic Breakdown") || !strings.Contains(content, "provisional") || !strings.Contains(content, "| Economy |") {
+		t.Fatalf("unexpected breakdown: %s", content)
+	}
+
+	answered := 0
+	for _, breakdown := range breakdownTopics(eightValuesQuiz) {
+		for _, item := range breakdown.answered {
+			if !containsInt(topicQuestions(eightValuesQuiz, breakdown.topic), item.question) {
+				t.Errorf("question %d is not about %s", item.question, breakdown.topic)
+			}
+		}
+		if len(breakdown.answered) > 0 && len(breakdown.scores) == 0 {
+			t.Errorf("expected %s to be scored on its answers", breakdown.topic)
+		}
+		answered += len(breakdown.answered)
+	}
+	if answered < 30 {
+		t.Errorf("expected every answer in at least one topic, got %d", answered)
+	}
+
+	content, isError = callTool(t, handleTopicBreakdown, "topic_breakdown", map[string]interface{}{"quiz": "eight_values", "topic": "economy"})
+	if isError || strings.Contains(content, "| Religion |") || (strings.Contains(content, "| Economy | 0/") == strings.Contains(content, "Your answers on Economy")) {
+		t.Errorf("expected only the economy row with its answers, got: %s", content)
+	}
+	if content, isError := callTool(t, handleTopicBreakdown, "topic_breakdown", map[string]interface{}{"quiz": "political_compass", "topic": "activism"}); !isError {
+		t.Errorf("expected an error for a topic the quiz does not cover, got: %s", content)
+	}
+}
+
+func TestPoliticalCompassTopicScores(t *testing.T) {
+	// Agreeing strongly with every economy question leans fully one way on the economic axis
+	var items []answeredItem
+	for _, question := range topicQuestions(politicalCompassQuiz, "economy") {
+		items = append(items, answeredItem{question: question, value: 1})
+	}
+	for _, score := range topicScores(politicalCompassQuiz, items) {
+		if score.Score < -100 || score.Score > 100 {
+			t.Errorf("%s leaning %.1f is out of range", score.Axis, score.Score)
+		}
+		if score.Axis == "Economic" && (score.Score == 0 || score.Label == "Centre") {
+			t.Errorf("expected a clear economic leaning, got %+v", score)
+		}
+	}
+}
+
+func TestPoliticalCompassTopicSocialPoles(t *testing.T) {
+	// Strongly disagreeing across the race topic, such as with race_superiority, leans
+	// libertarian: positive social scores are authoritarian
+	var items []answeredItem
+	for _, question := range topicQuestions(politicalCompassQuiz, "race") {
+		items = append(items, answeredItem{question: question, value: -1})
+	}
+	social := false
+	for _, score := range topicScores(politicalCompassQuiz, items) {
+		if score.Axis == "Social" {
+			social = true
+			if score.Score >= 0 || score.Label != "Libertarian" {
+				t.Errorf("expected a libertarian social leaning, got %+v", score)
+			}
+		}
+	}
+	if !social {
+		t.Error("expected a social leaning")
+	}
+}
+
+// containsInt reports whether a list holds a value
+func containsInt(list []int, value int) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
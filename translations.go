@@ -0,0 +1,300 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// translationPack holds the strings read from one pack file, keyed by table name
+// ("quiz/section") and then by translation key
+type translationPack struct {
+	Language string
+	Tables   map[string]map[string]string
+}
+
+// findTranslationTable returns the registered table with the given "quiz/section" name
+func findTranslationTable(name string) (translationTable, bool) {
+	for _, table := range translationTables {
+		if table.name() == name {
+			return table, true
+		}
+	}
+	return translationTable{}, false
+}
+
+// languageFromFilename derives a language code from a pack filename such as "de.json" or "pt.po"
+func languageFromFilename(path string) string {
+	base := filepath.Base(path)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
+
+// loadTranslationPacks reads every .json and .po file in dir and registers its strings.
+// It returns the languages that were loaded, in file order.
+func loadTranslationPacks(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading translations directory: %w", err)
+	}
+
+	var loaded []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+
+		var pack *translationPack
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".json":
+			pack, err = parseJSONTranslationPack(path)
+		case ".po":
+			pack, err = parsePOTranslationPack(path)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.Name(), err)
+		}
+		if err := registerTranslationPack(pack); err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.Name(), err)
+		}
+		loaded = append(loaded, pack.Language)
+	}
+	return loaded, nil
+}
+
+// parseJSONTranslationPack reads a pack of the form
+// {"language": "de", "political_compass": {"questions": {...}, "copy": {...}}, ...}
+func parseJSONTranslationPack(path string) (*translationPack, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	pack := &translationPack{Language: languageFromFilename(path), Tables: map[string]map[string]string{}}
+	for quiz, value := range raw {
+		if quiz == "language" {
+			if err := json.Unmarshal(value, &pack.Language); err != nil {
+				return nil, fmt.Errorf("language must be a string: %w", err)
+			}
+			continue
+		}
+
+		var sections map[string]map[string]string
+		if err := json.Unmarshal(value, &sections); err != nil {
+			return nil, fmt.Errorf("%s must map sections to string tables: %w", quiz, err)
+		}
+		for section, entries := range sections {
+			pack.Tables[quiz+"/"+section] = entries
+		}
+	}
+	return pack, nil
+}
+
+// parsePOTranslationPack reads a gettext catalogue where msgctxt is the table name
+// ("politiscales/questions"), msgid the translation key and msgstr the text.
+// The language comes from the "Language:" header, or the filename if there is none.
+func parsePOTranslationPack(path string) (*translationPack, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	pack := &translationPack{Language: languageFromFilename(path), Tables: map[string]map[string]string{}}
+
+	var ctxt, id, str string
+	var field *string
+	flush := func() {
+		if id == "" {
+			// The header entry carries catalogue metadata
+			for _, line := range strings.Split(str, "\n") {
+				if value, ok := strings.CutPrefix(line, "Language:"); ok && strings.TrimSpace(value) != "" {
+					pack.Language = strings.TrimSpace(value)
+				}
+			}
+		} else if str != "" {
+			if pack.Tables[ctxt] == nil {
+				pack.Tables[ctxt] = map[string]string{}
+			}
+			pack.Tables[ctxt][id] = str
+		}
+		ctxt, id, str, field = "", "", "", nil
+	}
+
+	seen := false
+	for n, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		keyword, rest, _ := strings.Cut(line, " ")
+		if strings.HasPrefix(line, `"`) {
+			keyword, rest = "", line
+		}
+
+		if keyword == "msgctxt" || (keyword == "msgid" && field != &ctxt) {
+			if seen {
+				flush()
+			}
+			seen = true
+		}
+
+		switch keyword {
+		case "msgctxt":
+			field = &ctxt
+		case "msgid":
+			field = &id
+		case "msgstr":
+			field = &str
+		case "":
+			if field == nil {
+				return nil, fmt.Errorf("line %d: string without a keyword", n+1)
+			}
+		default:
+			return nil, fmt.Errorf("line %d: unsupported keyword %q", n+1, keyword)
+		}
+
+		value, err := strconv.Unquote(strings.TrimSpace(rest))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid string %s", n+1, rest)
+		}
+		*field += value
+	}
+	if seen {
+		flush()
+	}
+
+	for name := range pack.Tables {
+		if name == "" {
+			return nil, fmt.Errorf("entries need a msgctxt naming their table")
+		}
+	}
+	return pack, nil
+}
+
+// registerTranslationPack merges a pack into the translation tables and makes its
+// language available to set_language. Built-in tables are copied, not modified.
+func registerTranslationPack(pack *translationPack) error {
+	if pack.Language == "" {
+		return fmt.Errorf("pack has no language")
+	}
+
+	for name := range pack.Tables {
+		if _, ok := findTranslationTable(name); !ok {
+			return fmt.Errorf("unknown table %q", name)
+		}
+	}
+
+	for name, entries := range pack.Tables {
+		table, _ := findTranslationTable(name)
+		merged := make(map[string]string, len(table.tables[pack.Language])+len(entries))
+		for key, text := range table.tables[pack.Language] {
+			merged[key] = text
+		}
+		for key, text := range entries {
+			merged[key] = text
+		}
+		table.tables[pack.Language] = merged
+	}
+
+	if !isSupportedLanguage(pack.Language) {
+		supportedLanguages = append(supportedLanguages, pack.Language)
+	}
+	return nil
+}
+
+// tableCoverage lists the keys a language is missing or has in excess compared to English
+type tableCoverage struct {
+	Table   string
+	Missing []string
+	Extra   []string
+}
+
+// translationCoverage compares every table in the language against English
+func translationCoverage(language string) []tableCoverage {
+	var report []tableCoverage
+	for _, table := range translationTables {
+		reference := table.tables["en"]
+		translated := table.tables[language]
+
+		coverage := tableCoverage{Table: table.name()}
+		for key := range reference {
+			if _, ok := translated[key]; !ok {
+				coverage.Missing = append(coverage.Missing, key)
+			}
+		}
+		for key := range translated {
+			if _, ok := reference[key]; !ok {
+				coverage.Extra = append(coverage.Extra, key)
+			}
+		}
+		sort.Strings(coverage.Missing)
+		sort.Strings(coverage.Extra)
+		report = append(report, coverage)
+	}
+	return report
+}
+
+// formatTranslationCoverage renders the coverage report for the given languages
+func formatTranslationCoverage(languages []string) string {
+	var b strings.Builder
+	b.WriteString("🌐 Translation Coverage\n")
+
+	for _, language := range languages {
+		fmt.Fprintf(&b, "\n## %s\n", language)
+		for _, coverage := range translationCoverage(language) {
+			total := len(translationTableFor(coverage.Table)["en"])
+			fmt.Fprintf(&b, "- %s: %d/%d translated", coverage.Table, total-len(coverage.Missing), total)
+			if len(coverage.Extra) > 0 {
+				fmt.Fprintf(&b, ", %d extra", len(coverage.Extra))
+			}
+			b.WriteString("\n")
+			if len(coverage.Missing) > 0 {
+				fmt.Fprintf(&b, "  Missing: %s\n", strings.Join(coverage.Missing, ", "))
+			}
+			if len(coverage.Extra) > 0 {
+				fmt.Fprintf(&b, "  Extra: %s\n", strings.Join(coverage.Extra, ", "))
+			}
+		}
+	}
+	return b.String()
+}
+
+// translationTableFor returns the per-language tables for a "quiz/section" name
+func translationTableFor(name string) map[string]map[string]string {
+	table, _ := findTranslationTable(name)
+	return table.tables
+}
+
+// Handler function for reporting missing and extra translation keys
+func handleTranslationCoverage(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	language := request.GetString("language", "")
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	languages := supportedLanguages
+	if language != "" {
+		if !isSupportedLanguage(language) {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid language: %s. Valid languages are: %s",
+				language, strings.Join(supportedLanguages, ", "))), nil
+		}
+		languages = []string{language}
+	}
+
+	return mcp.NewToolResultText(formatTranslationCoverage(languages)), nil
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+// unregisterLanguage removes a language loaded from a translation pack
+func unregisterLanguage(language string) {
+	for _, table := range translationTables {
+		delete(table.tables, language)
+	}
+	for i, lang := range supportedLanguages {
+		if lang == language {
+			supportedLanguages = append(supportedLanguages[:i:i], supportedLanguages[i+1:]...)
+			break
+		}
+	}
+}
+
+func writePack(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+		t.Fatalf("writing %s: %v", name, err)
+	}
+}
+
+func TestLoadJSONTranslationPack(t *testing.T) {
+	defer unregisterLanguage("de")
+	defer restoreDefaultLanguages()
+	resetState()
+
+	dir := t.TempDir()
+	writePack(t, dir, "german.json", `{
+		"language": "de",
+		"political_compass": {
+			"questions": {"astrology": "Astrologie erklärt viele Dinge genau."},
+			"copy": {"agree": "Zustimmen"}
+		},
+		"politiscales": {
+			"results": {"results_title": "Ergebnisse", "not_a_key": "x"}
+		}
+	}`)
+
+	loaded, err := loadTranslationPacks(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(loaded) != 1 || loaded[0] != "de" {
+		t.Fatalf("expected de to be loaded, got %v", loaded)
+	}
+	if !isSupportedLanguage("de") {
+		t.Fatal("loaded language should be supported")
+	}
+
+	response, _ := handleSetLanguage(context.Background(), createRequestWithLanguage("de"))
+	if isErrorResult(response) {
+		t.Fatalf("set_language should accept a loaded language: %s", extractTextContent(response))
+	}
+	if got := getPoliticalCompassQuestionText("astrology"); got != "Astrologie erklärt viele Dinge genau." {
+		t.Errorf("expected German question text, got %s", got)
+	}
+	if got := getPoliticalCompassQuestionText("globalisation_humanity"); got != politicalcompass.ENQuestions["globalisation_humanity"] {
+		t.Errorf("untranslated question should fall back to English, got %s", got)
+	}
+	if got := politiscales.ResultsText("de", "results_title", ""); got != "Ergebnisse" {
+		t.Errorf("expected German results title, got %s", got)
+	}
+}
+
+func TestLoadPOTranslationPack(t *testing.T) {
+	defer unregisterLanguage("pt")
+
+	dir := t.TempDir()
+	writePack(t, dir, "portuguese.po", `# Portuguese translation
+msgid ""
+msgstr ""
+"Language: pt\n"
+"Content-Type: text/plain; charset=UTF-8\n"
+
+#: politiscales
+msgctxt "politiscales/copy"
+msgid "agree"
+msgstr "Concordo"
+
+msgctxt "eight_values/questions"
+msgid "free_markets_free_people"
+msgstr ""
+"Mercados livres "
+"criam pessoas \"livres\"."
+
+msgctxt "eight_values/copy"
+msgid "disagree"
+msgstr ""
+`)
+
+	if _, err := loadTranslationPacks(dir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := translate(politiscalesCopyTables, "pt", "agree"); got != "Concordo" {
+		t.Errorf("expected Portuguese copy, got %s", got)
+	}
+	if got := translate(eightValuesQuestionTables, "pt", "free_markets_free_people"); got != `Mercados livres criam pessoas "livres".` {
+		t.Errorf("continuation lines should be joined, got %s", got)
+	}
+	if _, ok := eightValuesCopyTables["pt"]["disagree"]; ok {
+		t.Error("empty msgstr should be treated as untranslated")
+	}
+}
+
+func TestTranslationPackStringsEscapedInCharts(t *testing.T) {
+	defer unregisterLanguage("de")
+	defer restoreDefaultLanguages()
+
+	dir := t.TempDir()
+	writePack(t, dir, "german.json", `{
+		"language": "de",
+		"politiscales": {
+			"results": {
+				"results_title": "Ergebnisse & Werte",
+				"axis_capitalism": "Markt & Kapital",
+				"noun_capitalism": "Kapitalist <Markt>",
+				"badge_religion": "Glaube & Kirche"
+			}
+		}
+	}`)
+	if _, err := loadTranslationPacks(dir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	results := map[string]float64{"capitalism": 80, "communism": 10, "religion": 90}
+	charts := map[string]string{
+		"results": politiscales.GeneratePolitiscalesResultsSVGWithOptions(results, politiscales.SVGOptions{Language: "de"}),
+		"badges":  politiscales.GenerateBadgesSVG(results, "de"),
+	}
+	for name, svg := range charts {
+		decoder := xml.NewDecoder(strings.NewReader(svg))
+		for {
+			_, err := decoder.Token()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("%s: pack strings should not break the SVG: %v", name, err)
+			}
+		}
+		if !strings.Contains(svg, "Glaube &amp; Kirche") {
+			t.Errorf("%s: expected the escaped badge label, got: %s", name, svg)
+		}
+	}
+	for _, expected := range []string{"Ergebnisse &amp; Werte", "Markt &amp; Kapital", "Kapitalist &lt;Markt&gt;"} {
+		if !strings.Contains(charts["results"], expected) {
+			t.Errorf("expected %q in the results chart", expected)
+		}
+	}
+}
+
+func TestTranslationPackOverridesDoNotModifyBuiltins(t *testing.T) {
+	original := politiscales.FRCopy["agree"]
+	defer func() { politiscalesCopyTables["fr"] = politiscales.FRCopy }()
+
+	err := registerTranslationPack(&translationPack{
+		Language: "fr",
+		Tables:   map[string]map[string]string{"politiscales/copy": {"agree": "D'accord !"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if politiscalesCopyTables["fr"]["agree"] != "D'accord !" {
+		t.Error("pack should override the existing string")
+	}
+	if politiscales.FRCopy["agree"] != original {
+		t.Error("built-in table should not be modified")
+	}
+	if len(politiscalesCopyTables["fr"]) != len(politiscales.FRCopy) {
+		t.Error("override should keep the other built-in strings")
+	}
+}
+
+func TestInvalidTranslationPacks(t *testing.T) {
+	cases := map[string]string{
+		"bad.json":    `{"political_compass": `,
+		"table.json":  `{"language": "xx", "political_compass": {"answers": {"a": "b"}}}`,
+		"noctxt.po":   "msgid \"agree\"\nmsgstr \"ok\"\n",
+		"keyword.po":  "msgctxt \"politiscales/copy\"\nmsgid_plural \"agree\"\n",
+		"unquoted.po": "msgctxt \"politiscales/copy\"\nmsgid agree\n",
+	}
+	for name, content := range cases {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			writePack(t, dir, name, content)
+			if _, err := loadTranslationPacks(dir); err == nil {
+				t.Error("expected error for malformed pack")
+			}
+			if isSupportedLanguage("xx") {
+				unregisterLanguage("xx")
+				t.Error("malformed pack should not register its language")
+			}
+		})
+	}
+
+	if _, err := loadTranslationPacks(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("expected error for missing directory")
+	}
+}
+
+func TestTranslationCoverage(t *testing.T) {
+	for _, lang := range []string{"en", "fr", "zh"} {
+		for _, coverage := range translationCoverage(lang) {
+			if len(coverage.Missing) > 0 || len(coverage.Extra) > 0 {
+				t.Errorf("%s %s: missing %v, extra %v", lang, coverage.Table, coverage.Missing, coverage.Extra)
+			}
+		}
+	}
+
+	defer unregisterLanguage("de")
+	registerTranslationPack(&translationPack{
+		Language: "de",
+		Tables:   map[string]map[string]string{"politiscales/copy": {"agree": "Zustimmen", "bogus": "x"}},
+	})
+
+	response, _ := handleTranslationCoverage(context.Background(), createMockRequest("translation_coverage", map[string]interface{}{"language": "de"}))
+	content := extractTextContent(response)
+	for _, expected := range []string{"## de", "politiscales/copy: 1/10 translated, 1 extra", "Extra: bogus", "Missing: back_home"} {
+		if !strings.Contains(content, expected) {
+			t.Errorf("expected %q in coverage report, got:\n%s", expected, content)
+		}
+	}
+	if strings.Contains(content, "## fr") {
+		t.Error("report should be limited to the requested language")
+	}
+
+	response, _ = handleTranslationCoverage(context.Background(), createMockRequest("translation_coverage", map[string]interface{}{"language": "xx"}))
+	if !isErrorResult(response) {
+		t.Error("expected error for unknown language")
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+// standardError is the standard error of the weighted mean answer, in answer units
+func (e axisEstimate) standardError() float64 {
+	if e.weight == 0 {
+		return 0
+	}
+	return math.Sqrt(e.variance*e.weightSq) / e.weight
+}
+
+// confidenceMargin returns the half-width, in percentage points, of a 95% interval
+// for the axis score. Unlike Margin it has no finite-population correction: it
+// treats the answered questions as a sample of the opinions behind the axis, so an
+// axis that only one or two questions inform stays wide after the quiz is complete.
+func (e axisEstimate) confidenceMargin() float64 {
+	if e.weight == 0 {
+		return 50
+	}
+	return adaptiveZ * e.standardError() * 100 / e.spread
+}
+
+// formatConfidence describes a score's 95% interval, clamped to the axis range
+func formatConfidence(score, margin, min, max float64, decimals int) string {
+	return fmt.Sprintf(" (±%.*f, 95%% CI %.*f–%.*f)", decimals, margin,
+		decimals, math.Max(min, score-margin), decimals, math.Min(max, score+margin))
+}
+
+// politicalCompassConfidenceMargins returns the 95% interval half-widths of the
+// economic and social scores on the -10 to 10 compass scale
+func politicalCompassConfidenceMargins() (economic, social float64) {
+	var economicObservations, socialObservations []axisObservation
+	for i, response := range quizState.Responses {
+		if i >= len(shuffledQuestions) {
+			break
+		}
+		question := politicalcompass.AllQuestions[shuffledQuestions[i]]
+		value := politicalCompassAnswerValue(i, response)
+		economicObservations = appendCompassObservation(economicObservations, question.Economic, value)
+		socialObservations = appendCompassObservation(socialObservations, question.Social, value)
+	}
+	// The divisors match the pc.js normalisation in handlePoliticalCompass
+	return compassMargin(economicObservations, 8.0), compassMargin(socialObservations, 19.5)
+}
+
+// appendCompassObservation places an answer within its question's weight range, from
+// -1 at the lowest column to 1 at the highest, weighted by half that range. Questions
+// with no weight on the axis are skipped.
+func appendCompassObservation(observations []axisObservation, weights [4]float64, value float64) []axisObservation {
+	low, high := weights[0], weights[0]
+	for _, weight := range weights {
+		low, high = math.Min(low, weight), math.Max(high, weight)
+	}
+	half := (high - low) / 2
+	if half == 0 {
+		return observations
+	}
+	position := (politicalcompass.Interpolate(weights, value) - (high+low)/2) / half
+	return append(observations, axisObservation{value: position, weight: half})
+}
+
+// compassMargin converts the uncertainty in the mean position into compass units.
+// The raw score is the sum of the column midpoints plus the total half-range times
+// the mean position, so its standard error is the total weight times the mean's.
+func compassMargin(observations []axisObservation, divisor float64) float64 {
+	if len(observations) == 0 {
+		// Nothing known: the whole half-scale is possible
+		return 10
+	}
+	estimate := estimateAxis(observations, 0, 2)
+	return adaptiveZ * estimate.weight * estimate.standardError() / divisor
+}
+
+// eightValuesConfidenceMargins returns the 95% interval half-width of each 8values
+// axis in percentage points, in Effect order
+func eightValuesConfidenceMargins() [4]float64 {
+	var margins [4]float64
+	for axis, estimate := range eightValuesAxisEstimates() {
+		margins[axis] = estimate.confidenceMargin()
+	}
+	return margins
+}
+
+// politiscalesConfidenceMargins returns the 95% interval half-width of each
+// politiscales axis in percentage points, keyed by axis name. Margins shrink with
+// the score when pair normalisation scales an axis down.
+func politiscalesConfidenceMargins(results map[string]float64) map[string]float64 {
+	margins := make(map[string]float64)
+	for i, estimate := range politiscalesAxisEstimates() {
+		axis := politiscales.Axes[i].Name
+		margin := estimate.confidenceMargin()
+		if raw := estimate.Mean * 100; raw > 0 && results[axis] < raw {
+			margin *= results[axis] / raw
+		}
+		margins[axis] = margin
+	}
+	return margins
+}
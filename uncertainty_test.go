@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+func TestConfidenceMargin(t *testing.T) {
+	single := estimateAxis([]axisObservation{{value: 1, weight: 1}}, 0, 2)
+	var consistent []axisObservation
+	for i := 0; i < 12; i++ {
+		consistent = append(consistent, axisObservation{value: 1, weight: 1})
+	}
+	many := estimateAxis(consistent, 0, 2)
+	mixed := estimateAxis(append(consistent[:6:6], []axisObservation{
+		{value: -1, weight: 1}, {value: -1, weight: 1}, {value: -1, weight: 1},
+		{value: -1, weight: 1}, {value: -1, weight: 1}, {value: -1, weight: 1},
+	}...), 0, 2)
+
+	if single.Margin != 0 {
+		t.Errorf("adaptive margin should be zero with nothing remaining, got %.2f", single.Margin)
+	}
+	if single.confidenceMargin() < 30 {
+		t.Errorf("a single answer should leave a wide interval, got ±%.1f", single.confidenceMargin())
+	}
+	if many.confidenceMargin() >= single.confidenceMargin() {
+		t.Errorf("more answers should narrow the interval: 1 -> %.1f, 12 -> %.1f", single.confidenceMargin(), many.confidenceMargin())
+	}
+	if mixed.confidenceMargin() <= many.confidenceMargin() {
+		t.Errorf("contradictory answers should widen the interval: %.1f vs %.1f", mixed.confidenceMargin(), many.confidenceMargin())
+	}
+	if empty := estimateAxis(nil, 0, 2); empty.confidenceMargin() != 50 {
+		t.Errorf("an axis with no answers should span the whole scale, got ±%.1f", empty.confidenceMargin())
+	}
+}
+
+func TestFormatConfidence(t *testing.T) {
+	if got := formatConfidence(95, 8, 0, 100, 1); got != " (±8.0, 95% CI 87.0–100.0)" {
+		t.Errorf("unexpected interval: %q", got)
+	}
+	if got := formatConfidence(-9.5, 1.25, -10, 10, 2); got != " (±1.25, 95% CI -10.00–-8.25)" {
+		t.Errorf("unexpected interval: %q", got)
+	}
+}
+
+func TestPoliticalCompassConfidenceIntervals(t *testing.T) {
+	resetState()
+
+	handlePoliticalCompass(context.Background(), createRequestWithAnswer(""))
+	var content string
+	for i := 0; i < len(politicalcompass.AllQuestions); i++ {
+		response, _ := handlePoliticalCompass(context.Background(), createRequestWithAnswer("agree"))
+		content = extractTextContent(response)
+	}
+
+	economic, social := politicalCompassConfidenceMargins()
+	if economic <= 0 || economic > 3 || social <= 0 || social > 3 {
+		t.Errorf("unexpected compass margins: economic ±%.2f, social ±%.2f", economic, social)
+	}
+	if !strings.Contains(content, "95% CI") || !strings.Contains(content, "<!-- Confidence intervals -->\n  <line") {
+		t.Errorf("completion should report and draw confidence intervals, got: %s", content)
+	}
+
+	status, _ := handleQuizStatus(context.Background(), createEmptyRequest())
+	if !strings.Contains(extractTextContent(status), "at 95% confidence") {
+		t.Errorf("status should report confidence intervals, got: %s", extractTextContent(status))
+	}
+}
+
+func TestEightValuesConfidenceIntervals(t *testing.T) {
+	resetState()
+
+	handleEightValues(context.Background(), createMockRequest("eight_values", map[string]interface{}{"answer": "", "length": 20}))
+	var content string
+	for i := 0; i < 20; i++ {
+		response, _ := handleEightValues(context.Background(), createRequestWithAnswer("agree"))
+		content = extractTextContent(response)
+	}
+	if strings.Count(content, "95% CI") != 4 {
+		t.Errorf("expected an interval for each axis, got: %s", content)
+	}
+	if !strings.Contains(content, `stroke="#222222" stroke-width="4"`) {
+		t.Error("completion chart should draw error bars")
+	}
+
+	shortForm := eightValuesConfidenceMargins()
+	resetState()
+	handleEightValues(context.Background(), createRequestWithAnswer(""))
+	for i := 0; i < 70; i++ {
+		handleEightValues(context.Background(), createRequestWithAnswer("agree"))
+	}
+	full := eightValuesConfidenceMargins()
+	for axis := range full {
+		if full[axis] >= shortForm[axis] {
+			t.Errorf("%s: the full quiz should be more certain than a short form (%.1f vs %.1f)", eightValuesAxisNames[axis], full[axis], shortForm[axis])
+		}
+	}
+
+	status, _ := handleEightValuesStatus(context.Background(), createEmptyRequest())
+	if strings.Count(extractTextContent(status), "95% CI") != 4 {
+		t.Errorf("status should report an interval for each axis, got: %s", extractTextContent(status))
+	}
+}
+
+func TestPolitiscalesConfidenceIntervals(t *testing.T) {
+	resetState()
+
+	handlePolitiscales(context.Background(), createRequestWithAnswer(""))
+	var content string
+	for i := 0; i < len(politiscales.Questions); i++ {
+		response, _ := handlePolitiscales(context.Background(), createRequestWithAnswer("agree"))
+		content = extractTextContent(response)
+	}
+	if !strings.Contains(content, "Quiz Complete!") || !strings.Contains(content, "95% CI") {
+		t.Fatalf("completion should report confidence intervals, got: %s", content)
+	}
+
+	// Monarchism rests on a single question, so it stays far less certain than a paired axis
+	margins := politiscalesConfidenceMargins(calculatePolitiscalesResults())
+	if margins["monarchism"] < 2*margins["capitalism"] {
+		t.Errorf("single-question axes should have wide intervals: monarchism ±%.1f, capitalism ±%.1f",
+			margins["monarchism"], margins["capitalism"])
+	}
+
+	status, _ := handlePolitiscalesStatus(context.Background(), createEmptyRequest())
+	if !strings.Contains(extractTextContent(status), "95% CI") {
+		t.Errorf("status should report confidence intervals, got: %s", extractTextContent(status))
+	}
+}
@@ -0,0 +1,334 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/eightvalues"
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+// whatIfOverride is one hypothetical answer to a question
+type whatIfOverride struct {
+	question int
+	answered bool    // whether the question has a recorded answer
+	before   float64 // recorded answer, if any
+	after    float64 // hypothetical answer
+}
+
+// whatIfAnswerScale returns the answer tokens a quiz accepts with their values,
+// along with the copy tables and language used to recognise translated answers
+func whatIfAnswerScale(quiz string) (values map[string]float64, tokens []string, copyTables map[string]map[string]string, language string) {
+	switch quiz {
+	case politicalCompassQuiz:
+		// The political compass has no neutral option
+		return map[string]float64{
+				"strongly_disagree": politicalcompass.ResponseValues[politicalcompass.StronglyDisagree],
+				"disagree":          politicalcompass.ResponseValues[politicalcompass.Disagree],
+				"agree":             politicalcompass.ResponseValues[politicalcompass.Agree],
+				"strongly_agree":    politicalcompass.ResponseValues[politicalcompass.StronglyAgree],
+			}, []string{"strongly_disagree", "disagree", "agree", "strongly_agree"},
+			politicalCompassCopyTables, politicalCompassLanguage
+	case eightValuesQuiz:
+		return map[string]float64{
+			"strongly_disagree": eightvalues.StronglyDisagree,
+			"disagree":          eightvalues.Disagree,
+			"neutral":           eightvalues.Neutral,
+			"agree":             eightvalues.Agree,
+			"strongly_agree":    eightvalues.StronglyAgree,
+		}, answerTokens, eightValuesCopyTables, eightValuesLanguage
+	default:
+		return map[string]float64{
+			"strongly_disagree": -1.0,
+			"disagree":          -2.0 / 3.0,
+			"neutral":           0.0,
+			"agree":             2.0 / 3.0,
+			"strongly_agree":    1.0,
+		}, answerTokens, politiscalesCopyTables, politiscalesLanguage
+	}
+}
+
+// quizQuestionCount returns the number of questions in a quiz's full bank
+func quizQuestionCount(quiz string) int {
+	switch quiz {
+	case politicalCompassQuiz:
+		return len(politicalcompass.AllQuestions)
+	case eightValuesQuiz:
+		return len(eightvalues.Questions)
+	default:
+		return len(politiscales.Questions)
+	}
+}
+
+// questionKey returns the translation key of a question by index
+func questionKey(quiz string, question int) string {
+	switch quiz {
+	case politicalCompassQuiz:
+		return politicalcompass.AllQuestions[question].Text
+	case eightValuesQuiz:
+		return eightvalues.Questions[question].Text
+	default:
+		return politiscales.Questions[question].Text
+	}
+}
+
+// parseWhatIfOverrides resolves override keys (question IDs or translation keys)
+// and answers (answer strings or numbers from -1 to 1) against the recorded answers
+func parseWhatIfOverrides(quiz string, raw map[string]any, current map[int]float64) ([]whatIfOverride, error) {
+	values, tokens, copyTables, language := whatIfAnswerScale(quiz)
+	count := quizQuestionCount(quiz)
+
+	keys := make([]string, 0, len(raw))
+	for key := range raw {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	seen := map[int]string{}
+	var overrides []whatIfOverride
+	for _, key := range keys {
+		question := questionIndex(quiz, strings.TrimSpace(key))
+		if id, err := strconv.Atoi(strings.TrimSpace(key)); err == nil {
+			question = id
+		}
+		if question < 0 || question >= count {
+			return nil, fmt.Errorf("invalid question: %s. Use a question ID from 0 to %d or a question key", key, count-1)
+		}
+		if previous, ok := seen[question]; ok {
+			return nil, fmt.Errorf("duplicate override: %s and %s refer to the same question", previous, key)
+		}
+		seen[question] = key
+
+		var value float64
+		switch answer := raw[key].(type) {
+		case float64:
+			if math.IsNaN(answer) || answer < -1 || answer > 1 {
+				return nil, fmt.Errorf("invalid value for %s: must be a number between -1 (strongly disagree) and 1 (strongly agree)", key)
+			}
+			value = answer
+		case string:
+			token := normalizeAnswer(answer, copyTables, language)
+			v, ok := values[token]
+			if !ok {
+				return nil, fmt.Errorf("invalid answer for %s: %s", key, invalidAnswerMessage(answer, tokens, copyTables, language))
+			}
+			value = v
+		default:
+			return nil, fmt.Errorf("invalid answer for %s: use an answer such as \"agree\" or a number between -1 and 1", key)
+		}
+
+		before, answered := current[question]
+		overrides = append(overrides, whatIfOverride{question: question, answered: answered, before: before, after: value})
+	}
+	return overrides, nil
+}
+
+// handleWhatIf rescores a quiz with some answers replaced, leaving the recorded answers untouched
+func handleWhatIf(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	quiz, err := request.RequireString("quiz")
+	if err != nil {
+		return mcp.NewToolResultError("quiz is required"), nil
+	}
+	raw, _ := request.GetArguments()["overrides"].(map[string]any)
+	if len(raw) == 0 {
+		return mcp.NewToolResultError("overrides is required: map question IDs or keys to answers, e.g. {\"3\": \"agree\"}"), nil
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	var items []answeredItem
+	switch quiz {
+	case politicalCompassQuiz:
+		items = politicalCompassAnswers()
+	case eightValuesQuiz:
+		items = eightValuesAnswers()
+	case politiscalesQuiz:
+		items = politiscalesAnswers()
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("invalid quiz: %s. Please use one of: %s, %s, %s",
+			quiz, politicalCompassQuiz, eightValuesQuiz, politiscalesQuiz)), nil
+	}
+	if err := reviewHold(quiz); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Both scorings work on copies, so the quiz state is never written
+	before := map[int]float64{}
+	for _, item := range items {
+		before[item.question] = item.value
+	}
+	overrides, err := parseWhatIfOverrides(quiz, raw, before)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	after := map[int]float64{}
+	for question, value := range before {
+		after[question] = value
+	}
+	for _, override := range overrides {
+		after[override.question] = override.after
+	}
+
+	text := fmt.Sprintf("🔮 **What If: %s**\n\n*Hypothetical only: your recorded answers are unchanged.*\n\n**Changed answers:**\n", quizTitles[quiz])
+	for _, override := range overrides {
+		was := "(not answered)"
+		if override.answered {
+			was = describeAnswer(override.before)
+		}
+		text += fmt.Sprintf("- #%d \"%s\": %s → %s\n", override.question,
+			questionText(itemRef{quiz, questionKey(quiz, override.question)}), was, describeAnswer(override.after))
+	}
+
+	var scores, svg string
+	switch quiz {
+	case politicalCompassQuiz:
+		scores, svg = whatIfPoliticalCompass(before, after)
+	case eightValuesQuiz:
+		scores, svg = whatIfEightValues(before, after)
+	default:
+		scores, svg = whatIfPolitiscales(before, after)
+	}
+	text += "\n**Scores (before → after):**\n" + scores + "\n" + svg + "\n\n" +
+		"The chart shows the hypothetical result, with the current result marked in grey or dashed lines."
+	return mcp.NewToolResultText(text), nil
+}
+
+// whatIfPoliticalCompass compares compass positions, scoring each answer set like completion does
+func whatIfPoliticalCompass(before, after map[int]float64) (string, string) {
+	beforeEconomic, beforeSocial := scorePoliticalCompass(before)
+	afterEconomic, afterSocial := scorePoliticalCompass(after)
+
+	text := fmt.Sprintf("- Economic: %.2f → %.2f (%+.2f)\n", beforeEconomic, afterEconomic, afterEconomic-beforeEconomic)
+	text += fmt.Sprintf("- Social: %.2f → %.2f (%+.2f)\n", beforeSocial, afterSocial, afterSocial-beforeSocial)
+	text += "- Quadrant: " + describeChange(getQuadrant(beforeEconomic, beforeSocial), getQuadrant(afterEconomic, afterSocial)) + "\n"
+
+	svg := politicalcompass.GenerateSVGWithOptions(afterEconomic, afterSocial, politicalcompass.SVGOptions{
+		Previous: &politicalcompass.Position{Economic: beforeEconomic, Social: beforeSocial},
+	})
+	return text, svg
+}
+
+// whatIfEightValues compares 8values percentages and their setLabel classifications
+func whatIfEightValues(before, after map[int]float64) (string, string) {
+	beforeScores := scoreEightValues(before)
+	afterScores := scoreEightValues(after)
+
+	var text string
+	var previous [4]float64
+	for axis, name := range eightValuesAxisNames {
+		previous[axis] = beforeScores[axis]
+		text += fmt.Sprintf("- %s: %.1f%% → %.1f%% (%+.1f), %s\n", name, beforeScores[axis], afterScores[axis],
+			afterScores[axis]-beforeScores[axis],
+			describeChange(eightValuesLabel(axis, beforeScores[axis]), eightValuesLabel(axis, afterScores[axis])))
+	}
+
+	svg := eightvalues.GenerateSVGWithOptions(afterScores[eightvalues.Economic], afterScores[eightvalues.Diplomatic],
+		afterScores[eightvalues.Government], afterScores[eightvalues.Society], eightvalues.SVGOptions{Previous: &previous})
+	return text, svg
+}
+
+// whatIfPolitiscales compares politiscales axes that moved, the leading side of
+// each pair, and the badges earned
+func whatIfPolitiscales(before, after map[int]float64) (string, string) {
+	toResponses := func(answers map[int]float64) map[int32]float64 {
+		responses := make(map[int32]float64)
+		for question, value := range answers {
+			responses[politiscales.Questions[question].Index] = value
+		}
+		return responses
+	}
+	beforeResults := scorePolitiscales(toResponses(before))
+	afterResults := scorePolitiscales(toResponses(after))
+
+	var text string
+	unchanged := 0
+	for _, axis := range politiscales.Axes {
+		delta := afterResults[axis.Name] - beforeResults[axis.Name]
+		if math.Abs(delta) < 0.005 {
+			unchanged++
+			continue
+		}
+		text += fmt.Sprintf("- %s: %.2f → %.2f (%+.2f)\n", axis.Name, beforeResults[axis.Name], afterResults[axis.Name], delta)
+	}
+	if unchanged > 0 {
+		text += fmt.Sprintf("- %d other axes unchanged\n", unchanged)
+	}
+
+	beforeLeaning, afterLeaning := politiscalesLeaning(beforeResults), politiscalesLeaning(afterResults)
+	for _, pair := range politiscalesPairs() {
+		if beforeLeaning[pair] != afterLeaning[pair] {
+			text += fmt.Sprintf("- %s leaning: %s\n", pair, describeChange(beforeLeaning[pair], afterLeaning[pair]))
+		}
+	}
+	text += "- Badges: " + describeChange(strings.Join(politiscalesBadges(beforeResults), ", "),
+		strings.Join(politiscalesBadges(afterResults), ", ")) + "\n"
+
+	svg := politiscalesChart(afterResults,
+		politiscales.SVGOptions{Language: politiscalesLanguage, Previous: beforeResults})
+	return text, svg
+}
+
+// politiscalesPairs returns the politiscales axis pairs in chart order
+func politiscalesPairs() []string {
+	var pairs []string
+	seen := map[string]bool{}
+	for _, axis := range politiscales.Axes {
+		if axis.Pair != "" && !seen[axis.Pair] {
+			seen[axis.Pair] = true
+			pairs = append(pairs, axis.Pair)
+		}
+	}
+	return pairs
+}
+
+// politiscalesLeaning names the higher-scoring axis of each pair, or "balanced" on a tie
+func politiscalesLeaning(results map[string]float64) map[string]string {
+	leaning := map[string]string{}
+	best := map[string]float64{}
+	for _, axis := range politiscales.Axes {
+		if axis.Pair == "" {
+			continue
+		}
+		score := results[axis.Name]
+		_, seen := leaning[axis.Pair]
+		switch {
+		case !seen || score > best[axis.Pair]:
+			leaning[axis.Pair], best[axis.Pair] = axis.Name, score
+		case score == best[axis.Pair]:
+			leaning[axis.Pair] = "balanced"
+		}
+	}
+	return leaning
+}
+
+// politiscalesBadges lists the unpaired axes whose score reaches their badge threshold
+func politiscalesBadges(results map[string]float64) []string {
+	var badges []string
+	for _, axis := range politiscales.Axes {
+		if axis.Pair == "" && axis.Threshold > 0 && results[axis.Name] >= axis.Threshold*100 {
+			badges = append(badges, axis.Label)
+		}
+	}
+	return badges
+}
+
+// describeChange shows a label before and after, or notes that it did not change
+func describeChange(before, after string) string {
+	if before == "" {
+		before = "none"
+	}
+	if after == "" {
+		after = "none"
+	}
+	if before == after {
+		return before + " (unchanged)"
+	}
+	return before + " → " + after
+}
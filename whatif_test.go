@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/x86ed/MCP-PoliticalCompass/v3/eightvalues"
+	politicalcompass "github.com/x86ed/MCP-PoliticalCompass/v3/political-compass"
+	"github.com/x86ed/MCP-PoliticalCompass/v3/politiscales"
+)
+
+func TestWhatIfLeavesStateUntouched(t *testing.T) {
+	resetState()
+
+	handlePoliticalCompass(context.Background(), createRequestWithAnswer(""))
+	for i := 0; i < 10; i++ {
+		handlePoliticalCompass(context.Background(), createRequestWithAnswer("agree"))
+	}
+	responses := append([]politicalcompass.Response(nil), quizState.Responses...)
+	values := append([]float64(nil), quizState.Values...)
+	economic, social, index := totalEconomicScore, totalSocialScore, currentIndex
+
+	first := shuffledQuestions[0]
+	content, isError := callTool(t, handleWhatIf, "what_if", map[string]interface{}{
+		"quiz":      "political_compass",
+		"overrides": map[string]interface{}{fmt.Sprint(first): "strongly_disagree"},
+	})
+	if isError {
+		t.Fatalf("unexpected error: %s", content)
+	}
+
+	if !reflect.DeepEqual(responses, quizState.Responses) || !reflect.DeepEqual(values, quizState.Values) {
+		t.Error("what_if should not change the recorded answers")
+	}
+	if economic != totalEconomicScore || social != totalSocialScore || index != currentIndex {
+		t.Error("what_if should not change the running scores")
+	}
+
+	beforeEconomic, beforeSocial := politicalCompassPosition(totalEconomicScore, totalSocialScore)
+	for _, expected := range []string{
+		"What If: Political Compass",
+		"your recorded answers are unchanged",
+		fmt.Sprintf("#%d", first),
+		"agree → strongly disagree",
+		fmt.Sprintf("- Economic: %.2f →", beforeEconomic),
+		fmt.Sprintf("- Social: %.2f →", beforeSocial),
+		"- Quadrant: ",
+		fmt.Sprintf("Before: (%.2f, %.2f)", beforeEconomic, beforeSocial),
+	} {
+		if !strings.Contains(content, expected) {
+			t.Errorf("expected %q in what_if output, got: %s", expected, content)
+		}
+	}
+}
+
+func TestWhatIfEightValuesLabelChange(t *testing.T) {
+	resetState()
+
+	// A single answer decides an axis: find a question that only moves the economic axis
+	question := -1
+	for i, q := range eightvalues.Questions {
+		if q.Effect[eightvalues.Economic] > 0 && q.Effect[eightvalues.Diplomatic] == 0 &&
+			q.Effect[eightvalues.Government] == 0 && q.Effect[eightvalues.Society] == 0 {
+			question = i
+			break
+		}
+	}
+	if question < 0 {
+		t.Skip("no purely economic question in the bank")
+	}
+
+	scores, svg := whatIfEightValues(map[int]float64{}, map[int]float64{question: eightvalues.StronglyAgree})
+	if !strings.Contains(scores, "- Economic: 50.0% → 100.0% (+50.0), Centrist → Communist") {
+		t.Errorf("expected the economic label to change, got: %s", scores)
+	}
+	if !strings.Contains(scores, "- Society: 50.0% → 50.0% (+0.0), Neutral (unchanged)") {
+		t.Errorf("expected untouched axes to keep their label, got: %s", scores)
+	}
+	if !strings.Contains(svg, "Dashed lines: before") {
+		t.Error("expected the chart to mark the previous scores")
+	}
+}
+
+func TestWhatIfQuestionKeysAndUnansweredQuestions(t *testing.T) {
+	resetState()
+
+	handlePolitiscales(context.Background(), createRequestWithAnswer(""))
+	handlePolitiscales(context.Background(), createRequestWithAnswer("agree"))
+	responses := map[int32]float64{}
+	for k, v := range politiscalesQuizState.Responses {
+		responses[k] = v
+	}
+
+	// Pick a question that has not been answered and address it by key
+	var key string
+	for _, q := range politiscales.Questions {
+		if _, answered := politiscalesQuizState.Responses[q.Index]; !answered {
+			key = q.Text
+			break
+		}
+	}
+	content, isError := callTool(t, handleWhatIf, "what_if", map[string]interface{}{
+		"quiz":      "politiscales",
+		"overrides": map[string]interface{}{key: 1.0},
+	})
+	if isError {
+		t.Fatalf("unexpected error: %s", content)
+	}
+	if !strings.Contains(content, "(not answered) → strongly agree") {
+		t.Errorf("expected the unanswered question to be listed, got: %s", content)
+	}
+	if !strings.Contains(content, "- Badges: ") {
+		t.Errorf("expected badge changes in what_if output, got: %s", content)
+	}
+	if !reflect.DeepEqual(responses, politiscalesQuizState.Responses) {
+		t.Error("what_if should not record hypothetical answers")
+	}
+}
+
+func TestWhatIfErrors(t *testing.T) {
+	resetState()
+
+	tests := []struct {
+		name     string
+		args     map[string]interface{}
+		expected string
+	}{
+		{"missing quiz", map[string]interface{}{"overrides": map[string]interface{}{"0": "agree"}}, "quiz is required"},
+		{"invalid quiz", map[string]interface{}{"quiz": "sapply", "overrides": map[string]interface{}{"0": "agree"}}, "invalid quiz"},
+		{"missing overrides", map[string]interface{}{"quiz": "eight_values"}, "overrides is required"},
+		{"unknown question", map[string]interface{}{"quiz": "eight_values", "overrides": map[string]interface{}{"999": "agree"}}, "invalid question: 999"},
+		{"unknown key", map[string]interface{}{"quiz": "eight_values", "overrides": map[string]interface{}{"no_such_key": "agree"}}, "invalid question: no_such_key"},
+		{"invalid answer", map[string]interface{}{"quiz": "eight_values", "overrides": map[string]interface{}{"0": "maybe"}}, "invalid answer for 0"},
+		{"neutral on the compass", map[string]interface{}{"quiz": "political_compass", "overrides": map[string]interface{}{"0": "neutral"}}, "invalid answer for 0"},
+		{"value out of range", map[string]interface{}{"quiz": "politiscales", "overrides": map[string]interface{}{"0": 2.0}}, "invalid value for 0"},
+		{"duplicate question", map[string]interface{}{"quiz": "eight_values", "overrides": map[string]interface{}{"0": "agree", eightvalues.Questions[0].Text: "disagree"}}, "duplicate override"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, isError := callTool(t, handleWhatIf, "what_if", tt.args)
+			if !isError || !strings.Contains(content, tt.expected) {
+				t.Errorf("expected error containing %q, got: %s", tt.expected, content)
+			}
+		})
+	}
+}
+
+func TestPolitiscalesLeaningAndBadges(t *testing.T) {
+	leaning := politiscalesLeaning(map[string]float64{"constructivism": 60, "essentialism": 20})
+	if leaning["identity"] != "constructivism" {
+		t.Errorf("expected constructivism to lead identity, got %q", leaning["identity"])
+	}
+	if politiscalesLeaning(map[string]float64{})["identity"] != "balanced" {
+		t.Error("expected an unanswered pair to be balanced")
+	}
+
+	badges := politiscalesBadges(map[string]float64{"monarchism": 50, "anarchism": 89})
+	if !reflect.DeepEqual(badges, []string{"Monarchist"}) {
+		t.Errorf("expected only the monarchism badge, got %v", badges)
+	}
+}